@@ -0,0 +1,214 @@
+// Package audit keeps an append-only, hash chained record of maintenance
+// actions.  Every entry carries the hash of the one before it, so editing,
+// dropping or reordering entries in the stored log breaks the chain and shows
+// up in Verify.
+package audit
+
+import (
+	"bufio"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+	"time"
+)
+
+// Entry is one audited action.  ElevatorID is -1 for building wide actions.
+type Entry struct {
+	Seq        int64           `json:"seq"`
+	Time       time.Time       `json:"time"`
+	Action     string          `json:"action"`
+	Actor      string          `json:"actor"`
+	ApprovedBy string          `json:"approvedBy,omitempty"`
+	SourceIP   string          `json:"sourceIP"`
+	BuildingID int             `json:"buildingID"`
+	ElevatorID int             `json:"elevatorID"`
+	Before     json.RawMessage `json:"before,omitempty"`
+	After      json.RawMessage `json:"after,omitempty"`
+	Reason     string          `json:"reason,omitempty"`
+	Error      string          `json:"error,omitempty"`
+	PrevHash   string          `json:"prevHash"`
+	Hash       string          `json:"hash"`
+}
+
+// hash is the sha256 of the entry with its own Hash left out
+func (e Entry) hash() (string, error) {
+	e.Hash = ""
+	b, err := json.Marshal(e)
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(b)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// Filter narrows down Entries, zero values match everything
+type Filter struct {
+	Action     string
+	Actor      string
+	ElevatorID *int
+	Since      time.Time
+	Until      time.Time
+	// only these buildings, every building when nil
+	Buildings []int
+}
+
+func (f Filter) match(e Entry) bool {
+	if f.Action != "" && e.Action != f.Action {
+		return false
+	}
+	if f.Actor != "" && e.Actor != f.Actor {
+		return false
+	}
+	if f.ElevatorID != nil && e.ElevatorID != *f.ElevatorID {
+		return false
+	}
+	if !f.Since.IsZero() && e.Time.Before(f.Since) {
+		return false
+	}
+	if !f.Until.IsZero() && !e.Time.Before(f.Until) {
+		return false
+	}
+	if f.Buildings != nil {
+		for _, id := range f.Buildings {
+			if id == e.BuildingID {
+				return true
+			}
+		}
+		return false
+	}
+	return true
+}
+
+// Log is the audit trail.  Entries are kept in memory for queries and, if
+// the log was opened on a file, written through to it one json line each.
+type Log struct {
+	mu      sync.Mutex
+	entries []Entry
+	out     io.Writer
+	clock   func() time.Time
+}
+
+// New starts an empty log that writes to out, out can be nil
+func New(out io.Writer) *Log {
+	return &Log{out: out, clock: time.Now}
+}
+
+// Open loads the log at path, checks its chain and carries on appending to it
+func Open(path string) (*Log, error) {
+	f, err := os.OpenFile(path, os.O_RDWR|os.O_CREATE|os.O_APPEND, 0600)
+	if err != nil {
+		return nil, err
+	}
+	entries, err := read(f)
+	if err == nil {
+		err = verify(entries)
+	}
+	if err != nil {
+		f.Close()
+		return nil, fmt.Errorf("audit log %s: %w", path, err)
+	}
+	return &Log{entries: entries, out: f, clock: time.Now}, nil
+}
+
+func read(r io.Reader) ([]Entry, error) {
+	entries := make([]Entry, 0)
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 64*1024), 16*1024*1024)
+	for scanner.Scan() {
+		var e Entry
+		if err := json.Unmarshal(scanner.Bytes(), &e); err != nil {
+			return nil, fmt.Errorf("entry %d: %w", len(entries)+1, err)
+		}
+		entries = append(entries, e)
+	}
+	return entries, scanner.Err()
+}
+
+// Append seals an entry onto the end of the chain.  Seq, Time, PrevHash and
+// Hash are filled in here.
+func (l *Log) Append(e Entry) (Entry, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	e.Seq = int64(len(l.entries)) + 1
+	e.Time = l.clock().UTC()
+	e.PrevHash = ""
+	if len(l.entries) > 0 {
+		e.PrevHash = l.entries[len(l.entries)-1].Hash
+	}
+	hash, err := e.hash()
+	if err != nil {
+		return Entry{}, err
+	}
+	e.Hash = hash
+	if l.out != nil {
+		line, err := json.Marshal(e)
+		if err != nil {
+			return Entry{}, err
+		}
+		// nothing goes in memory that didn't make it to disk
+		if _, err := l.out.Write(append(line, '\n')); err != nil {
+			return Entry{}, err
+		}
+	}
+	l.entries = append(l.entries, e)
+	return e, nil
+}
+
+// Entries returns the entries matching f, oldest first
+func (l *Log) Entries(f Filter) []Entry {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	matched := make([]Entry, 0)
+	for _, e := range l.entries {
+		if f.match(e) {
+			matched = append(matched, e)
+		}
+	}
+	return matched
+}
+
+// Verify checks the chain of the entries held in memory
+func (l *Log) Verify() error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return verify(l.entries)
+}
+
+// VerifyFile checks the chain of a stored log
+func VerifyFile(path string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	entries, err := read(f)
+	if err != nil {
+		return err
+	}
+	return verify(entries)
+}
+
+func verify(entries []Entry) error {
+	prev := ""
+	for i, e := range entries {
+		if e.Seq != int64(i)+1 {
+			return fmt.Errorf("entry %d is out of sequence, has seq %d", i+1, e.Seq)
+		}
+		if e.PrevHash != prev {
+			return fmt.Errorf("entry %d does not follow entry %d", e.Seq, e.Seq-1)
+		}
+		hash, err := e.hash()
+		if err != nil {
+			return err
+		}
+		if hash != e.Hash {
+			return fmt.Errorf("entry %d has been altered", e.Seq)
+		}
+		prev = e.Hash
+	}
+	return nil
+}
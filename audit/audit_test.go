@@ -0,0 +1,109 @@
+package audit
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestAuditLogChain(t *testing.T) {
+	l := New(nil)
+	now := time.Date(2024, 3, 1, 9, 0, 0, 0, time.UTC)
+	l.clock = func() time.Time { return now }
+
+	first, err := l.Append(Entry{Action: "resetElevator", Actor: "alice", ElevatorID: 1, Reason: "stuck doors"})
+	if err != nil {
+		t.Errorf("Expected no error appending, got %s", err.Error())
+	}
+	if first.Seq != 1 || first.PrevHash != "" || first.Hash == "" {
+		t.Errorf("First entry should start the chain, got %v", first)
+	}
+	now = now.Add(time.Minute)
+	second, _ := l.Append(Entry{Action: "takeElevatorOutOfService", Actor: "bob", ElevatorID: 2,
+		Before: json.RawMessage(`{"InService":true}`), After: json.RawMessage(`{"InService":false}`)})
+	if second.Seq != 2 || second.PrevHash != first.Hash {
+		t.Errorf("Second entry should chain onto the first, got %v", second)
+	}
+	if err := l.Verify(); err != nil {
+		t.Errorf("Expected the chain to verify, got %s", err.Error())
+	}
+
+	// quietly change who did it
+	l.entries[0].Actor = "mallory"
+	if err := l.Verify(); err == nil {
+		t.Errorf("Changing an entry should break the chain")
+	}
+	l.entries[0].Actor = "alice"
+
+	// or drop the first entry and renumber
+	dropped := []Entry{l.entries[1]}
+	dropped[0].Seq = 1
+	if err := verify(dropped); err == nil {
+		t.Errorf("Dropping an entry should break the chain")
+	}
+}
+
+func TestAuditLogFilter(t *testing.T) {
+	l := New(nil)
+	now := time.Date(2024, 3, 1, 9, 0, 0, 0, time.UTC)
+	l.clock = func() time.Time { return now }
+	l.Append(Entry{Action: "resetElevator", Actor: "alice", ElevatorID: 1})
+	now = now.Add(time.Hour)
+	l.Append(Entry{Action: "drainElevator", Actor: "bob", ElevatorID: 2})
+	l.Append(Entry{Action: "fireRecall", Actor: "carol", ElevatorID: -1, BuildingID: 2})
+
+	if n := len(l.Entries(Filter{})); n != 3 {
+		t.Errorf("Empty filter should match 3 entries, got %d", n)
+	}
+	if n := len(l.Entries(Filter{Actor: "bob"})); n != 1 {
+		t.Errorf("Actor filter should match 1 entry, got %d", n)
+	}
+	elevatorID := 1
+	if n := len(l.Entries(Filter{ElevatorID: &elevatorID})); n != 1 {
+		t.Errorf("Elevator filter should match 1 entry, got %d", n)
+	}
+	if n := len(l.Entries(Filter{Since: now})); n != 2 {
+		t.Errorf("Since filter should match 2 entries, got %d", n)
+	}
+	if n := len(l.Entries(Filter{Buildings: []int{2}})); n != 1 {
+		t.Errorf("Building filter should match 1 entry, got %d", n)
+	}
+	if n := len(l.Entries(Filter{Buildings: []int{}})); n != 0 {
+		t.Errorf("Empty building filter should match nothing, got %d", n)
+	}
+}
+
+func TestAuditLogFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "audit.log")
+	l, err := Open(path)
+	if err != nil {
+		t.Fatalf("Expected no error opening a new log, got %s", err.Error())
+	}
+	l.Append(Entry{Action: "resetElevator", Actor: "alice", ElevatorID: 1})
+	l.Append(Entry{Action: "drainElevator", Actor: "bob", ElevatorID: 2})
+
+	// picks up where it left off
+	l, err = Open(path)
+	if err != nil {
+		t.Fatalf("Expected no error reopening the log, got %s", err.Error())
+	}
+	e, _ := l.Append(Entry{Action: "elevatorBackInService", Actor: "bob", ElevatorID: 2})
+	if e.Seq != 3 {
+		t.Errorf("Reopened log should carry on at seq 3, got %d", e.Seq)
+	}
+	if err := VerifyFile(path); err != nil {
+		t.Errorf("Expected the stored log to verify, got %s", err.Error())
+	}
+
+	data, _ := os.ReadFile(path)
+	os.WriteFile(path, []byte(strings.Replace(string(data), `"actor":"alice"`, `"actor":"bob"`, 1)), 0600)
+	if err := VerifyFile(path); err == nil {
+		t.Errorf("Editing the stored log should fail verification")
+	}
+	if _, err := Open(path); err == nil {
+		t.Errorf("Opening a tampered log should fail")
+	}
+}
@@ -0,0 +1,32 @@
+package building
+
+import (
+	"github.com/tcotav/elevatormgr/elevator"
+)
+
+// Call aging.  With an aging policy set the cars stop serving calls strictly
+// in order and go to the nearest call instead, which can leave a far away
+// floor waiting while the cars shuttle around a busy one.  Waiting time counts
+// in a call's favour and past the policy's MaxWait a call goes ahead of
+// everything else, so no call is starved.  See elevator.AgingPolicy.
+
+// SetAgingPolicy sets how every car in the building picks its next stop
+func (b *Building) SetAgingPolicy(policy elevator.AgingPolicy) error {
+	if policy.MaxWait < 0 || policy.Weight < 0 {
+		return b.errorf(ErrInvalidArgument, -1, "invalid aging policy: %v in building: %d", policy, b.ID)
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.agingPolicy = policy
+	for _, e := range b.ElevatorList {
+		e.Aging = policy
+	}
+	return nil
+}
+
+// GetAgingPolicy returns the building's aging policy
+func (b *Building) GetAgingPolicy() elevator.AgingPolicy {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.agingPolicy
+}
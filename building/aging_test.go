@@ -0,0 +1,83 @@
+package building
+
+import (
+	"testing"
+	"time"
+
+	"github.com/tcotav/elevatormgr/elevator"
+)
+
+// simulateBusyCar runs a single car that keeps getting called between floors 2
+// and 3 while somebody waits on floor 10, and returns how long they waited
+func simulateBusyCar(t *testing.T, policy elevator.AgingPolicy) time.Duration {
+	b := NewBuilding(1, 10, 1)
+	now := time.Date(2024, 3, 1, 9, 0, 0, 0, time.UTC)
+	b.clock = func() time.Time { return now }
+	if err := b.SetAgingPolicy(policy); err != nil {
+		t.Fatalf("Setting the aging policy should not fail, got %v", err)
+	}
+
+	start := now
+	if _, err := b.CallElevator(10, -1); err != nil {
+		t.Fatalf("Calling the elevator should not fail, got %v", err)
+	}
+	for i := 0; i < 100; i++ {
+		busy := 2
+		if b.GetElevator(0).CurrentFloor == 2 {
+			busy = 3
+		}
+		if _, err := b.CallElevator(busy, 1); err != nil {
+			t.Fatalf("Calling the elevator should not fail, got %v", err)
+		}
+		now = now.Add(15 * time.Second)
+		call, err := b.NextStop(0)
+		if err != nil {
+			t.Fatalf("Next stop should not fail, got %v", err)
+		}
+		if call.Floor == 10 {
+			return now.Sub(start)
+		}
+	}
+	return -1
+}
+
+func TestBuildingAgingPolicyBoundsWait(t *testing.T) {
+	policy := elevator.AgingPolicy{MaxWait: 2 * time.Minute}
+	wait := simulateBusyCar(t, policy)
+	// the far call has to be served at the first stop after it goes past MaxWait
+	if wait < 0 || wait > policy.MaxWait+15*time.Second {
+		t.Errorf("Floor 10 should wait no longer than %v, got %v", policy.MaxWait+15*time.Second, wait)
+	}
+
+	// weight alone lets the far call catch up as well
+	wait = simulateBusyCar(t, elevator.AgingPolicy{Weight: 0.1})
+	if wait < 0 || wait > 2*time.Minute {
+		t.Errorf("Floor 10 should be served within 2m by aging weight, got %v", wait)
+	}
+}
+
+func TestBuildingNearestFirstStarves(t *testing.T) {
+	// nearest first with no real bound never gets to floor 10
+	wait := simulateBusyCar(t, elevator.AgingPolicy{MaxWait: 24 * time.Hour})
+	if wait != -1 {
+		t.Errorf("Floor 10 should be starved without aging, got served after %v", wait)
+	}
+}
+
+func TestBuildingAgingPolicyCarriedOverReset(t *testing.T) {
+	b := NewBuilding(1, 10, 2)
+	policy := elevator.AgingPolicy{MaxWait: time.Minute, Weight: 0.2}
+	if err := b.SetAgingPolicy(elevator.AgingPolicy{MaxWait: -time.Second}); err == nil {
+		t.Errorf("Negative MaxWait should be rejected")
+	}
+	b.SetAgingPolicy(policy)
+	if _, _, err := b.ResetElevator(1); err != nil {
+		t.Errorf("Resetting the elevator should not fail, got %v", err)
+	}
+	if b.GetElevator(1).Aging != policy {
+		t.Errorf("Reset elevator should keep the building aging policy, got %v", b.GetElevator(1).Aging)
+	}
+	if b.GetAgingPolicy() != policy {
+		t.Errorf("Building aging policy should be %v, got %v", policy, b.GetAgingPolicy())
+	}
+}
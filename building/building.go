@@ -2,8 +2,10 @@ package building
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"sync"
+	"time"
 
 	"github.com/tcotav/elevatormgr/elevator"
 )
@@ -13,39 +15,151 @@ type Building struct {
 	ID           int
 	ElevatorList []*elevator.Elevator
 	NumFloors    int
+
+	// fire service -- see fireservice.go
+	RecallFloor          int
+	AlternateRecallFloor int
+	FireRecall           bool
+	activeRecallFloor    int
+	smokeDetectors       map[int]bool
+	fireServiceKey       string
+
+	// emergency power -- see emergencypower.go
+	EmergencyPower       bool
+	LobbyFloor           int
+	emergencyRunningCars int
+	emergencySelected    []int
+	emergencyReturnQueue []int
+
+	// priority calls -- see prioritycall.go
+	priorityCallKey string
+	priorityCallLog []PriorityCallRecord
+
+	// scheduled maintenance -- see maintenance.go
+	MinInService       int
+	maintenanceWindows []*MaintenanceWindow
+	nextWindowID       int
+
+	// outstanding hall calls -- see hallcalls.go
+	hallCalls map[hallCallKey]*HallCall
+	clock     func() time.Time
+
+	// hall call re-optimization -- see optimize.go
+	ReassignThreshold time.Duration
+	ReassignHoldTime  time.Duration
+
+	// unanswered call escalation -- see overdue.go
+	MaxWait time.Duration
+
+	// next stop selection -- see aging.go
+	agingPolicy elevator.AgingPolicy
+
+	// floor labels, banks and dispatch -- see layout.go
+	floorLabels      map[int]string
+	groups           []Group
+	dispatchStrategy DispatchStrategy
+
+	// call tickets -- see tickets.go
+	tickets  map[string]*Ticket
+	carCalls map[carCallKey]string
+	// hall call tickets answered at each car's last stop
+	answered map[int][]string
+
+	// event subscribers -- see events.go
+	subscribers      map[int]chan Event
+	nextSubscriberID int
 }
 
 func NewBuilding(buildingID int, maxfloors int, numberElevators int) *Building {
-	elevatorList := make([]*elevator.Elevator,0)
-	for i := 0; i < numberElevators; i++ {
-		// for simplicity sake, we use the count as elevatorID
-		elevatorList = append(elevatorList, elevator.NewElevator(buildingID, i, maxfloors))
+	alternateRecallFloor := 1
+	if maxfloors > 1 {
+		alternateRecallFloor = 2
 	}
 
-	return &Building{
+	b := &Building{
 		ID:           buildingID,
-		ElevatorList: elevatorList,
+		ElevatorList: make([]*elevator.Elevator,0),
 		NumFloors:    maxfloors,
+		RecallFloor:          1,
+		AlternateRecallFloor: alternateRecallFloor,
+		LobbyFloor:           1,
+		MinInService:         1,
+		smokeDetectors:       make(map[int]bool),
+		hallCalls:            make(map[hallCallKey]*HallCall),
+		clock:                time.Now,
+		ReassignThreshold:    20 * time.Second,
+		ReassignHoldTime:     30 * time.Second,
+		MaxWait:              2 * time.Minute,
+		subscribers:          make(map[int]chan Event),
+		tickets:              make(map[string]*Ticket),
+		carCalls:             make(map[carCallKey]string),
+		answered:             make(map[int][]string),
+		floorLabels:          make(map[int]string),
+		groups:               make([]Group, 0),
+		dispatchStrategy:     DispatchClosest,
 	}
+	for i := 0; i < numberElevators; i++ {
+		// for simplicity sake, we use the count as elevatorID
+		b.ElevatorList = append(b.ElevatorList, b.newElevator(i))
+	}
+	return b
+}
+
+// newElevator makes a car that runs on the building's clock and aging policy
+func (b *Building) newElevator(elevatorID int) *elevator.Elevator {
+	e := elevator.NewElevator(b.ID, elevatorID, b.NumFloors)
+	e.Clock = func() time.Time { return b.clock() }
+	e.Aging = b.agingPolicy
+	return e
 }
 
 // maintenance function -- resets elevator to the ground floor and clears the call list
-func (b *Building) ResetElevator(elevatorID int) (int, error) {
+// the hall calls it was holding are handed to the other cars, the returned list says where each went.
+// applied is false when the reset was refused -- with it true the car was reset and
+// the error only says some calls couldn't be placed.
+func (b *Building) ResetElevator(elevatorID int) ([]Reassignment, bool, error) {
 	b.mu.Lock()
 	defer b.mu.Unlock()
 	e := b.GetElevator(elevatorID)
 	if e == nil {
-		return -1, fmt.Errorf("elevator with ID: %d does not exist in building: %d", elevatorID, b.ID)
+		return nil, false, b.errorf(ErrElevatorNotFound, elevatorID, "elevator with ID: %d does not exist in building: %d", elevatorID, b.ID)
 	}
 	if !e.InService {
-		return -1, fmt.Errorf("elevator with ID: %d is not in service in building: %d", elevatorID, b.ID)
+		return nil, false, b.errorf(ErrNotInService, elevatorID, "elevator with ID: %d is not in service in building: %d", elevatorID, b.ID)
+	}
+	if b.FireRecall {
+		return nil, false, b.errorf(ErrConflict, elevatorID, "building: %d is in fire recall, elevator: %d cannot be reset", b.ID, elevatorID)
+	}
+	// a fresh car would drop out of the emergency return queue and stall it
+	if b.EmergencyPower {
+		return nil, false, b.errorf(ErrConflict, elevatorID, "building: %d is on emergency power, elevator: %d cannot be reset", b.ID, elevatorID)
 	}
-	callsImpacted := e.CallList.Len()
-	b.ElevatorList[elevatorID] = elevator.NewElevator(b.ID, elevatorID, b.NumFloors)
 
 	// we want to return error because in a real system, something co
 	// go wrong with resetting an elevator
-	return callsImpacted, nil
+	reassigned, err := b.resetElevator(e)
+	return reassigned, true, err
+}
+
+// resetElevator swaps in a fresh car and reassigns the old one's hall calls.
+// The reset happens even if some calls can't be placed, the error says so.
+// Caller holds the lock.
+func (b *Building) resetElevator(e *elevator.Elevator) ([]Reassignment, error) {
+	hallCalls := e.CallList.RemoveType(elevator.HallCall)
+	fresh := b.newElevator(e.ElevatorID)
+	fresh.InService = e.InService
+	setSpec(fresh, ElevatorSpec{ServedFloors: e.ServedFloors, Capacity: e.Capacity, Speed: e.Speed})
+	for i, car := range b.ElevatorList {
+		if car == e {
+			b.ElevatorList[i] = fresh
+		}
+	}
+
+	reassigned, err := b.reassignHallCalls(hallCalls, e.ElevatorID)
+	if err != nil {
+		return reassigned, fmt.Errorf("could not reassign hall calls from elevator: %d in building: %d: %w", e.ElevatorID, b.ID, err)
+	}
+	return reassigned, nil
 }
 
 func (b *Building) GetElevatorList() []*elevator.Elevator {
@@ -53,6 +167,8 @@ func (b *Building) GetElevatorList() []*elevator.Elevator {
 }
 
 func (b *Building) GetAllElevatorState() ([]byte, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
 	retbytes, err := json.Marshal(b.ElevatorList)
 	if err != nil {
 		return []byte{}, err
@@ -60,35 +176,138 @@ func (b *Building) GetAllElevatorState() ([]byte, error) {
 	return retbytes, nil
 }
 
-func (b *Building) SetElevatorInServiceStatus(elevatorID int, inService bool) error {
+// GetElevatorState returns the state of one car as json
+func (b *Building) GetElevatorState(elevatorID int) ([]byte, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
 	e := b.GetElevator(elevatorID)
 	if e == nil {
-		return fmt.Errorf("elevator with ID: %d does not exist in building: %d", elevatorID, b.ID)
+		return nil, b.errorf(ErrElevatorNotFound, elevatorID, "elevator with ID: %d does not exist in building: %d", elevatorID, b.ID)
+	}
+	return json.Marshal(e)
+}
+
+// SetElevatorInServiceStatus takes a car out of service or puts it back.  Taking it
+// out resets it, the returned list says where its hall calls went.  applied is
+// false when the change was refused, the same as ResetElevator.
+func (b *Building) SetElevatorInServiceStatus(elevatorID int, inService bool) ([]Reassignment, bool, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	e := b.GetElevator(elevatorID)
+	if e == nil {
+		return nil, false, b.errorf(ErrElevatorNotFound, elevatorID, "elevator with ID: %d does not exist in building: %d", elevatorID, b.ID)
+	}
+	// putting a draining car back in service just stops the drain
+	if inService && e.Mode == elevator.ModeDraining {
+		e.Mode = elevator.ModeNormal
+		return []Reassignment{}, true, nil
 	}
 	if e.InService == inService {
 		// NOOP
 		// do we message out that the elevator is already in the state requested?
-		return nil
+		return []Reassignment{}, true, nil
 	}
-	// set the inservice flag
-	e.InService = inService
 	// if it is being taken out of service, reset the elevator
 	// clearing the call stack and bringing the elevator back to the ground floor
 	if !inService {
-		b.ResetElevator(elevatorID)
+		if b.EmergencyPower {
+			return nil, false, b.errorf(ErrConflict, elevatorID, "building: %d is on emergency power, elevator: %d cannot be taken out of service", b.ID, elevatorID)
+		}
+		e.InService = false
+		reassigned, err := b.resetElevator(e)
+		return reassigned, true, err
+	}
+	// set the inservice flag
+	e.InService = true
+	// a car coming back during fire recall joins the others at the recall floor
+	if b.FireRecall {
+		if err := e.Recall(b.activeRecallFloor); err != nil {
+			// no way to the recall floor, leave it out of service
+			e.InService = false
+			return nil, false, err
+		}
+	}
+	return []Reassignment{}, true, nil
+}
+
+// SetIndependentService takes a car out of group dispatch for independent
+// (attendant) service or returns it to the group.  Unlike taking the car out of
+// service it keeps its calls and still answers its car buttons.
+func (b *Building) SetIndependentService(elevatorID int, on bool) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	e := b.GetElevator(elevatorID)
+	if e == nil {
+		return b.errorf(ErrElevatorNotFound, elevatorID, "elevator with ID: %d does not exist in building: %d", elevatorID, b.ID)
+	}
+	if !e.InService {
+		return b.errorf(ErrNotInService, elevatorID, "elevator with ID: %d is not in service in building: %d", elevatorID, b.ID)
 	}
+	if on {
+		return e.StartIndependentService()
+	}
+	e.EndIndependentService()
 	return nil
 }
 
 func (b *Building) CallElevator(floor int, direction int) (int, error) {
+	t, err := b.RegisterHallCall(floor, direction)
+	return t.ElevatorID, err
+}
+
+// RegisterHallCall dispatches a hall call and returns its ticket.  Pushing a
+// button that's already lit hands back the ticket of the call already made.
+func (b *Building) RegisterHallCall(floor int, direction int) (Ticket, error) {
 	if direction != 1 && direction != -1 {
-		return -1, fmt.Errorf("invalid direction: %d in building: %d", direction, b.ID)
+		return Ticket{ElevatorID: -1}, b.errorf(ErrInvalidArgument, -1, "invalid direction: %d in building: %d", direction, b.ID)
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	// otherwise it looks like no car could take it
+	if floor < 1 || floor > b.NumFloors {
+		return Ticket{ElevatorID: -1}, b.errorf(ErrInvalidFloor, -1, "invalid floor: %d in building: %d", floor, b.ID)
+	}
+	if b.FireRecall {
+		return Ticket{ElevatorID: -1}, b.errorf(ErrConflict, -1, "building: %d is in fire recall, hall calls are not accepted", b.ID)
 	}
+	// somebody already pushed this button -- the lantern's lit, nothing to do
+	b.prune()
+	if hc, ok := b.hallCalls[hallCallKey{floor, direction}]; ok {
+		return *b.tickets[hc.TicketID], nil
+	}
+
+	el, err := b.dispatch(floor, direction, -1)
+	if el == nil {
+		return Ticket{ElevatorID: -1}, err
+	}
+	// the car already stops there going that way, the passenger still gets a ticket
+	if err != nil && !errors.Is(err, elevator.ErrDuplicateCall) {
+		return Ticket{ElevatorID: el.ElevatorID}, err
+	}
+	hc := b.registerHallCall(floor, direction, el)
+	return *b.tickets[hc.TicketID], nil
+}
+
+// dispatch hands a hall call to the best car, skipping the car with ID exclude.
+// Caller holds the lock.
+func (b *Building) dispatch(floor int, direction int, exclude int) (*elevator.Elevator, error) {
+	el := b.pickCar(floor, direction, exclude)
 
+	// if we didn't find an elevator, return an error
+	if el == nil {
+		return nil, b.errorf(ErrNoElevators, -1, "no elevators in service for floor: %d in building: %d", floor, b.ID)
+	}
+	// then do the actual call
+	return el, el.CallElevator(floor, direction)
+}
+
+// closestCar picks the closest car that can take a hall call, skipping the car
+// with ID exclude.  Caller holds the lock.
+func (b *Building) closestCar(floor int, exclude int) *elevator.Elevator {
 	// we want to use the CLOSEST elevator to the floor
 	var el *elevator.Elevator
 	for _, e := range b.ElevatorList {
-		if e.InService {
+		if dispatchable(e, floor) && e.ElevatorID != exclude {
 			if el == nil {
 				el = e
 			} else {
@@ -98,70 +317,113 @@ func (b *Building) CallElevator(floor int, direction int) (int, error) {
 			}
 		}
 	}
-
-	// if we didn't find an elevator, return an error
-	if el == nil {
-		return -1, fmt.Errorf("no elevators in service in building: %d", b.ID)
-	}
-	// then do the actual call
-	err := el.CallElevator(floor, direction)
-	// and return which elevator we called
-	return el.ElevatorID, err
+	return el
 }
 
 func (b *Building) PushDestinationButton(elevatorID int, floor int) error {
+	_, err := b.RegisterCarCall(elevatorID, floor)
+	return err
+}
+
+// RegisterCarCall adds a car call and returns its ticket.  Pushing a button
+// that's already lit hands back the ticket of the call already made.
+func (b *Building) RegisterCarCall(elevatorID int, floor int) (Ticket, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
 	e := b.GetElevator(elevatorID)
 	if e == nil {
-		return fmt.Errorf("elevator with ID: %d does not exist in building: %d", elevatorID, b.ID)
+		return Ticket{ElevatorID: -1}, b.errorf(ErrElevatorNotFound, elevatorID, "elevator with ID: %d does not exist in building: %d", elevatorID, b.ID)
 	}
 	if !e.InService {
-		return fmt.Errorf("elevator with ID: %d is not in service in building: %d", elevatorID, b.ID)
+		return Ticket{ElevatorID: -1}, b.errorf(ErrNotInService, elevatorID, "elevator with ID: %d is not in service in building: %d", elevatorID, b.ID)
+	}
+	b.prune()
+	if ticketID, ok := b.carCalls[carCallKey{elevatorID, floor}]; ok {
+		return *b.tickets[ticketID], nil
+	}
+	// a stop the car is already making, like a maintenance override, gets a ticket too
+	if err := e.PushDestinationButton(floor); err != nil && !errors.Is(err, elevator.ErrDuplicateCall) {
+		return Ticket{ElevatorID: -1}, err
 	}
-	return e.PushDestinationButton(floor)
+	t := b.newTicket(elevator.CarCall, floor, callDirection(e.CurrentFloor, floor), e.ElevatorID)
+	b.carCalls[carCallKey{elevatorID, floor}] = t.ID
+	return *t, nil
 }
 
 func (b *Building) NextStop(elevatorID int) (*elevator.Call, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
 	e := b.GetElevator(elevatorID)
 	if e == nil {
-		return nil, fmt.Errorf("elevator with ID: %d does not exist in building: %d", elevatorID, b.ID)
+		return nil, b.errorf(ErrElevatorNotFound, elevatorID, "elevator with ID: %d does not exist in building: %d", elevatorID, b.ID)
 	}
 	if !e.InService {
-		return nil, fmt.Errorf("elevator with ID: %d is not in service in building: %d", elevatorID, b.ID)
+		return nil, b.errorf(ErrNotInService, elevatorID, "elevator with ID: %d is not in service in building: %d", elevatorID, b.ID)
+	}
+	call, err := e.NextStop()
+	if err != nil {
+		return nil, err
+	}
+	b.elevatorDeparted(e)
+	b.elevatorArrived(e, call)
+	return call, nil
+}
+
+// elevatorArrived is where the building reacts to a car reaching a stop -- caller holds the lock
+func (b *Building) elevatorArrived(e *elevator.Elevator, call *elevator.Call) {
+	// one stop answers both the hall call and the car call for the same floor and direction
+	twin := *call
+	twin.Type = elevator.HallCall
+	if call.Type == elevator.HallCall {
+		twin.Type = elevator.CarCall
+	}
+	merged := e.CallList.Remove(twin)
+	if call.Type == elevator.HallCall || merged && twin.Type == elevator.HallCall {
+		b.hallCallAnswered(call.Floor, call.Direction, e)
+	}
+	if call.Type == elevator.CarCall || merged && twin.Type == elevator.CarCall {
+		b.carCallAnswered(call.Floor, e)
+	}
+	if e.Mode == elevator.ModeEmergencyReturn && e.CallList.Len() == 0 {
+		b.emergencyCarReturned(e)
+	}
+	if e.Mode == elevator.ModePriority && e.CallList.Len() == 0 {
+		e.EndPriorityRun()
+	}
+	if e.Mode == elevator.ModeDraining && e.CallList.Len() == 0 {
+		b.finishDrain(e)
 	}
-	return e.NextStop()
 }
 
 func (b *Building) MaintenanceCallOverride(elevatorID int, floor int, direction int) error {
 	if direction != 1 && direction != -1 {
-		return fmt.Errorf("invalid direction: %d for elevator: %d in building: %d", direction, elevatorID, b.ID)
+		return b.errorf(ErrInvalidArgument, elevatorID, "invalid direction: %d for elevator: %d in building: %d", direction, elevatorID, b.ID)
 	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
 	e := b.GetElevator(elevatorID)
 	if e == nil {
-		return fmt.Errorf("elevator with ID: %d does not exist in building: %d", elevatorID, b.ID)
+		return b.errorf(ErrElevatorNotFound, elevatorID, "elevator with ID: %d does not exist in building: %d", elevatorID, b.ID)
 	}
 	if !e.InService {
-		return fmt.Errorf("elevator with ID: %d is not in service in building: %d", elevatorID, b.ID)
+		return b.errorf(ErrNotInService, elevatorID, "elevator with ID: %d is not in service in building: %d", elevatorID, b.ID)
+	}
+	if e.Mode != elevator.ModeNormal {
+		return b.errorf(elevator.ErrWrongMode, elevatorID, "elevator with ID: %d is in %s mode in building: %d", elevatorID, e.Mode, b.ID)
+	}
+	if !e.Serves(floor) {
+		return b.errorf(elevator.ErrFloorNotServed, elevatorID, "floor: %d is not served by elevator: %d in building: %d", floor, elevatorID, b.ID)
 	}
 	return e.ForceCallElevator(floor, direction)
 }
 
-// make sure elevator exists in our list
-func (b *Building) doesElevatorExist(elevatorID int) bool {
-	if len(b.ElevatorList) == 0 {
-		return false
-	}
+// GetElevator returns a pointer to the elevator object, nil if there's no car with that ID.
+// cars come and go, so the ID isn't its place in the list
+func (b *Building) GetElevator(elevatorID int) *elevator.Elevator {
 	for _, e := range b.ElevatorList {
 		if e.ElevatorID == elevatorID {
-			return true
+			return e
 		}
 	}
-	return false
-}
-
-// GetElevator returns a pointer to the elevator object, checks if the elevator exists first
-func (b *Building) GetElevator(elevatorID int) *elevator.Elevator {
-	if b.doesElevatorExist(elevatorID) {
-		return b.ElevatorList[elevatorID]
-	}
 	return nil
 }
@@ -3,6 +3,7 @@ package building
 import (
 	"testing"
 	"encoding/json"
+	"errors"
 	"github.com/tcotav/elevatormgr/elevator"
 )
 
@@ -48,6 +49,47 @@ func TestBuildingElevatorReset(t *testing.T) {
 	}
 }
 
+func TestBuildingElevatorResetReassignsHallCalls(t *testing.T) {
+	b := NewBuilding(1, 10, 2)
+
+	elID, _ := b.CallElevator(3, 1)
+	b.CallElevator(7, -1)
+	b.PushDestinationButton(elID, 5)
+
+	reassigned, applied, err := b.ResetElevator(elID)
+	if !applied || err != nil {
+		t.Errorf("Elevator should be reset, got %v", err)
+	}
+	if len(reassigned) != 2 {
+		t.Errorf("Both hall calls should be reassigned, got %d", len(reassigned))
+	}
+	other := b.GetElevator(1 - elID)
+	for _, r := range reassigned {
+		if r.ElevatorID != other.ElevatorID {
+			t.Errorf("Hall call %v should go to elevator %d, got %d", r.Call, other.ElevatorID, r.ElevatorID)
+		}
+	}
+	if other.GetCallList().Len() != 2 {
+		t.Errorf("Elevator %d should have the 2 hall calls, got %d", other.ElevatorID, other.GetCallList().Len())
+	}
+
+	// nobody left to take the calls -- the car still goes out of service
+	b.SetElevatorInServiceStatus(elID, false)
+	reassigned, applied, err = b.SetElevatorInServiceStatus(other.ElevatorID, false)
+	if err == nil {
+		t.Errorf("Taking the last car out of service with hall calls should return an error")
+	}
+	if !applied {
+		t.Errorf("Taking the last car out of service should still be applied")
+	}
+	if len(reassigned) != 2 || reassigned[0].ElevatorID != -1 {
+		t.Errorf("Unplaced hall calls should be listed with elevator -1, got %v", reassigned)
+	}
+	if b.GetElevator(other.ElevatorID).InService {
+		t.Errorf("Elevator %d should be out of service", other.ElevatorID)
+	}
+}
+
 func TestBuildingElevatorTrip(t *testing.T) {
 	b := NewBuilding(1, 10, 2)
 
@@ -114,4 +156,88 @@ func TestGetAllElevatorState(t *testing.T) {
 		}
 
 	}
-}
\ No newline at end of file
+}
+
+func TestGetElevatorState(t *testing.T) {
+	b := NewBuilding(1, 10, 2)
+	elID, _ := b.CallElevator(3, 1)
+
+	stateJSONb, err := b.GetElevatorState(elID)
+	if err != nil {
+		t.Errorf("Elevator state should be returned, %s", err.Error())
+	}
+	var el elevator.Elevator
+	err = json.Unmarshal(stateJSONb, &el)
+	if err != nil {
+		t.Errorf("JSON unmarshal error, %s", err.Error())
+	}
+	if el.ElevatorID != elID || el.GetCallList().Len() != 1 {
+		t.Errorf("Elevator %d should have 1 call, got %v", elID, el)
+	}
+	if _, err := b.GetElevatorState(10); err == nil {
+		t.Errorf("Elevator 10 should not exist")
+	}
+}
+
+func TestBuildingIndependentService(t *testing.T) {
+	b := NewBuilding(1, 10, 2)
+
+	err := b.SetIndependentService(0, true)
+	if err != nil {
+		t.Errorf("Independent service should start, got %s", err.Error())
+	}
+	e := b.GetElevator(0)
+	if !e.DoorsOpen {
+		t.Errorf("Elevator should hold its doors open until it gets a call")
+	}
+
+	// hall calls skip the independent car
+	for i := 0; i < 3; i++ {
+		elID, err := b.CallElevator(3+i, 1)
+		if err != nil {
+			t.Errorf("Elevator should be called, got %s", err.Error())
+		}
+		if elID == 0 {
+			t.Errorf("Hall call should not go to the independent service car")
+		}
+	}
+
+	if err := b.PushDestinationButton(0, 6); err != nil {
+		t.Errorf("Independent service car should take car calls, got %s", err.Error())
+	}
+	if e.DoorsOpen {
+		t.Errorf("Elevator should close its doors once it has a call")
+	}
+	b.NextStop(0)
+	if !e.DoorsOpen {
+		t.Errorf("Elevator should hold its doors open at the stop")
+	}
+
+	b.SetIndependentService(0, false)
+	if e.Mode != elevator.ModeNormal {
+		t.Errorf("Elevator should be back in group service, got %s", e.Mode)
+	}
+	b.SetElevatorInServiceStatus(1, false)
+	elID, _ := b.CallElevator(7, -1)
+	if elID != 0 {
+		t.Errorf("Hall call should go to elevator 0 once it is back in the group, got %d", elID)
+	}
+}
+
+func TestBuildingMaintenanceCallOverride(t *testing.T) {
+	b := NewBuilding(1, 10, 2)
+	b.ConfigureElevator(1, ElevatorSpec{ServedFloors: []int{1, 5, 10}})
+
+	if err := b.MaintenanceCallOverride(1, 7, 1); !errors.Is(err, elevator.ErrFloorNotServed) {
+		t.Errorf("Override to floor 7 should fail on a car that skips it, got %v", err)
+	}
+	if b.GetElevator(1).CallList.Len() != 0 {
+		t.Errorf("Elevator 1 should have no calls after a refused override")
+	}
+	if err := b.MaintenanceCallOverride(1, 5, 1); err != nil {
+		t.Errorf("Override to floor 5 should be made, got %s", err.Error())
+	}
+	if err := b.MaintenanceCallOverride(0, 11, 1); err == nil {
+		t.Errorf("Override above the top floor should fail")
+	}
+}
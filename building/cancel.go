@@ -0,0 +1,76 @@
+package building
+
+import (
+	"github.com/tcotav/elevatormgr/elevator"
+)
+
+// Call cancellation -- a double tap on the button, or maintenance cleaning up
+// calls nobody is waiting for any more.
+
+// CancelHallCall drops an outstanding hall call from its car and the registry
+func (b *Building) CancelHallCall(floor int, direction int, reason string) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.prune()
+	key := hallCallKey{floor, direction}
+	hc, ok := b.hallCalls[key]
+	if !ok {
+		return b.errorf(ErrCallNotFound, -1, "no hall call at floor: %d direction: %d in building: %d", floor, direction, b.ID)
+	}
+	if e := b.GetElevator(hc.ElevatorID); e != nil {
+		call := elevator.Call{Floor: floor, Direction: direction, Type: elevator.HallCall}
+		e.CallList.Remove(call)
+		// a priority run has the call put aside, don't let it come back when the run ends
+		kept := e.DeferredCalls[:0]
+		for _, c := range e.DeferredCalls {
+			if !c.Same(call) {
+				kept = append(kept, c)
+			}
+		}
+		e.DeferredCalls = kept
+	}
+	delete(b.hallCalls, key)
+	b.setTicketStatus(hc.TicketID, CallCancelled, hc.ElevatorID)
+	b.emit(Event{
+		Type:       EventCallCancelled,
+		Floor:      floor,
+		Direction:  direction,
+		ElevatorID: hc.ElevatorID,
+		Reason:     reason,
+	})
+	return nil
+}
+
+// CancelCarCall drops a car call for a floor from an elevator.
+// Only normal and independent service -- recall, emergency return, priority and
+// firefighter trips are car calls too and aren't ours to cancel.
+func (b *Building) CancelCarCall(elevatorID int, floor int, reason string) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	e := b.GetElevator(elevatorID)
+	if e == nil {
+		return b.errorf(ErrElevatorNotFound, elevatorID, "elevator with ID: %d does not exist in building: %d", elevatorID, b.ID)
+	}
+	if e.Mode != elevator.ModeNormal && e.Mode != elevator.ModeIndependent {
+		return b.errorf(elevator.ErrWrongMode, elevatorID, "elevator with ID: %d is in %s mode in building: %d, its car calls can't be cancelled", elevatorID, e.Mode, b.ID)
+	}
+	for _, c := range e.CallList.Snapshot() {
+		if c.Floor != floor || c.Type != elevator.CarCall {
+			continue
+		}
+		e.CallList.Remove(c)
+		if ticketID, ok := b.carCalls[carCallKey{elevatorID, floor}]; ok {
+			delete(b.carCalls, carCallKey{elevatorID, floor})
+			b.setTicketStatus(ticketID, CallCancelled, elevatorID)
+		}
+		b.emit(Event{
+			Type:       EventCallCancelled,
+			Floor:      floor,
+			Direction:  c.Direction,
+			ElevatorID: elevatorID,
+			Reason:     reason,
+		})
+		return nil
+	}
+	return b.errorf(ErrCallNotFound, elevatorID, "no car call for floor: %d on elevator: %d in building: %d", floor, elevatorID, b.ID)
+}
@@ -0,0 +1,115 @@
+package building
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/tcotav/elevatormgr/elevator"
+)
+
+func TestBuildingCancelCalls(t *testing.T) {
+	b := NewBuilding(1, 10, 2)
+	events, unsubscribe := b.Subscribe(10)
+	defer unsubscribe()
+
+	elID, _ := b.CallElevator(4, 1)
+	b.PushDestinationButton(elID, 7)
+
+	if err := b.CancelHallCall(4, -1, "double tap"); err == nil {
+		t.Errorf("Cancelling a hall call that doesn't exist should fail")
+	}
+	if err := b.CancelHallCall(4, 1, "double tap"); err != nil {
+		t.Errorf("Hall call should be cancelled, got %s", err.Error())
+	}
+	if len(b.GetHallCalls()) != 0 {
+		t.Errorf("Hall call registry should be empty")
+	}
+	ev, _ := nextEvent(events, EventCallCancelled)
+	if ev.Type != EventCallCancelled || ev.Floor != 4 || ev.Reason != "double tap" {
+		t.Errorf("Expected a cancellation event for floor 4, got %v", ev)
+	}
+
+	if err := b.CancelCarCall(elID, 3, "cleanup"); err == nil {
+		t.Errorf("Cancelling a car call that doesn't exist should fail")
+	}
+	if err := b.CancelCarCall(elID, 7, "cleanup"); err != nil {
+		t.Errorf("Car call should be cancelled, got %s", err.Error())
+	}
+	if b.GetElevator(elID).GetCallList().Len() != 0 {
+		t.Errorf("Elevator should have an empty call list, got %d", b.GetElevator(elID).GetCallList().Len())
+	}
+	ev, _ = nextEvent(events, EventCallCancelled)
+	if ev.Type != EventCallCancelled || ev.ElevatorID != elID || ev.Floor != 7 {
+		t.Errorf("Expected a cancellation event for floor 7 on elevator %d, got %v", elID, ev)
+	}
+}
+
+func TestBuildingCancelCarCallLifeSafety(t *testing.T) {
+	// priority run
+	b := NewBuilding(1, 10, 1)
+	b.SetPriorityCallKey("codeblue")
+	b.PriorityCall(6, 2, "codeblue", "nurse", "code blue")
+	if err := b.CancelCarCall(0, 6, "cleanup"); !errors.Is(err, elevator.ErrWrongMode) {
+		t.Errorf("Cancelling a priority run call should be refused, got %v", err)
+	}
+	if b.GetElevator(0).GetCallList().Len() != 2 {
+		t.Errorf("Priority run should keep both its calls, got %d", b.GetElevator(0).GetCallList().Len())
+	}
+
+	// fire recall, then firefighter service
+	b = NewBuilding(1, 10, 1)
+	b.SetFireServiceKey("firekey")
+	b.MaintenanceCallOverride(0, 5, 1)
+	b.NextStop(0)
+	floor, _ := b.ActivateFireRecall()
+	if err := b.CancelCarCall(0, floor, "cleanup"); !errors.Is(err, elevator.ErrWrongMode) {
+		t.Errorf("Cancelling the recall trip should be refused, got %v", err)
+	}
+	if b.GetElevator(0).GetCallList().Len() != 1 {
+		t.Errorf("Elevator should still be recalled to floor %d", floor)
+	}
+	b.NextStop(0)
+	b.SetFirefighterService(0, true, "firekey")
+	b.FirefighterDoorButton(0, false, "firekey")
+	b.FirefighterCarCall(0, 6, "firekey")
+	if err := b.CancelCarCall(0, 6, "cleanup"); !errors.Is(err, elevator.ErrWrongMode) {
+		t.Errorf("Cancelling a firefighter call should be refused, got %v", err)
+	}
+
+	// emergency return
+	b = NewBuilding(1, 10, 1)
+	b.MaintenanceCallOverride(0, 5, 1)
+	b.NextStop(0)
+	b.ActivateEmergencyPower(1)
+	if err := b.CancelCarCall(0, 1, "cleanup"); !errors.Is(err, elevator.ErrWrongMode) {
+		t.Errorf("Cancelling the emergency return should be refused, got %v", err)
+	}
+	if b.GetElevator(0).GetCallList().Len() != 1 {
+		t.Errorf("Elevator should still be returning to the lobby")
+	}
+}
+
+func TestBuildingCancelHallCallPriorityRun(t *testing.T) {
+	b := NewBuilding(1, 10, 1)
+	b.SetPriorityCallKey("codeblue")
+	b.CallElevator(4, 1)
+	b.PriorityCall(6, 2, "codeblue", "nurse", "code blue")
+
+	if err := b.CancelHallCall(4, 1, "double tap"); err != nil {
+		t.Errorf("Hall call should be cancelled during a priority run, got %s", err.Error())
+	}
+	e := b.GetElevator(0)
+	if len(e.DeferredCalls) != 0 {
+		t.Errorf("Cancelled hall call should be dropped from the deferred calls, got %d", len(e.DeferredCalls))
+	}
+
+	// finish the run -- the cancelled call shouldn't come back
+	b.NextStop(0)
+	b.NextStop(0)
+	if e.Mode != elevator.ModeNormal {
+		t.Errorf("Elevator should be back in normal mode, got %s", e.Mode)
+	}
+	if e.GetCallList().Len() != 0 {
+		t.Errorf("Elevator should have an empty call list, got %d", e.GetCallList().Len())
+	}
+}
@@ -0,0 +1,21 @@
+package building
+
+// Commissioning.  During a modernization cars are added and taken away (see
+// AddElevator and RemoveElevator) and floors are added while the building
+// keeps running, so cars are looked up by their ID and never by where they
+// sit in the list.
+
+// ExtendFloors adds floors to the top of the building.  Cars that serve every
+// floor serve the new ones too, cars with a list of floors keep to it.
+func (b *Building) ExtendFloors(numFloors int) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if numFloors <= b.NumFloors {
+		return b.errorf(ErrConflict, -1, "building: %d already has %d floors, floors can only be added", b.ID, b.NumFloors)
+	}
+	b.NumFloors = numFloors
+	for _, e := range b.ElevatorList {
+		e.MaxFloor = numFloors
+	}
+	return nil
+}
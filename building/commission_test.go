@@ -0,0 +1,86 @@
+package building
+
+import (
+	"testing"
+)
+
+func TestBuildingCommissionElevator(t *testing.T) {
+	b := NewBuilding(1, 10, 3)
+	b.SetGroups([]Group{{Name: "all", Elevators: []int{0, 1, 2}}})
+
+	if err := b.RemoveElevator(1); err == nil {
+		t.Errorf("Elevator 1 is in service and should not be removed")
+	}
+	b.PushDestinationButton(1, 7)
+	b.DrainElevator(1)
+	if err := b.RemoveElevator(1); err == nil {
+		t.Errorf("Elevator 1 still has a passenger and should not be removed")
+	}
+	b.CancelCarCall(1, 7, "test")
+	b.SetElevatorInServiceStatus(1, false)
+	if err := b.RemoveElevator(1); err != nil {
+		t.Fatalf("Drained elevator 1 should be removed, got %s", err.Error())
+	}
+	if ids := b.GetElevatorIDs(); len(ids) != 2 || ids[1] != 2 || b.GetElevator(1) != nil {
+		t.Errorf("Building should be left with elevators 0 and 2, got %v", ids)
+	}
+	// the car after the gap is still found by its ID
+	if err := b.PushDestinationButton(2, 9); err != nil || b.GetElevator(2).ElevatorID != 2 {
+		t.Errorf("Elevator 2 should still take calls, got %v", err)
+	}
+	if g := b.GetGroups(); len(g[0].Elevators) != 2 {
+		t.Errorf("Elevator 1 should be gone from its group, got %v", g)
+	}
+
+	elID, err := b.AddElevator(-1, ElevatorSpec{ServedFloors: []int{10, 1}, Capacity: 20})
+	if err != nil || elID != 3 {
+		t.Fatalf("New elevator should be 3, got %d, %v", elID, err)
+	}
+	if e := b.GetElevator(3); !e.InService || e.Serves(5) || e.Capacity != 20 {
+		t.Errorf("Elevator 3 should be in service and skip floor 5, got %v", e)
+	}
+	if _, err := b.AddElevator(1, ElevatorSpec{}); err != nil {
+		t.Errorf("Elevator 1 should be free to use again, got %s", err.Error())
+	}
+	if _, err := b.AddElevator(2, ElevatorSpec{}); err == nil {
+		t.Errorf("Adding a second elevator 2 should fail")
+	}
+	if _, err := b.AddElevator(-1, ElevatorSpec{ServedFloors: []int{12}}); err == nil {
+		t.Errorf("Adding an elevator serving floor 12 should fail")
+	}
+
+	// a reset keeps what the car is like
+	b.ResetElevator(3)
+	if e := b.GetElevator(3); e.Serves(5) || e.Capacity != 20 {
+		t.Errorf("Elevator 3 should still skip floor 5 after a reset")
+	}
+
+	b.ActivateFireRecall()
+	if _, err := b.AddElevator(-1, ElevatorSpec{}); err == nil {
+		t.Errorf("Adding an elevator during fire recall should fail")
+	}
+}
+
+func TestBuildingExtendFloors(t *testing.T) {
+	b := NewBuilding(1, 10, 2)
+	b.ConfigureElevator(1, ElevatorSpec{ServedFloors: []int{1, 10}})
+	if err := b.ExtendFloors(8); err == nil {
+		t.Errorf("Going down to 8 floors should fail")
+	}
+	if err := b.ExtendFloors(12); err != nil {
+		t.Fatalf("Extending to 12 floors should not fail, got %s", err.Error())
+	}
+	if len(b.GetFloors()) != 12 {
+		t.Errorf("Building should have 12 floors, got %d", len(b.GetFloors()))
+	}
+	elID, err := b.CallElevator(12, -1)
+	if err != nil || elID != 0 {
+		t.Errorf("Floor 12 should be served by elevator 0, got %d, %v", elID, err)
+	}
+	if err := b.PushDestinationButton(1, 11); err == nil {
+		t.Errorf("Elevator 1 keeps to its floors and should refuse floor 11")
+	}
+	if err := b.SetFloorLabels(map[int]string{12: "R"}); err != nil {
+		t.Errorf("Floor 12 should take a label, got %s", err.Error())
+	}
+}
@@ -0,0 +1,90 @@
+package building
+
+import (
+	"github.com/tcotav/elevatormgr/elevator"
+)
+
+// Graceful drain.  Rather than dumping a car's calls the way taking it out of
+// service does, a draining car hands its hall calls to the other cars, stops
+// taking new ones, finishes the car calls for the passengers already on board
+// and then parks where it is and goes out of service.
+
+// Reassignment records where a hall call went when it was moved off a car.
+// ElevatorID is -1 if no car could take it.
+type Reassignment struct {
+	Call       elevator.Call
+	ElevatorID int
+}
+
+// DrainElevator starts draining a car and returns where its hall calls went
+func (b *Building) DrainElevator(elevatorID int) ([]Reassignment, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	e := b.GetElevator(elevatorID)
+	if e == nil {
+		return nil, b.errorf(ErrElevatorNotFound, elevatorID, "elevator with ID: %d does not exist in building: %d", elevatorID, b.ID)
+	}
+	if !e.InService {
+		return nil, b.errorf(ErrNotInService, elevatorID, "elevator with ID: %d is not in service in building: %d", elevatorID, b.ID)
+	}
+	// don't strand anybody waiting in the hall -- every hall call needs
+	// another car that stops at its floor
+	for _, call := range e.CallList.Snapshot() {
+		if call.Type == elevator.HallCall && !b.hasDispatchableCar(call.Floor, elevatorID) {
+			return nil, b.errorf(ErrNoElevators, elevatorID, "no other elevators in service to take the hall call at floor: %d of elevator: %d in building: %d", call.Floor, elevatorID, b.ID)
+		}
+	}
+	hallCalls, err := e.StartDrain()
+	if err != nil {
+		return nil, err
+	}
+	reassigned, err := b.reassignHallCalls(hallCalls, elevatorID)
+	if e.CallList.Len() == 0 {
+		b.finishDrain(e)
+	}
+	return reassigned, err
+}
+
+// finishDrain parks a drained car out of service -- caller holds the lock
+func (b *Building) finishDrain(e *elevator.Elevator) {
+	e.Mode = elevator.ModeNormal
+	e.InService = false
+}
+
+// reassignHallCalls dispatches hall calls to the other cars.  Every call gets
+// a Reassignment, the error is set if any of them couldn't be placed.
+// Caller holds the lock.
+func (b *Building) reassignHallCalls(calls []elevator.Call, exclude int) ([]Reassignment, error) {
+	reassigned := make([]Reassignment, 0, len(calls))
+	var firstErr error
+	for _, call := range calls {
+		el, err := b.dispatch(call.Floor, call.Direction, exclude)
+		r := Reassignment{Call: call, ElevatorID: -1}
+		// a duplicate means the other car already has the call, that's fine
+		if el != nil {
+			r.ElevatorID = el.ElevatorID
+			b.assignHallCall(call.Floor, call.Direction, el)
+		} else {
+			key := hallCallKey{call.Floor, call.Direction}
+			if hc, ok := b.hallCalls[key]; ok {
+				b.setTicketStatus(hc.TicketID, CallExpired, -1)
+			}
+			delete(b.hallCalls, key)
+			if firstErr == nil {
+				firstErr = err
+			}
+		}
+		reassigned = append(reassigned, r)
+	}
+	return reassigned, firstErr
+}
+
+// hasDispatchableCar checks for a car that could take a hall call at floor -- caller holds the lock
+func (b *Building) hasDispatchableCar(floor int, exclude int) bool {
+	for _, e := range b.ElevatorList {
+		if dispatchable(e, floor) && e.ElevatorID != exclude {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,75 @@
+package building
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/tcotav/elevatormgr/elevator"
+)
+
+func TestBuildingDrainElevator(t *testing.T) {
+	b := NewBuilding(1, 10, 2)
+
+	// both cars start on floor 1 so the first in the list gets the calls
+	elID, _ := b.CallElevator(4, 1)
+	b.CallElevator(6, -1)
+	b.PushDestinationButton(elID, 8)
+
+	reassigned, err := b.DrainElevator(elID)
+	if err != nil {
+		t.Errorf("Elevator should drain, got %s", err.Error())
+	}
+	if len(reassigned) != 2 {
+		t.Errorf("Both hall calls should be reassigned, got %d", len(reassigned))
+	}
+	for _, r := range reassigned {
+		if r.ElevatorID == elID || r.ElevatorID == -1 {
+			t.Errorf("Hall call %v should go to the other car, got %d", r.Call, r.ElevatorID)
+		}
+	}
+
+	e := b.GetElevator(elID)
+	if e.Mode != elevator.ModeDraining || !e.InService {
+		t.Errorf("Elevator should be draining and still in service")
+	}
+	if e.GetCallList().Len() != 1 {
+		t.Errorf("Elevator should only have its car call left, got %d", e.GetCallList().Len())
+	}
+	if err := b.PushDestinationButton(elID, 3); err == nil {
+		t.Errorf("Draining elevator should not take new calls")
+	}
+
+	b.NextStop(elID)
+	if e.InService {
+		t.Errorf("Elevator should be out of service once it has finished its car calls")
+	}
+	if e.CurrentFloor != 8 {
+		t.Errorf("Elevator should park where it finished, got floor %d", e.CurrentFloor)
+	}
+
+	// the last car can't drain while people are waiting in the hall
+	if _, err := b.DrainElevator(1 - elID); err == nil {
+		t.Errorf("Last car should not drain with hall calls outstanding")
+	}
+}
+
+func TestBuildingDrainElevatorServedFloors(t *testing.T) {
+	b := NewBuilding(1, 10, 2)
+	// car 1 only runs between the bottom five floors
+	b.ConfigureElevator(1, ElevatorSpec{ServedFloors: []int{1, 2, 3, 4, 5}})
+
+	elID, _ := b.CallElevator(3, 1)
+	b.CallElevator(8, -1)
+	if elID != 0 {
+		t.Errorf("Hall call at floor 3 should go to elevator 0, got %d", elID)
+	}
+
+	// car 1 is in service but can't get to floor 8
+	if _, err := b.DrainElevator(0); !errors.Is(err, ErrNoElevators) {
+		t.Errorf("Drain should be refused when a hall call has no other car, got %v", err)
+	}
+	e := b.GetElevator(0)
+	if e.Mode != elevator.ModeNormal || e.GetCallList().Len() != 2 {
+		t.Errorf("Refused drain should leave the car and its calls alone, got %s with %d calls", e.Mode, e.GetCallList().Len())
+	}
+}
@@ -0,0 +1,177 @@
+package building
+
+import (
+	"github.com/tcotav/elevatormgr/elevator"
+)
+
+// Emergency power operation.  The generator can't run every car, so when the
+// building goes on emergency power the cars are returned to the lobby one at a
+// time.  Once they're all down, only the selected cars go back into normal
+// service and the rest stay parked.  Maintenance can change which cars run.
+
+// EmergencyPowerStatus is the building's emergency power state for the API
+type EmergencyPowerStatus struct {
+	Active      bool
+	RunningCars int
+	Selected    []int
+	ReturnQueue []int
+}
+
+// ActivateEmergencyPower puts the building on emergency power with at most
+// runningCars cars allowed to run once the cars have been returned
+func (b *Building) ActivateEmergencyPower(runningCars int) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.EmergencyPower {
+		return b.errorf(ErrConflict, -1, "building: %d is already on emergency power", b.ID)
+	}
+	if runningCars < 0 {
+		return b.errorf(ErrInvalidArgument, -1, "invalid number of running cars: %d in building: %d", runningCars, b.ID)
+	}
+
+	// default selection is the first in service cars, maintenance can change it
+	selected := make([]int, 0)
+	queue := make([]int, 0)
+	for _, e := range b.ElevatorList {
+		if !e.InService || e.Mode != elevator.ModeNormal {
+			continue
+		}
+		if len(selected) < runningCars {
+			selected = append(selected, e.ElevatorID)
+		}
+		e.EmergencyHold()
+		queue = append(queue, e.ElevatorID)
+	}
+
+	b.EmergencyPower = true
+	b.emergencyRunningCars = runningCars
+	b.emergencySelected = selected
+	b.emergencyReturnQueue = queue
+	return b.returnNextEmergencyCar()
+}
+
+// SetEmergencyPowerCars changes which cars run on emergency power
+func (b *Building) SetEmergencyPowerCars(elevatorIDs []int) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if !b.EmergencyPower {
+		return b.errorf(ErrConflict, -1, "building: %d is not on emergency power", b.ID)
+	}
+	if len(elevatorIDs) > b.emergencyRunningCars {
+		return b.errorf(ErrInvalidArgument, -1, "only %d cars can run on emergency power in building: %d, got %d", b.emergencyRunningCars, b.ID, len(elevatorIDs))
+	}
+	for i, elevatorID := range elevatorIDs {
+		e := b.GetElevator(elevatorID)
+		if e == nil {
+			return b.errorf(ErrElevatorNotFound, elevatorID, "elevator with ID: %d does not exist in building: %d", elevatorID, b.ID)
+		}
+		if !e.InService {
+			return b.errorf(ErrNotInService, elevatorID, "elevator with ID: %d is not in service in building: %d", elevatorID, b.ID)
+		}
+		for _, other := range elevatorIDs[:i] {
+			if other == elevatorID {
+				return b.errorf(ErrInvalidArgument, elevatorID, "elevator with ID: %d selected twice in building: %d", elevatorID, b.ID)
+			}
+		}
+	}
+	b.emergencySelected = elevatorIDs
+
+	// cars still on their way down pick up the new selection when they arrive
+	if len(b.emergencyReturnQueue) > 0 {
+		return nil
+	}
+	for _, e := range b.ElevatorList {
+		selected := b.isEmergencySelected(e.ElevatorID)
+		if selected && e.Mode == elevator.ModeEmergencyParked {
+			e.Mode = elevator.ModeNormal
+		}
+		// a deselected car drops its calls and heads back to the lobby to park
+		if !selected && e.InService && e.Mode == elevator.ModeNormal {
+			if err := e.EmergencyReturn(b.LobbyFloor); err != nil {
+				return err
+			}
+			if e.CallList.Len() == 0 {
+				e.Mode = elevator.ModeEmergencyParked
+			}
+		}
+	}
+	return nil
+}
+
+// EndEmergencyPower puts every car back into normal service
+func (b *Building) EndEmergencyPower() error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if !b.EmergencyPower {
+		return b.errorf(ErrConflict, -1, "building: %d is not on emergency power", b.ID)
+	}
+	for _, e := range b.ElevatorList {
+		if e.Mode == elevator.ModeEmergencyReturn || e.Mode == elevator.ModeEmergencyParked {
+			e.CallList.Clear()
+			e.Mode = elevator.ModeNormal
+		}
+	}
+	b.EmergencyPower = false
+	b.emergencyRunningCars = 0
+	b.emergencySelected = nil
+	b.emergencyReturnQueue = nil
+	return nil
+}
+
+// GetEmergencyPowerStatus returns the emergency power state of the building
+func (b *Building) GetEmergencyPowerStatus() EmergencyPowerStatus {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return EmergencyPowerStatus{
+		Active:      b.EmergencyPower,
+		RunningCars: b.emergencyRunningCars,
+		Selected:    append([]int{}, b.emergencySelected...),
+		ReturnQueue: append([]int{}, b.emergencyReturnQueue...),
+	}
+}
+
+// emergencyCarReturned parks a car that made it to the lobby and sends the next one -- caller holds the lock
+func (b *Building) emergencyCarReturned(e *elevator.Elevator) {
+	e.Mode = elevator.ModeEmergencyParked
+	if len(b.emergencyReturnQueue) > 0 && b.emergencyReturnQueue[0] == e.ElevatorID {
+		b.emergencyReturnQueue = b.emergencyReturnQueue[1:]
+	}
+	b.returnNextEmergencyCar()
+}
+
+// returnNextEmergencyCar sends the car at the head of the return queue to the
+// lobby.  Cars already there are parked straight away.  When the queue is empty
+// the selected cars go back into service.  Caller holds the lock.
+func (b *Building) returnNextEmergencyCar() error {
+	for len(b.emergencyReturnQueue) > 0 {
+		e := b.GetElevator(b.emergencyReturnQueue[0])
+		if e != nil && e.InService {
+			if err := e.EmergencyReturn(b.LobbyFloor); err != nil {
+				return err
+			}
+			if e.CallList.Len() > 0 {
+				// on its way -- NextStop will bring us back here when it arrives
+				return nil
+			}
+			e.Mode = elevator.ModeEmergencyParked
+		}
+		b.emergencyReturnQueue = b.emergencyReturnQueue[1:]
+	}
+	for _, elevatorID := range b.emergencySelected {
+		e := b.GetElevator(elevatorID)
+		if e != nil && e.InService && e.Mode == elevator.ModeEmergencyParked {
+			e.Mode = elevator.ModeNormal
+		}
+	}
+	return nil
+}
+
+// isEmergencySelected -- caller holds the lock
+func (b *Building) isEmergencySelected(elevatorID int) bool {
+	for _, id := range b.emergencySelected {
+		if id == elevatorID {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,104 @@
+package building
+
+import (
+	"testing"
+
+	"github.com/tcotav/elevatormgr/elevator"
+)
+
+func TestBuildingEmergencyPower(t *testing.T) {
+	b := NewBuilding(1, 10, 3)
+
+	// get cars 0 and 1 up the building
+	b.MaintenanceCallOverride(0, 5, 1)
+	b.NextStop(0)
+	b.MaintenanceCallOverride(1, 8, 1)
+	b.NextStop(1)
+
+	err := b.ActivateEmergencyPower(1)
+	if err != nil {
+		t.Errorf("Emergency power should activate, got %s", err.Error())
+	}
+
+	// car 0 goes first, car 1 waits its turn
+	e0 := b.GetElevator(0)
+	e1 := b.GetElevator(1)
+	if e0.GetCallList().Len() != 1 {
+		t.Errorf("Elevator 0 should be returning to the lobby")
+	}
+	if e1.GetCallList().Len() != 0 {
+		t.Errorf("Elevator 1 should be waiting for its turn, got %d calls", e1.GetCallList().Len())
+	}
+	if _, err := b.CallElevator(3, 1); err == nil {
+		t.Errorf("Hall calls should be refused until the cars are returned")
+	}
+
+	b.NextStop(0)
+	if e0.Mode != elevator.ModeEmergencyParked {
+		t.Errorf("Elevator 0 should be parked, got %s", e0.Mode)
+	}
+	if e1.GetCallList().Len() != 1 {
+		t.Errorf("Elevator 1 should now be returning to the lobby")
+	}
+	b.NextStop(1)
+
+	// everybody is down -- only the selected car runs
+	status := b.GetEmergencyPowerStatus()
+	if len(status.ReturnQueue) != 0 {
+		t.Errorf("Return queue should be empty, got %v", status.ReturnQueue)
+	}
+	if e0.Mode != elevator.ModeNormal {
+		t.Errorf("Elevator 0 should be running, got %s", e0.Mode)
+	}
+	if e1.Mode != elevator.ModeEmergencyParked || b.GetElevator(2).Mode != elevator.ModeEmergencyParked {
+		t.Errorf("Elevators 1 and 2 should be parked")
+	}
+	elID, err := b.CallElevator(3, 1)
+	if err != nil || elID != 0 {
+		t.Errorf("Hall call should go to elevator 0, got %d", elID)
+	}
+
+	if err := b.SetEmergencyPowerCars([]int{1, 2}); err == nil {
+		t.Errorf("Selecting more cars than the generator can run should fail")
+	}
+	if err := b.SetEmergencyPowerCars([]int{2}); err != nil {
+		t.Errorf("Selection should change, got %s", err.Error())
+	}
+	if b.GetElevator(2).Mode != elevator.ModeNormal {
+		t.Errorf("Elevator 2 should be running")
+	}
+	if e0.Mode != elevator.ModeEmergencyParked {
+		t.Errorf("Elevator 0 should be parked at the lobby, got %s", e0.Mode)
+	}
+
+	if err := b.EndEmergencyPower(); err != nil {
+		t.Errorf("Emergency power should end, got %s", err.Error())
+	}
+	for _, e := range b.GetElevatorList() {
+		if e.Mode != elevator.ModeNormal {
+			t.Errorf("Elevator %d should be back in normal mode, got %s", e.ElevatorID, e.Mode)
+		}
+	}
+}
+
+func TestBuildingEmergencyPowerRefusesReset(t *testing.T) {
+	b := NewBuilding(1, 10, 3)
+	b.MaintenanceCallOverride(0, 5, 1)
+	b.NextStop(0)
+	b.ActivateEmergencyPower(1)
+
+	// swapping in a fresh car would leave the queue waiting on car 0 forever
+	if _, applied, err := b.ResetElevator(0); applied || err == nil {
+		t.Errorf("Reset should be refused on emergency power")
+	}
+	if _, applied, err := b.SetElevatorInServiceStatus(0, false); applied || err == nil {
+		t.Errorf("Out of service should be refused on emergency power")
+	}
+	if b.GetElevator(0).Mode != elevator.ModeEmergencyReturn {
+		t.Errorf("Elevator 0 should still be returning, got %s", b.GetElevator(0).Mode)
+	}
+	b.NextStop(0)
+	if status := b.GetEmergencyPowerStatus(); len(status.ReturnQueue) != 0 {
+		t.Errorf("Return queue should be empty once car 0 is down, got %v", status.ReturnQueue)
+	}
+}
@@ -0,0 +1,36 @@
+package building
+
+import (
+	"errors"
+
+	"github.com/tcotav/elevatormgr/elevator"
+)
+
+// Sentinel errors, check for them with errors.Is.  What the building returns
+// is an elevator.Error wrapping one of these or one of the elevator package's,
+// with the building and car it's about.
+var (
+	// there's no car with that ID
+	ErrElevatorNotFound = errors.New("elevator not found")
+	// the car is out of service
+	ErrNotInService = errors.New("not in service")
+	// there's no such ticket, hall call or car call
+	ErrCallNotFound = errors.New("call not found")
+	// the floor isn't in the building, the same as the elevator package's
+	ErrInvalidFloor = elevator.ErrInvalidFloor
+	// a setting or argument that can never work -- direction, policy, spec
+	ErrInvalidArgument = errors.New("invalid argument")
+	// the building can't do it as things stand -- fire recall, emergency
+	// power, calls still pending on the car
+	ErrConflict = errors.New("conflict")
+	// no car is able to take the call
+	ErrNoElevators = errors.New("no elevators available")
+	// the fire service or priority call key is wrong
+	ErrNotAuthorized = errors.New("not authorized")
+)
+
+// errorf is elevator.Errorf for this building, elevatorID is -1 when the
+// error isn't about a particular car
+func (b *Building) errorf(err error, elevatorID int, format string, a ...interface{}) error {
+	return elevator.Errorf(err, b.ID, elevatorID, format, a...)
+}
@@ -0,0 +1,51 @@
+package building
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/tcotav/elevatormgr/elevator"
+)
+
+func TestBuildingErrors(t *testing.T) {
+	b := NewBuilding(4, 10, 2)
+
+	_, err := b.RegisterCarCall(5, 3)
+	if !errors.Is(err, ErrElevatorNotFound) {
+		t.Errorf("Elevator 5 should not be found, got %v", err)
+	}
+	var e *elevator.Error
+	if !errors.As(err, &e) || e.BuildingID != 4 || e.ElevatorID != 5 {
+		t.Errorf("Error should be about elevator 5 in building 4, got %+v", e)
+	}
+
+	b.SetElevatorInServiceStatus(1, false)
+	if _, err := b.RegisterCarCall(1, 3); !errors.Is(err, ErrNotInService) {
+		t.Errorf("Elevator 1 should not be in service, got %v", err)
+	}
+	if err := b.CancelHallCall(3, 1, ""); !errors.Is(err, ErrCallNotFound) {
+		t.Errorf("There should be no hall call at 3, got %v", err)
+	}
+	if _, err := b.RegisterHallCall(3, 2); !errors.Is(err, ErrInvalidArgument) {
+		t.Errorf("Direction 2 should be an invalid argument, got %v", err)
+	}
+
+	// errors from the car come through with their own sentinel
+	_, err = b.RegisterCarCall(0, 11)
+	if !errors.Is(err, ErrInvalidFloor) || !errors.Is(err, elevator.ErrInvalidFloor) {
+		t.Errorf("Floor 11 should be invalid, got %v", err)
+	}
+	if errors.As(err, &e) && e.ElevatorID != 0 {
+		t.Errorf("Error should be about elevator 0, got %d", e.ElevatorID)
+	}
+
+	if _, err := b.ActivateFireRecall(); err != nil {
+		t.Errorf("Fire recall should activate, got %s", err.Error())
+	}
+	if _, err := b.RegisterHallCall(3, 1); !errors.Is(err, ErrConflict) {
+		t.Errorf("Hall calls should conflict with fire recall, got %v", err)
+	}
+	if err := b.SetFirefighterService(0, true, "wrong key"); !errors.Is(err, ErrNotAuthorized) {
+		t.Errorf("The wrong key should not be authorized, got %v", err)
+	}
+}
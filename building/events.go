@@ -0,0 +1,66 @@
+package building
+
+import (
+	"time"
+)
+
+// Building events.  Anything that wants to know when the building changes a
+// call -- the server log, a status stream -- subscribes and gets a channel.
+// Events are sent without blocking, a subscriber that falls behind misses them.
+
+// EventType says what happened
+type EventType string
+
+const (
+	EventHallCallReassigned EventType = "hallCallReassigned"
+	EventCallCancelled      EventType = "callCancelled"
+	EventCallStatus         EventType = "callStatus"
+	EventCallOverdue        EventType = "callOverdue"
+)
+
+// Event is something that happened to a call in the building
+type Event struct {
+	Type           EventType
+	Time           time.Time
+	BuildingID     int
+	Floor          int
+	Direction      int
+	ElevatorID     int
+	FromElevatorID int
+	TicketID       string     `json:",omitempty"`
+	Status         CallStatus `json:",omitempty"`
+	Reason         string     `json:",omitempty"`
+}
+
+// Subscribe returns a channel of building events and a func to stop them
+func (b *Building) Subscribe(buffer int) (<-chan Event, func()) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	ch := make(chan Event, buffer)
+	b.nextSubscriberID++
+	id := b.nextSubscriberID
+	b.subscribers[id] = ch
+	unsubscribe := func() {
+		b.mu.Lock()
+		defer b.mu.Unlock()
+		if _, ok := b.subscribers[id]; ok {
+			delete(b.subscribers, id)
+			close(ch)
+		}
+	}
+	return ch, unsubscribe
+}
+
+// emit sends an event to every subscriber -- caller holds the lock
+func (b *Building) emit(ev Event) {
+	ev.BuildingID = b.ID
+	if ev.Time.IsZero() {
+		ev.Time = b.clock()
+	}
+	for _, ch := range b.subscribers {
+		select {
+		case ch <- ev:
+		default:
+		}
+	}
+}
@@ -0,0 +1,40 @@
+package building
+
+import (
+	"testing"
+)
+
+// nextEvent reads buffered events until it finds one of the given type
+func nextEvent(events <-chan Event, eventType EventType) (Event, bool) {
+	for {
+		select {
+		case ev := <-events:
+			if ev.Type == eventType {
+				return ev, true
+			}
+		default:
+			return Event{}, false
+		}
+	}
+}
+
+func TestBuildingSubscribe(t *testing.T) {
+	b := NewBuilding(1, 10, 2)
+	events, unsubscribe := b.Subscribe(10)
+
+	b.CallElevator(4, 1)
+	ev, ok := nextEvent(events, EventCallStatus)
+	if !ok {
+		t.Errorf("Expected a call status event")
+	}
+	if ev.BuildingID != 1 || ev.Floor != 4 {
+		t.Errorf("Expected an event for floor 4 in building 1, got %v", ev)
+	}
+
+	unsubscribe()
+	for range events {
+		// drain whatever was buffered before the close
+	}
+	// no panic sending after unsubscribe
+	b.CallElevator(6, 1)
+}
@@ -0,0 +1,150 @@
+package building
+
+import (
+	"github.com/tcotav/elevatormgr/elevator"
+)
+
+// Fire service Phase I recall.  On activation every in-service car drops its
+// calls and runs express to the recall floor, or to the alternate recall floor
+// when the smoke detector on the main recall floor is active.  Cars park there
+// with the doors open and normal hall and car calls are refused until the
+// recall is reset.
+
+// Phase II hands a recalled car over to firefighters who drive it with the
+// fire service key.  Hall calls are ignored, car calls are taken one at a time
+// and the doors only open while the door open button is held.
+
+// SetFireServiceKey sets the key firefighters need for Phase II
+func (b *Building) SetFireServiceKey(key string) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.fireServiceKey = key
+}
+
+// SetSmokeDetector records the state of the smoke detector on a floor
+func (b *Building) SetSmokeDetector(floor int, active bool) error {
+	if floor < 1 || floor > b.NumFloors {
+		return b.errorf(ErrInvalidFloor, -1, "invalid floor: %d in building: %d", floor, b.ID)
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.smokeDetectors[floor] = active
+	return nil
+}
+
+// ActivateFireRecall puts the building into Phase I recall and returns the
+// floor the cars were sent to.  Calling it again while active is a NOOP.
+func (b *Building) ActivateFireRecall() (int, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.FireRecall {
+		return b.activeRecallFloor, nil
+	}
+	recallFloor := b.RecallFloor
+	if b.smokeDetectors[recallFloor] {
+		recallFloor = b.AlternateRecallFloor
+	}
+	if recallFloor < 1 || recallFloor > b.NumFloors {
+		return -1, b.errorf(ErrInvalidFloor, -1, "invalid recall floor: %d in building: %d", recallFloor, b.ID)
+	}
+	for _, e := range b.ElevatorList {
+		if !e.InService {
+			continue
+		}
+		if err := e.Recall(recallFloor); err != nil {
+			return -1, err
+		}
+	}
+	b.FireRecall = true
+	b.activeRecallFloor = recallFloor
+	return recallFloor, nil
+}
+
+// ResetFireRecall returns the cars to normal service where they are parked
+func (b *Building) ResetFireRecall() error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if !b.FireRecall {
+		return b.errorf(ErrConflict, -1, "building: %d is not in fire recall", b.ID)
+	}
+	for _, e := range b.ElevatorList {
+		if e.Mode == elevator.ModeFirefighter {
+			return b.errorf(ErrConflict, e.ElevatorID, "elevator: %d is still in firefighter service in building: %d", e.ElevatorID, b.ID)
+		}
+	}
+	for _, e := range b.ElevatorList {
+		if e.Mode == elevator.ModeFireRecall {
+			e.ClearRecall()
+		}
+	}
+	b.FireRecall = false
+	b.activeRecallFloor = 0
+	return nil
+}
+
+// SetFirefighterService switches a recalled car in or out of Phase II.  Switching
+// it out sends it back to the recall floor.
+func (b *Building) SetFirefighterService(elevatorID int, on bool, key string) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	e, err := b.firefighterElevator(elevatorID, key)
+	if err != nil {
+		return err
+	}
+	if on {
+		return e.StartFirefighterService()
+	}
+	if e.Mode != elevator.ModeFirefighter {
+		// NOOP
+		return nil
+	}
+	return e.Recall(b.activeRecallFloor)
+}
+
+// FirefighterCarCall registers a car call from the firefighter key
+func (b *Building) FirefighterCarCall(elevatorID int, floor int, key string) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	e, err := b.firefighterElevator(elevatorID, key)
+	if err != nil {
+		return err
+	}
+	return e.FirefighterCarCall(floor)
+}
+
+// FirefighterDoorButton opens the doors while pressed and closes them on release
+func (b *Building) FirefighterDoorButton(elevatorID int, pressed bool, key string) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	e, err := b.firefighterElevator(elevatorID, key)
+	if err != nil {
+		return err
+	}
+	return e.FirefighterDoorButton(pressed)
+}
+
+// firefighterElevator does the shared key, recall and elevator checks -- caller holds the lock
+func (b *Building) firefighterElevator(elevatorID int, key string) (*elevator.Elevator, error) {
+	if err := b.checkFireServiceKey(key); err != nil {
+		return nil, err
+	}
+	if !b.FireRecall {
+		return nil, b.errorf(ErrConflict, -1, "building: %d is not in fire recall", b.ID)
+	}
+	e := b.GetElevator(elevatorID)
+	if e == nil {
+		return nil, b.errorf(ErrElevatorNotFound, elevatorID, "elevator with ID: %d does not exist in building: %d", elevatorID, b.ID)
+	}
+	if !e.InService {
+		return nil, b.errorf(ErrNotInService, elevatorID, "elevator with ID: %d is not in service in building: %d", elevatorID, b.ID)
+	}
+	return e, nil
+}
+
+// checkFireServiceKey -- caller holds the lock
+func (b *Building) checkFireServiceKey(key string) error {
+	if b.fireServiceKey == "" || key != b.fireServiceKey {
+		return b.errorf(ErrNotAuthorized, -1, "not authorized for fire service in building: %d", b.ID)
+	}
+	return nil
+}
@@ -0,0 +1,149 @@
+package building
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/tcotav/elevatormgr/elevator"
+)
+
+func TestBuildingFireRecall(t *testing.T) {
+	b := NewBuilding(1, 10, 2)
+	b.SetFireServiceKey("firekey")
+
+	elID, err := b.CallElevator(5, 1)
+	if err != nil {
+		t.Errorf("Elevator should be called, got %s", err.Error())
+	}
+	b.PushDestinationButton(elID, 8)
+
+	floor, err := b.ActivateFireRecall()
+	if err != nil {
+		t.Errorf("Fire recall should activate, got %s", err.Error())
+	}
+	if floor != 1 {
+		t.Errorf("Cars should be recalled to floor 1, got %d", floor)
+	}
+	for _, e := range b.GetElevatorList() {
+		if e.Mode != elevator.ModeFireRecall {
+			t.Errorf("Elevator %d should be in fire recall, got %s", e.ElevatorID, e.Mode)
+		}
+		// both cars start on floor 1 so they're already parked
+		if e.GetCallList().Len() != 0 {
+			t.Errorf("Elevator %d should have an empty call list, got %d", e.ElevatorID, e.GetCallList().Len())
+		}
+		if !e.DoorsOpen {
+			t.Errorf("Elevator %d should be parked with doors open", e.ElevatorID)
+		}
+	}
+
+	if _, err := b.CallElevator(3, 1); err == nil {
+		t.Errorf("Hall calls should be refused during fire recall")
+	}
+	if err := b.PushDestinationButton(elID, 4); err == nil {
+		t.Errorf("Car calls should be refused during fire recall")
+	}
+
+	if err := b.ResetFireRecall(); err != nil {
+		t.Errorf("Fire recall should reset, got %s", err.Error())
+	}
+	if _, err := b.CallElevator(3, 1); err != nil {
+		t.Errorf("Hall calls should be accepted after reset, got %s", err.Error())
+	}
+}
+
+func TestBuildingFireRecallAlternateFloor(t *testing.T) {
+	b := NewBuilding(1, 10, 2)
+	b.SetSmokeDetector(1, true)
+
+	// move a car up the building so it has somewhere to go
+	elID, _ := b.CallElevator(6, 1)
+	b.NextStop(elID)
+
+	floor, err := b.ActivateFireRecall()
+	if err != nil {
+		t.Errorf("Fire recall should activate, got %s", err.Error())
+	}
+	if floor != 2 {
+		t.Errorf("Cars should be recalled to alternate floor 2, got %d", floor)
+	}
+	e := b.GetElevator(elID)
+	if e.DoorsOpen {
+		t.Errorf("Elevator should have doors closed while travelling to the recall floor")
+	}
+	call, err := b.NextStop(elID)
+	if err != nil {
+		t.Errorf("Elevator should have a next stop, got %s", err.Error())
+	}
+	if call.Floor != 2 {
+		t.Errorf("Elevator should be going to floor 2, got %d", call.Floor)
+	}
+	if !e.DoorsOpen {
+		t.Errorf("Elevator should park with doors open at the recall floor")
+	}
+}
+
+func TestBuildingFirefighterService(t *testing.T) {
+	b := NewBuilding(1, 10, 2)
+	b.SetFireServiceKey("firekey")
+
+	if err := b.SetFirefighterService(0, true, "firekey"); err == nil {
+		t.Errorf("Firefighter service should need fire recall first")
+	}
+	b.ActivateFireRecall()
+
+	if err := b.SetFirefighterService(0, true, "wrongkey"); err == nil {
+		t.Errorf("Firefighter service should need the fire service key")
+	}
+	if err := b.SetFirefighterService(0, true, "firekey"); err != nil {
+		t.Errorf("Firefighter service should start, got %s", err.Error())
+	}
+
+	// doors are still open from the recall -- let go of the button to close them
+	if err := b.FirefighterCarCall(0, 6, "firekey"); err == nil {
+		t.Errorf("Firefighter call should be refused with the doors open")
+	}
+	b.FirefighterDoorButton(0, false, "firekey")
+	if err := b.FirefighterCarCall(0, 6, "firekey"); err != nil {
+		t.Errorf("Firefighter call should be accepted, got %s", err.Error())
+	}
+	if err := b.FirefighterCarCall(0, 7, "firekey"); err == nil {
+		t.Errorf("Firefighter calls should be taken one at a time")
+	}
+	if err := b.PushDestinationButton(0, 7); err == nil {
+		t.Errorf("Normal car calls should be refused in firefighter service")
+	}
+
+	call, err := b.NextStop(0)
+	if err != nil {
+		t.Errorf("Elevator should have a next stop, got %s", err.Error())
+	}
+	if call.Floor != 6 {
+		t.Errorf("Elevator should be going to floor 6, got %d", call.Floor)
+	}
+	e := b.GetElevator(0)
+	if e.DoorsOpen {
+		t.Errorf("Doors should stay closed on arrival in firefighter service")
+	}
+	b.FirefighterDoorButton(0, true, "firekey")
+	if !e.DoorsOpen {
+		t.Errorf("Doors should open while the button is held")
+	}
+
+	state, _ := e.GetState()
+	var stateMap map[string]interface{}
+	json.Unmarshal(state, &stateMap)
+	if stateMap["Mode"] != string(elevator.ModeFirefighter) {
+		t.Errorf("State should report firefighter mode, got %v", stateMap["Mode"])
+	}
+
+	if err := b.ResetFireRecall(); err == nil {
+		t.Errorf("Fire recall reset should wait for firefighter service to end")
+	}
+	if err := b.SetFirefighterService(0, false, "firekey"); err != nil {
+		t.Errorf("Firefighter service should end, got %s", err.Error())
+	}
+	if e.Mode != elevator.ModeFireRecall {
+		t.Errorf("Elevator should return to fire recall, got %s", e.Mode)
+	}
+}
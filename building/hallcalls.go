@@ -0,0 +1,165 @@
+package building
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/tcotav/elevatormgr/elevator"
+)
+
+// The hall call registry.  The building keeps track of every outstanding hall
+// call -- one per floor and direction, the lit hall lantern -- and the car it's
+// currently assigned to.  A second push of the same button joins the existing
+// call and the call can be moved between cars as things change.  The calls
+// themselves still live on the assigned car's call list.
+
+// HallCall is an outstanding hall call and the car that's answering it
+type HallCall struct {
+	Floor        int
+	Direction    int
+	ElevatorID   int
+	RegisteredAt time.Time
+	AssignedAt   time.Time
+	TicketID     string
+	EscalatedAt  time.Time `json:",omitempty"`
+}
+
+type hallCallKey struct {
+	floor     int
+	direction int
+}
+
+// GetHallCalls returns the outstanding hall calls, by floor then direction
+func (b *Building) GetHallCalls() []HallCall {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.prune()
+	hallCalls := make([]HallCall, 0, len(b.hallCalls))
+	for _, hc := range b.hallCalls {
+		hallCalls = append(hallCalls, *hc)
+	}
+	sort.Slice(hallCalls, func(i, j int) bool {
+		if hallCalls[i].Floor != hallCalls[j].Floor {
+			return hallCalls[i].Floor < hallCalls[j].Floor
+		}
+		return hallCalls[i].Direction < hallCalls[j].Direction
+	})
+	return hallCalls
+}
+
+// ReassignHallCall moves an outstanding hall call to a different car
+func (b *Building) ReassignHallCall(floor int, direction int, elevatorID int) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.prune()
+	hc, ok := b.hallCalls[hallCallKey{floor, direction}]
+	if !ok {
+		return b.errorf(ErrCallNotFound, -1, "no hall call at floor: %d direction: %d in building: %d", floor, direction, b.ID)
+	}
+	e := b.GetElevator(elevatorID)
+	if e == nil {
+		return b.errorf(ErrElevatorNotFound, elevatorID, "elevator with ID: %d does not exist in building: %d", elevatorID, b.ID)
+	}
+	if !e.InService {
+		return b.errorf(ErrNotInService, elevatorID, "elevator with ID: %d is not in service in building: %d", elevatorID, b.ID)
+	}
+	if hc.ElevatorID == elevatorID {
+		// NOOP
+		return nil
+	}
+	return b.moveHallCall(hc, e)
+}
+
+// registerHallCall adds a newly dispatched hall call -- caller holds the lock
+func (b *Building) registerHallCall(floor int, direction int, e *elevator.Elevator) *HallCall {
+	now := b.clock()
+	t := b.newTicket(elevator.HallCall, floor, direction, e.ElevatorID)
+	hc := &HallCall{
+		Floor:        floor,
+		Direction:    direction,
+		ElevatorID:   e.ElevatorID,
+		RegisteredAt: now,
+		AssignedAt:   now,
+		TicketID:     t.ID,
+	}
+	b.hallCalls[hallCallKey{floor, direction}] = hc
+	return hc
+}
+
+// assignHallCall records that a car has taken a hall call, registering it if
+// it's new -- caller holds the lock
+func (b *Building) assignHallCall(floor int, direction int, e *elevator.Elevator) {
+	hc, ok := b.hallCalls[hallCallKey{floor, direction}]
+	if !ok {
+		b.registerHallCall(floor, direction, e)
+		return
+	}
+	if hc.ElevatorID != e.ElevatorID {
+		hc.ElevatorID = e.ElevatorID
+		hc.AssignedAt = b.clock()
+		b.setTicketStatus(hc.TicketID, CallReassigned, e.ElevatorID)
+	}
+}
+
+// moveHallCall takes a hall call off its car and gives it to another -- caller holds the lock
+func (b *Building) moveHallCall(hc *HallCall, to *elevator.Elevator) error {
+	call := elevator.Call{Floor: hc.Floor, Direction: hc.Direction, Type: elevator.HallCall}
+	from := b.GetElevator(hc.ElevatorID)
+	if err := to.CallElevator(hc.Floor, hc.Direction); err != nil {
+		return fmt.Errorf("could not move hall call at floor: %d to elevator: %d in building: %d: %w", hc.Floor, to.ElevatorID, b.ID, err)
+	}
+	if from != nil {
+		from.CallList.Remove(call)
+	}
+	hc.ElevatorID = to.ElevatorID
+	hc.AssignedAt = b.clock()
+	b.setTicketStatus(hc.TicketID, CallReassigned, to.ElevatorID)
+	return nil
+}
+
+// hallCallAnswered clears a hall call once its car gets there -- caller holds the lock
+func (b *Building) hallCallAnswered(floor int, direction int, e *elevator.Elevator) {
+	key := hallCallKey{floor, direction}
+	if hc, ok := b.hallCalls[key]; ok && hc.ElevatorID == e.ElevatorID {
+		delete(b.hallCalls, key)
+		b.setTicketStatus(hc.TicketID, CallAnswered, e.ElevatorID)
+		// the ticket's done once the car leaves again
+		b.answered[e.ElevatorID] = append(b.answered[e.ElevatorID], hc.TicketID)
+	}
+}
+
+// pruneHallCalls drops registry entries whose car no longer has the call, like
+// when a fire recall or emergency power wipes the call lists -- caller holds the lock
+func (b *Building) pruneHallCalls() {
+	for key, hc := range b.hallCalls {
+		call := elevator.Call{Floor: hc.Floor, Direction: hc.Direction, Type: elevator.HallCall}
+		if !b.carHasCall(hc.ElevatorID, call) {
+			delete(b.hallCalls, key)
+			b.setTicketStatus(hc.TicketID, CallExpired, hc.ElevatorID)
+		}
+	}
+}
+
+// carHasCall checks a car still has a call -- caller holds the lock
+func (b *Building) carHasCall(elevatorID int, call elevator.Call) bool {
+	e := b.GetElevator(elevatorID)
+	if e == nil {
+		return false
+	}
+	if e.CallList.Contains(call) {
+		return true
+	}
+	// a priority run puts the car's calls aside for a while
+	return e.Mode == elevator.ModePriority && deferred(e, call)
+}
+
+// deferred checks whether a call is one the car put aside for a priority run
+func deferred(e *elevator.Elevator, call elevator.Call) bool {
+	for _, c := range e.DeferredCalls {
+		if c.Same(call) {
+			return true
+		}
+	}
+	return false
+}
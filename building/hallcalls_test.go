@@ -0,0 +1,55 @@
+package building
+
+import (
+	"testing"
+)
+
+func TestBuildingHallCallRegistry(t *testing.T) {
+	b := NewBuilding(1, 10, 2)
+
+	elID, err := b.CallElevator(4, 1)
+	if err != nil {
+		t.Errorf("Elevator should be called, got %s", err.Error())
+	}
+	// pushing the same button again joins the existing call
+	again, err := b.CallElevator(4, 1)
+	if err != nil {
+		t.Errorf("Duplicate hall call should be accepted, got %s", err.Error())
+	}
+	if again != elID {
+		t.Errorf("Duplicate hall call should go to elevator %d, got %d", elID, again)
+	}
+	b.CallElevator(4, -1)
+
+	hallCalls := b.GetHallCalls()
+	if len(hallCalls) != 2 {
+		t.Errorf("There should be 2 hall calls, got %d", len(hallCalls))
+	}
+	if hallCalls[0].Floor != 4 || hallCalls[0].Direction != -1 || hallCalls[0].ElevatorID != elID {
+		t.Errorf("First hall call should be floor 4 down on elevator %d, got %v", elID, hallCalls[0])
+	}
+
+	other := 1 - elID
+	if err := b.ReassignHallCall(4, 1, other); err != nil {
+		t.Errorf("Hall call should be reassigned, got %s", err.Error())
+	}
+	if b.GetElevator(elID).GetCallList().Len() != 1 || b.GetElevator(other).GetCallList().Len() != 1 {
+		t.Errorf("Each car should have one hall call after the move")
+	}
+	if err := b.ReassignHallCall(5, 1, other); err == nil {
+		t.Errorf("Reassigning a hall call that doesn't exist should fail")
+	}
+
+	// answering the call turns the lantern off
+	b.NextStop(other)
+	hallCalls = b.GetHallCalls()
+	if len(hallCalls) != 1 || hallCalls[0].Direction != -1 {
+		t.Errorf("Only the down call should be left, got %v", hallCalls)
+	}
+
+	// fire recall wipes the call lists and the registry with them
+	b.ActivateFireRecall()
+	if len(b.GetHallCalls()) != 0 {
+		t.Errorf("Fire recall should clear the hall calls")
+	}
+}
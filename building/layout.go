@@ -0,0 +1,312 @@
+package building
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/tcotav/elevatormgr/elevator"
+)
+
+// Building layout -- floor labels, what each car is like, how the cars are
+// grouped into banks and how hall calls are handed out.  All of it comes from
+// the config file, see the config package.
+
+// DispatchStrategy is how a hall call picks its car
+type DispatchStrategy string
+
+const (
+	// DispatchClosest sends the nearest car that can take the call
+	DispatchClosest DispatchStrategy = "closest"
+	// DispatchShortestWait sends the car that would get there first, counting
+	// the stops it already has
+	DispatchShortestWait DispatchStrategy = "shortest-wait"
+)
+
+// a typical storey, used to turn a car's speed into time per floor
+const floorHeight = 3.5 // metres
+
+// Floor is a floor and what the buttons call it
+type Floor struct {
+	Number int
+	Label  string
+}
+
+// Group is a bank of cars
+type Group struct {
+	Name      string
+	Elevators []int
+}
+
+// ElevatorSpec is what a car is like
+type ElevatorSpec struct {
+	ServedFloors []int
+	Capacity     int     // passengers
+	Speed        float64 // metres per second
+}
+
+// SetFloorLabels names floors, floors left out are known by their number
+func (b *Building) SetFloorLabels(labels map[int]string) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	for floor := range labels {
+		if floor < 1 || floor > b.NumFloors {
+			return b.errorf(ErrInvalidFloor, -1, "floor: %d does not exist in building: %d", floor, b.ID)
+		}
+	}
+	b.floorLabels = make(map[int]string)
+	for floor, label := range labels {
+		b.floorLabels[floor] = label
+	}
+	return nil
+}
+
+// GetFloors returns every floor in the building with its label
+func (b *Building) GetFloors() []Floor {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	floors := make([]Floor, 0, b.NumFloors)
+	for n := 1; n <= b.NumFloors; n++ {
+		label, ok := b.floorLabels[n]
+		if !ok {
+			label = fmt.Sprint(n)
+		}
+		floors = append(floors, Floor{Number: n, Label: label})
+	}
+	return floors
+}
+
+// ConfigureElevator sets what a car is like
+func (b *Building) ConfigureElevator(elevatorID int, spec ElevatorSpec) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	e := b.GetElevator(elevatorID)
+	if e == nil {
+		return b.errorf(ErrElevatorNotFound, elevatorID, "elevator with ID: %d does not exist in building: %d", elevatorID, b.ID)
+	}
+	if err := b.checkSpec(elevatorID, spec); err != nil {
+		return err
+	}
+	// a car can't be told to skip a floor it still has to go to
+	next := elevator.Elevator{MaxFloor: e.MaxFloor, ServedFloors: spec.ServedFloors}
+	for _, call := range append(e.CallList.Snapshot(), e.DeferredCalls...) {
+		if !next.Serves(call.Floor) {
+			return b.errorf(ErrConflict, elevatorID, "elevator: %d has a pending call to floor: %d in building: %d, drain it first", elevatorID, call.Floor, b.ID)
+		}
+	}
+	setSpec(e, spec)
+	return nil
+}
+
+// GetElevatorSpec returns what a car is like
+func (b *Building) GetElevatorSpec(elevatorID int) (ElevatorSpec, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	e := b.GetElevator(elevatorID)
+	if e == nil {
+		return ElevatorSpec{}, b.errorf(ErrElevatorNotFound, elevatorID, "elevator with ID: %d does not exist in building: %d", elevatorID, b.ID)
+	}
+	return ElevatorSpec{ServedFloors: append([]int{}, e.ServedFloors...), Capacity: e.Capacity, Speed: e.Speed}, nil
+}
+
+// GetElevatorIDs returns the IDs of the cars in the building
+func (b *Building) GetElevatorIDs() []int {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	ids := make([]int, 0, len(b.ElevatorList))
+	for _, e := range b.ElevatorList {
+		ids = append(ids, e.ElevatorID)
+	}
+	return ids
+}
+
+// AddElevator puts a new car into service and returns its ID.  An elevatorID
+// of -1 gives it the next ID after the highest in the building.
+func (b *Building) AddElevator(elevatorID int, spec ElevatorSpec) (int, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	// a new car would ignore a recall or power plan it wasn't part of
+	if b.FireRecall || b.EmergencyPower {
+		return 0, b.errorf(ErrConflict, -1, "elevators can't be added to building: %d during fire recall or emergency power", b.ID)
+	}
+	if elevatorID == -1 {
+		elevatorID = 0
+		for _, e := range b.ElevatorList {
+			if e.ElevatorID >= elevatorID {
+				elevatorID = e.ElevatorID + 1
+			}
+		}
+	}
+	if elevatorID < 0 || b.GetElevator(elevatorID) != nil {
+		return 0, b.errorf(ErrConflict, elevatorID, "elevator with ID: %d already exists or is invalid in building: %d", elevatorID, b.ID)
+	}
+	if err := b.checkSpec(elevatorID, spec); err != nil {
+		return 0, err
+	}
+	e := b.newElevator(elevatorID)
+	setSpec(e, spec)
+	b.ElevatorList = append(b.ElevatorList, e)
+	return elevatorID, nil
+}
+
+// RemoveElevator takes a car out of the building for good.  It has to be
+// drained first, out of service with nobody left to carry.
+func (b *Building) RemoveElevator(elevatorID int) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	e := b.GetElevator(elevatorID)
+	if e == nil {
+		return b.errorf(ErrElevatorNotFound, elevatorID, "elevator with ID: %d does not exist in building: %d", elevatorID, b.ID)
+	}
+	if b.FireRecall || b.EmergencyPower {
+		return b.errorf(ErrConflict, -1, "elevators can't be removed from building: %d during fire recall or emergency power", b.ID)
+	}
+	if len(b.ElevatorList) == 1 {
+		return b.errorf(ErrConflict, elevatorID, "elevator: %d is the only elevator in building: %d", elevatorID, b.ID)
+	}
+	if n := e.CallList.Len() + len(e.DeferredCalls); n > 0 || e.InService {
+		return b.errorf(ErrConflict, elevatorID, "elevator: %d has %d pending calls or is still in service in building: %d, drain it first", elevatorID, n, b.ID)
+	}
+	if len(b.ElevatorList)-1 < b.MinInService {
+		return b.errorf(ErrConflict, elevatorID, "removing elevator: %d would leave fewer than %d elevators in building: %d", elevatorID, b.MinInService, b.ID)
+	}
+
+	windows := make([]*MaintenanceWindow, 0, len(b.maintenanceWindows))
+	for _, w := range b.maintenanceWindows {
+		if w.ElevatorID != elevatorID {
+			windows = append(windows, w)
+		}
+	}
+	b.maintenanceWindows = windows
+	for i, g := range b.groups {
+		cars := make([]int, 0, len(g.Elevators))
+		for _, id := range g.Elevators {
+			if id != elevatorID {
+				cars = append(cars, id)
+			}
+		}
+		b.groups[i].Elevators = cars
+	}
+	delete(b.answered, elevatorID)
+	cars := make([]*elevator.Elevator, 0, len(b.ElevatorList)-1)
+	for _, car := range b.ElevatorList {
+		if car != e {
+			cars = append(cars, car)
+		}
+	}
+	b.ElevatorList = cars
+	return nil
+}
+
+// checkSpec -- caller holds the lock
+func (b *Building) checkSpec(elevatorID int, spec ElevatorSpec) error {
+	for _, floor := range spec.ServedFloors {
+		if floor < 1 || floor > b.NumFloors {
+			return b.errorf(ErrInvalidFloor, -1, "floor: %d does not exist in building: %d", floor, b.ID)
+		}
+	}
+	if spec.Capacity < 0 || spec.Speed < 0 {
+		return b.errorf(ErrInvalidArgument, elevatorID, "invalid capacity: %d or speed: %g for elevator: %d", spec.Capacity, spec.Speed, elevatorID)
+	}
+	return nil
+}
+
+func setSpec(e *elevator.Elevator, spec ElevatorSpec) {
+	e.ServedFloors = append([]int{}, spec.ServedFloors...)
+	sort.Ints(e.ServedFloors)
+	e.Capacity = spec.Capacity
+	e.Speed = spec.Speed
+}
+
+// SetGroups puts the cars into banks, a car can be in one bank at most
+func (b *Building) SetGroups(groups []Group) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	seen := make(map[int]string)
+	names := make(map[string]bool)
+	for _, g := range groups {
+		if g.Name == "" || names[g.Name] {
+			return b.errorf(ErrInvalidArgument, -1, "group name: %q is empty or used twice in building: %d", g.Name, b.ID)
+		}
+		names[g.Name] = true
+		for _, id := range g.Elevators {
+			if b.GetElevator(id) == nil {
+				return b.errorf(ErrElevatorNotFound, id, "elevator with ID: %d does not exist in building: %d", id, b.ID)
+			}
+			if other, ok := seen[id]; ok {
+				return b.errorf(ErrInvalidArgument, id, "elevator: %d is in both group: %s and group: %s", id, other, g.Name)
+			}
+			seen[id] = g.Name
+		}
+	}
+	b.groups = make([]Group, 0, len(groups))
+	for _, g := range groups {
+		b.groups = append(b.groups, Group{Name: g.Name, Elevators: append([]int{}, g.Elevators...)})
+	}
+	return nil
+}
+
+// GetGroups returns the banks of cars
+func (b *Building) GetGroups() []Group {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	groups := make([]Group, 0, len(b.groups))
+	for _, g := range b.groups {
+		groups = append(groups, Group{Name: g.Name, Elevators: append([]int{}, g.Elevators...)})
+	}
+	return groups
+}
+
+// SetDispatchStrategy changes how hall calls pick their car from now on
+func (b *Building) SetDispatchStrategy(strategy DispatchStrategy) error {
+	if strategy != DispatchClosest && strategy != DispatchShortestWait {
+		return b.errorf(ErrInvalidArgument, -1, "unknown dispatch strategy: %s", strategy)
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.dispatchStrategy = strategy
+	return nil
+}
+
+// GetDispatchStrategy returns how hall calls pick their car
+func (b *Building) GetDispatchStrategy() DispatchStrategy {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.dispatchStrategy
+}
+
+// pickCar picks the car for a hall call under the dispatch strategy,
+// skipping the car exclude -- caller holds the lock
+func (b *Building) pickCar(floor int, direction int, exclude int) *elevator.Elevator {
+	if b.dispatchStrategy != DispatchShortestWait {
+		return b.closestCar(floor, exclude)
+	}
+	now := b.clock()
+	call := elevator.Call{Floor: floor, Direction: direction, Type: elevator.HallCall, RegisteredAt: now}
+	var best *elevator.Elevator
+	var bestETA time.Duration
+	for _, e := range b.ElevatorList {
+		if !dispatchable(e, floor) || e.ElevatorID == exclude {
+			continue
+		}
+		eta := estimateArrival(e, call, now)
+		if best == nil || eta < bestETA {
+			best, bestETA = e, eta
+		}
+	}
+	return best
+}
+
+// dispatchable checks whether a car can take a hall call at floor
+func dispatchable(e *elevator.Elevator, floor int) bool {
+	// cars in any of the special modes are out of dispatch
+	return e.InService && e.Mode == elevator.ModeNormal && e.Serves(floor)
+}
+
+// floorTime is how long a car takes to go one floor
+func floorTime(e *elevator.Elevator) time.Duration {
+	if e.Speed <= 0 {
+		return floorTravelTime
+	}
+	return time.Duration(floorHeight / e.Speed * float64(time.Second))
+}
@@ -0,0 +1,137 @@
+package building
+
+import (
+	"testing"
+)
+
+func TestBuildingServedFloors(t *testing.T) {
+	b := NewBuilding(1, 10, 2)
+	// car 0 is nearer but doesn't stop at 5
+	if err := b.ConfigureElevator(0, ElevatorSpec{ServedFloors: []int{10, 1}}); err != nil {
+		t.Fatalf("Configuring elevator 0 should not fail, got %s", err.Error())
+	}
+	b.GetElevator(1).CurrentFloor = 9
+	elID, err := b.CallElevator(5, 1)
+	if err != nil || elID != 1 {
+		t.Errorf("Floor 5 should be answered by elevator 1, got %d, %v", elID, err)
+	}
+	if err := b.PushDestinationButton(0, 5); err == nil {
+		t.Errorf("Elevator 0 should refuse a trip to floor 5")
+	}
+	if _, err := b.CallElevator(10, -1); err != nil {
+		t.Errorf("Floor 10 should still be served, got %s", err.Error())
+	}
+
+	b.SetElevatorInServiceStatus(1, false)
+	if _, err := b.CallElevator(4, 1); err == nil {
+		t.Errorf("Floor 4 should have no car left to serve it")
+	}
+	if err := b.ConfigureElevator(0, ElevatorSpec{ServedFloors: []int{11}}); err == nil {
+		t.Errorf("Floor 11 should be refused")
+	}
+	if err := b.ConfigureElevator(3, ElevatorSpec{}); err == nil {
+		t.Errorf("Configuring elevator 3 should fail")
+	}
+}
+
+func TestBuildingShortestWait(t *testing.T) {
+	b := NewBuilding(1, 10, 2)
+	// car 0 is on the way with a stop on every floor, car 1 is idle further off
+	b.GetElevator(0).CurrentFloor = 2
+	for floor := 3; floor <= 8; floor++ {
+		b.PushDestinationButton(0, floor)
+	}
+	b.GetElevator(1).CurrentFloor = 1
+
+	if err := b.SetDispatchStrategy("random"); err == nil {
+		t.Errorf("Unknown dispatch strategy should be refused")
+	}
+	elID, _ := b.CallElevator(9, -1)
+	if elID != 0 {
+		t.Errorf("Closest dispatch should pick elevator 0, got %d", elID)
+	}
+	b.SetDispatchStrategy(DispatchShortestWait)
+	elID, _ = b.CallElevator(7, -1)
+	if elID != 1 {
+		t.Errorf("Shortest wait dispatch should pick the idle elevator 1, got %d", elID)
+	}
+}
+
+func TestBuildingGroups(t *testing.T) {
+	b := NewBuilding(1, 10, 3)
+	if err := b.SetGroups([]Group{{Name: "low", Elevators: []int{0, 1}}, {Name: "high", Elevators: []int{1}}}); err == nil {
+		t.Errorf("Elevator 1 should not be allowed in two groups")
+	}
+	if err := b.SetGroups([]Group{{Name: "low", Elevators: []int{4}}}); err == nil {
+		t.Errorf("Elevator 4 should not be allowed in a group")
+	}
+	if err := b.SetGroups([]Group{{Name: "low", Elevators: []int{0, 1}}}); err != nil {
+		t.Fatalf("Setting groups should not fail, got %s", err.Error())
+	}
+	b.SetElevatorInServiceStatus(1, false)
+	capacity := b.GetCapacity()
+	if len(capacity) != 2 {
+		t.Fatalf("Capacity should cover low and ungrouped, got %v", capacity)
+	}
+	if capacity[0].Bank != "low" || capacity[0].Cars != 2 || capacity[0].InService != 1 {
+		t.Errorf("Low bank should have 1 of 2 cars in service, got %v", capacity[0])
+	}
+	if capacity[1].Bank != "ungrouped" || capacity[1].Cars != 1 {
+		t.Errorf("Elevator 2 should be ungrouped, got %v", capacity[1])
+	}
+}
+
+func TestBuildingFloorLabels(t *testing.T) {
+	b := NewBuilding(1, 3, 1)
+	if err := b.SetFloorLabels(map[int]string{4: "R"}); err == nil {
+		t.Errorf("Labelling floor 4 should fail")
+	}
+	b.SetFloorLabels(map[int]string{1: "G"})
+	floors := b.GetFloors()
+	if len(floors) != 3 || floors[0].Label != "G" || floors[1].Label != "2" {
+		t.Errorf("Floors should be G, 2, 3, got %v", floors)
+	}
+}
+
+func TestBuildingAddRemoveElevator(t *testing.T) {
+	b := NewBuilding(1, 10, 2)
+	elID, err := b.AddElevator(-1, ElevatorSpec{ServedFloors: []int{1, 10}, Capacity: 20})
+	if err != nil || elID != 2 {
+		t.Fatalf("New elevator should be 2, got %d, %v", elID, err)
+	}
+	if !b.GetElevator(2).InService || b.GetElevator(2).Serves(5) {
+		t.Errorf("Elevator 2 should be in service and skip floor 5")
+	}
+	if _, err := b.AddElevator(-1, ElevatorSpec{ServedFloors: []int{12}}); err == nil {
+		t.Errorf("Adding an elevator serving floor 12 should fail")
+	}
+
+	b.SetGroups([]Group{{Name: "all", Elevators: []int{0, 1, 2}}})
+	b.PushDestinationButton(2, 10)
+	b.DrainElevator(2)
+	if err := b.RemoveElevator(2); err == nil {
+		t.Errorf("Elevator 2 has a call and should not be removed")
+	}
+	if err := b.ConfigureElevator(2, ElevatorSpec{ServedFloors: []int{1}}); err == nil {
+		t.Errorf("Elevator 2 should not drop floor 10 while it has a call there")
+	}
+	if err := b.RemoveElevator(1); err == nil {
+		t.Errorf("Elevator 1 is in service and should not be removed")
+	}
+	b.CancelCarCall(2, 10, "test")
+	b.SetElevatorInServiceStatus(2, false)
+	if err := b.RemoveElevator(2); err != nil {
+		t.Errorf("Idle elevator 2 should be removed, got %s", err.Error())
+	}
+	if ids := b.GetElevatorIDs(); len(ids) != 2 || b.GetElevator(2) != nil {
+		t.Errorf("Building should be back to elevators 0 and 1, got %v", ids)
+	}
+	if g := b.GetGroups(); len(g[0].Elevators) != 2 {
+		t.Errorf("Elevator 2 should be gone from its group, got %v", g)
+	}
+
+	b.ActivateFireRecall()
+	if _, err := b.AddElevator(-1, ElevatorSpec{}); err == nil {
+		t.Errorf("Adding an elevator during fire recall should fail")
+	}
+}
@@ -0,0 +1,154 @@
+package building
+
+import (
+	"sort"
+	"time"
+
+	"github.com/tcotav/elevatormgr/elevator"
+)
+
+// Scheduled maintenance windows.  Windows are booked ahead of time and the
+// server calls ApplyMaintenanceSchedule periodically to drain each car out of
+// service when its window starts and put it back when it ends.  A window is
+// refused if, together with the windows it overlaps, it would leave fewer than
+// MinInService cars running.
+
+// MaintenanceWindow is a booked period of maintenance on one elevator
+type MaintenanceWindow struct {
+	ID         int
+	ElevatorID int
+	Start      time.Time
+	End        time.Time
+	Reason     string
+	Technician string
+	Active     bool
+}
+
+// ScheduleMaintenance books a maintenance window and returns it with its ID set
+func (b *Building) ScheduleMaintenance(w MaintenanceWindow) (MaintenanceWindow, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.GetElevator(w.ElevatorID) == nil {
+		return MaintenanceWindow{}, b.errorf(ErrElevatorNotFound, w.ElevatorID, "elevator with ID: %d does not exist in building: %d", w.ElevatorID, b.ID)
+	}
+	if !w.End.After(w.Start) {
+		return MaintenanceWindow{}, b.errorf(ErrInvalidArgument, w.ElevatorID, "maintenance window for elevator: %d in building: %d ends before it starts", w.ElevatorID, b.ID)
+	}
+	if w.Reason == "" || w.Technician == "" {
+		return MaintenanceWindow{}, b.errorf(ErrInvalidArgument, w.ElevatorID, "maintenance window for elevator: %d in building: %d needs a reason and a technician", w.ElevatorID, b.ID)
+	}
+
+	// the most cars are down at the start of one of the overlapping windows
+	overlapping := []*MaintenanceWindow{&w}
+	for _, other := range b.maintenanceWindows {
+		if other.Start.Before(w.End) && w.Start.Before(other.End) {
+			if other.ElevatorID == w.ElevatorID {
+				return MaintenanceWindow{}, b.errorf(ErrConflict, w.ElevatorID, "elevator: %d in building: %d already has maintenance window: %d at that time", w.ElevatorID, b.ID, other.ID)
+			}
+			overlapping = append(overlapping, other)
+		}
+	}
+	// cars already out of service or draining stay out, unless it's for one of these windows
+	running := 0
+	for _, e := range b.ElevatorList {
+		if e.InService && e.Mode != elevator.ModeDraining || windowFor(overlapping, e.ElevatorID) {
+			running++
+		}
+	}
+	for _, at := range overlapping {
+		down := 0
+		for _, other := range overlapping {
+			if !other.Start.After(at.Start) && at.Start.Before(other.End) {
+				down++
+			}
+		}
+		if running-down < b.MinInService {
+			return MaintenanceWindow{}, b.errorf(ErrConflict, w.ElevatorID, "maintenance window for elevator: %d in building: %d would leave fewer than %d cars in service at %s", w.ElevatorID, b.ID, b.MinInService, at.Start.Format(time.RFC3339))
+		}
+	}
+
+	b.nextWindowID++
+	w.ID = b.nextWindowID
+	w.Active = false
+	b.maintenanceWindows = append(b.maintenanceWindows, &w)
+	return w, nil
+}
+
+// GetMaintenanceWindows returns the windows that haven't ended yet, soonest first
+func (b *Building) GetMaintenanceWindows(now time.Time) []MaintenanceWindow {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	windows := make([]MaintenanceWindow, 0)
+	for _, w := range b.maintenanceWindows {
+		if w.End.After(now) {
+			windows = append(windows, *w)
+		}
+	}
+	sort.Slice(windows, func(i, j int) bool {
+		return windows[i].Start.Before(windows[j].Start)
+	})
+	return windows
+}
+
+// ApplyMaintenanceSchedule starts and finishes the windows that are due and
+// returns the ones it managed to start and finish.  It returns the first error
+// it hits but carries on with the other windows.
+func (b *Building) ApplyMaintenanceSchedule(now time.Time) ([]MaintenanceWindow, []MaintenanceWindow, error) {
+	// work out what's due under the lock, the service changes take it themselves
+	b.mu.Lock()
+	starting := make([]*MaintenanceWindow, 0)
+	ending := make([]MaintenanceWindow, 0)
+	remaining := make([]*MaintenanceWindow, 0)
+	for _, w := range b.maintenanceWindows {
+		switch {
+		case !now.Before(w.End):
+			if w.Active {
+				ending = append(ending, *w)
+			}
+			// windows that ended while we weren't looking are just dropped
+			continue
+		case !w.Active && !now.Before(w.Start):
+			starting = append(starting, w)
+		}
+		remaining = append(remaining, w)
+	}
+	b.maintenanceWindows = remaining
+	b.mu.Unlock()
+
+	var firstErr error
+	started := make([]MaintenanceWindow, 0)
+	ended := make([]MaintenanceWindow, 0)
+	for _, w := range ending {
+		if _, _, err := b.SetElevatorInServiceStatus(w.ElevatorID, true); err != nil {
+			if firstErr == nil {
+				firstErr = err
+			}
+			continue
+		}
+		ended = append(ended, w)
+	}
+	for _, w := range starting {
+		// a window whose car didn't drain isn't active, we try again next time round
+		if _, err := b.DrainElevator(w.ElevatorID); err != nil {
+			if firstErr == nil {
+				firstErr = err
+			}
+			continue
+		}
+		b.mu.Lock()
+		w.Active = true
+		started = append(started, *w)
+		b.mu.Unlock()
+	}
+	return started, ended, firstErr
+}
+
+// windowFor checks whether one of windows is for the car
+func windowFor(windows []*MaintenanceWindow, elevatorID int) bool {
+	for _, w := range windows {
+		if w.ElevatorID == elevatorID {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,102 @@
+package building
+
+import (
+	"testing"
+	"time"
+)
+
+func TestBuildingScheduleMaintenance(t *testing.T) {
+	b := NewBuilding(1, 10, 3)
+	b.MinInService = 2
+	start := time.Date(2024, 3, 1, 9, 0, 0, 0, time.UTC)
+
+	w, err := b.ScheduleMaintenance(MaintenanceWindow{ElevatorID: 0, Start: start, End: start.Add(2 * time.Hour), Reason: "cable inspection", Technician: "pat"})
+	if err != nil {
+		t.Errorf("Maintenance window should be scheduled, got %s", err.Error())
+	}
+	if w.ID == 0 {
+		t.Errorf("Maintenance window should have an ID")
+	}
+
+	// would leave only one car running
+	_, err = b.ScheduleMaintenance(MaintenanceWindow{ElevatorID: 1, Start: start.Add(time.Hour), End: start.Add(3 * time.Hour), Reason: "door operator", Technician: "sam"})
+	if err == nil {
+		t.Errorf("Overlapping maintenance window should be refused")
+	}
+	// same car twice
+	_, err = b.ScheduleMaintenance(MaintenanceWindow{ElevatorID: 0, Start: start.Add(time.Hour), End: start.Add(3 * time.Hour), Reason: "door operator", Technician: "sam"})
+	if err == nil {
+		t.Errorf("Overlapping maintenance window on the same car should be refused")
+	}
+	// back to back is fine
+	_, err = b.ScheduleMaintenance(MaintenanceWindow{ElevatorID: 1, Start: start.Add(2 * time.Hour), End: start.Add(3 * time.Hour), Reason: "door operator", Technician: "sam"})
+	if err != nil {
+		t.Errorf("Back to back maintenance window should be scheduled, got %s", err.Error())
+	}
+	if _, err := b.ScheduleMaintenance(MaintenanceWindow{ElevatorID: 2, Start: start, End: start, Reason: "x", Technician: "y"}); err == nil {
+		t.Errorf("Empty maintenance window should be refused")
+	}
+
+	windows := b.GetMaintenanceWindows(start.Add(-time.Hour))
+	if len(windows) != 2 {
+		t.Errorf("There should be 2 upcoming maintenance windows, got %d", len(windows))
+	}
+
+	started, ended, _ := b.ApplyMaintenanceSchedule(start.Add(time.Minute))
+	if len(started) != 1 || started[0].ElevatorID != 0 || len(ended) != 0 {
+		t.Errorf("Only the window for elevator 0 should have started, got %v and %v", started, ended)
+	}
+	if b.GetElevator(0).InService {
+		t.Errorf("Elevator 0 should be out of service during its window")
+	}
+	started, ended, _ = b.ApplyMaintenanceSchedule(start.Add(2*time.Hour + time.Minute))
+	if len(started) != 1 || len(ended) != 1 || ended[0].ElevatorID != 0 {
+		t.Errorf("Elevator 0's window should have ended and elevator 1's started, got %v and %v", started, ended)
+	}
+	if !b.GetElevator(0).InService {
+		t.Errorf("Elevator 0 should be back in service after its window")
+	}
+	if b.GetElevator(1).InService {
+		t.Errorf("Elevator 1 should be out of service during its window")
+	}
+	windows = b.GetMaintenanceWindows(start.Add(2*time.Hour + time.Minute))
+	if len(windows) != 1 || !windows[0].Active {
+		t.Errorf("Only the active window for elevator 1 should be left")
+	}
+}
+
+func TestBuildingScheduleMaintenanceCarsOutOfService(t *testing.T) {
+	b := NewBuilding(1, 10, 3)
+	b.MinInService = 2
+	start := time.Date(2024, 3, 1, 9, 0, 0, 0, time.UTC)
+	b.SetElevatorInServiceStatus(2, false)
+
+	// car 2 is already out so taking car 0 down leaves one running
+	if _, err := b.ScheduleMaintenance(MaintenanceWindow{ElevatorID: 0, Start: start, End: start.Add(time.Hour), Reason: "cable inspection", Technician: "pat"}); err == nil {
+		t.Errorf("Maintenance window should be refused with car 2 out of service")
+	}
+	// car 2's own window only counts it once
+	if _, err := b.ScheduleMaintenance(MaintenanceWindow{ElevatorID: 2, Start: start, End: start.Add(time.Hour), Reason: "cable inspection", Technician: "pat"}); err != nil {
+		t.Errorf("Maintenance window for car 2 should be scheduled, got %s", err.Error())
+	}
+}
+
+func TestBuildingMaintenanceWindowFailedDrain(t *testing.T) {
+	b := NewBuilding(1, 10, 3)
+	start := time.Date(2024, 3, 1, 9, 0, 0, 0, time.UTC)
+	b.ScheduleMaintenance(MaintenanceWindow{ElevatorID: 0, Start: start, End: start.Add(time.Hour), Reason: "cable inspection", Technician: "pat"})
+	b.SetElevatorInServiceStatus(0, false)
+
+	started, _, err := b.ApplyMaintenanceSchedule(start.Add(time.Minute))
+	if err == nil || len(started) != 0 {
+		t.Errorf("Window should not start when car 0 can't drain, got %v %v", started, err)
+	}
+	if windows := b.GetMaintenanceWindows(start); len(windows) != 1 || windows[0].Active {
+		t.Errorf("Window for car 0 should not be active, got %v", windows)
+	}
+	// somebody else took it out, the schedule doesn't get to put it back
+	_, ended, _ := b.ApplyMaintenanceSchedule(start.Add(time.Hour + time.Minute))
+	if len(ended) != 0 || b.GetElevator(0).InService {
+		t.Errorf("Car 0 should stay out of service after a window that never started, got %v", ended)
+	}
+}
@@ -0,0 +1,102 @@
+package building
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/tcotav/elevatormgr/elevator"
+)
+
+// Re-optimization of hall call assignments.  OptimizeHallCalls is run
+// periodically and moves a hall call to another car when that car would get
+// there sooner by at least ReassignThreshold.  A call that was just assigned
+// isn't moved again until ReassignHoldTime has passed so calls don't flap
+// between two cars that are about as good as each other.
+
+// rough timings used to estimate when a car will get to a floor
+const (
+	floorTravelTime = 2 * time.Second
+	stopTime        = 10 * time.Second
+)
+
+// OptimizeHallCalls moves hall calls to cars that can answer them sooner and
+// returns the moves it made
+func (b *Building) OptimizeHallCalls() []Reassignment {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.prune()
+	now := b.clock()
+	moved := make([]Reassignment, 0)
+	for _, hc := range b.hallCalls {
+		if now.Sub(hc.AssignedAt) < b.ReassignHoldTime {
+			continue
+		}
+		current := b.GetElevator(hc.ElevatorID)
+		if current == nil || current.Mode != elevator.ModeNormal {
+			continue
+		}
+		call := elevator.Call{Floor: hc.Floor, Direction: hc.Direction, Type: elevator.HallCall, RegisteredAt: hc.RegisteredAt}
+		currentETA := estimateArrival(current, call, now)
+
+		var best *elevator.Elevator
+		var bestETA time.Duration
+		for _, e := range b.ElevatorList {
+			if !dispatchable(e, hc.Floor) || e.ElevatorID == current.ElevatorID {
+				continue
+			}
+			eta := estimateArrival(e, call, now)
+			if best == nil || eta < bestETA {
+				best, bestETA = e, eta
+			}
+		}
+		if best == nil || currentETA-bestETA < b.ReassignThreshold {
+			continue
+		}
+
+		from := hc.ElevatorID
+		if err := b.moveHallCall(hc, best); err != nil {
+			continue
+		}
+		moved = append(moved, Reassignment{Call: call, ElevatorID: best.ElevatorID})
+		b.emit(Event{
+			Type:           EventHallCallReassigned,
+			Floor:          hc.Floor,
+			Direction:      hc.Direction,
+			ElevatorID:     best.ElevatorID,
+			FromElevatorID: from,
+			Reason:         fmt.Sprintf("estimated arrival improved from %s to %s", currentETA, bestETA),
+		})
+	}
+	return moved
+}
+
+// estimateArrival works out how long from now until the car gets to the call.
+// It runs through the car's call list the way NextStop would, aging policy and
+// all, until it gets to the call's stop.  A call the car doesn't have yet is
+// added to the list first.
+func estimateArrival(e *elevator.Elevator, call elevator.Call, now time.Time) time.Duration {
+	calls := e.CallList.Copy()
+	if !calls.Contains(call) {
+		calls.Push(call)
+	}
+	policy := e.StopPolicy()
+	var eta time.Duration
+	floor := e.CurrentFloor
+	for c := calls.PopNext(now, floor, policy); c != nil; c = calls.PopNext(now.Add(eta), floor, policy) {
+		eta += time.Duration(abs(c.Floor-floor)) * floorTime(e)
+		// the stop answers the hall call and the car call together
+		if c.Floor == call.Floor && c.Direction == call.Direction {
+			break
+		}
+		eta += stopTime
+		floor = c.Floor
+	}
+	return eta
+}
+
+func abs(x int) int {
+	if x < 0 {
+		return -x
+	}
+	return x
+}
@@ -0,0 +1,77 @@
+package building
+
+import (
+	"testing"
+	"time"
+
+	"github.com/tcotav/elevatormgr/elevator"
+)
+
+func TestBuildingOptimizeHallCalls(t *testing.T) {
+	b := NewBuilding(1, 10, 2)
+	now := time.Date(2024, 3, 1, 9, 0, 0, 0, time.UTC)
+	b.clock = func() time.Time { return now }
+	events, unsubscribe := b.Subscribe(10)
+	defer unsubscribe()
+
+	// car 1 is away so car 0 takes the hall call behind a long trip
+	b.SetElevatorInServiceStatus(1, false)
+	b.PushDestinationButton(0, 10)
+	b.PushDestinationButton(0, 2)
+	b.CallElevator(3, 1)
+	b.SetElevatorInServiceStatus(1, true)
+
+	// too soon after the assignment to move it
+	if moved := b.OptimizeHallCalls(); len(moved) != 0 {
+		t.Errorf("Hall call should not move inside the hold time, got %v", moved)
+	}
+
+	now = now.Add(time.Minute)
+	moved := b.OptimizeHallCalls()
+	if len(moved) != 1 || moved[0].ElevatorID != 1 {
+		t.Errorf("Hall call should move to elevator 1, got %v", moved)
+	}
+	hallCalls := b.GetHallCalls()
+	if len(hallCalls) != 1 || hallCalls[0].ElevatorID != 1 {
+		t.Errorf("Registry should have the hall call on elevator 1, got %v", hallCalls)
+	}
+	if b.GetElevator(0).GetCallList().Len() != 2 {
+		t.Errorf("Elevator 0 should only have its car calls left, got %d", b.GetElevator(0).GetCallList().Len())
+	}
+
+	ev, ok := nextEvent(events, EventHallCallReassigned)
+	if !ok {
+		t.Errorf("Expected a reassignment event")
+	}
+	if ev.FromElevatorID != 0 || ev.ElevatorID != 1 || ev.Floor != 3 {
+		t.Errorf("Expected a reassignment event from 0 to 1 for floor 3, got %v", ev)
+	}
+
+	// nothing better now, and no flapping back
+	now = now.Add(time.Minute)
+	if moved := b.OptimizeHallCalls(); len(moved) != 0 {
+		t.Errorf("Hall call should stay put, got %v", moved)
+	}
+}
+
+func TestEstimateArrivalAgingPolicy(t *testing.T) {
+	b := NewBuilding(1, 10, 1)
+	now := time.Date(2024, 3, 1, 9, 0, 0, 0, time.UTC)
+	b.clock = func() time.Time { return now }
+	b.PushDestinationButton(0, 10)
+	b.PushDestinationButton(0, 2)
+	call := elevator.Call{Floor: 3, Direction: 1, Type: elevator.HallCall, RegisteredAt: now}
+
+	// in order it's 10, then 2, then 3
+	e := b.GetElevator(0)
+	inOrder := 9*floorTravelTime + stopTime + 8*floorTravelTime + stopTime + floorTravelTime
+	if eta := estimateArrival(e, call, now); eta != inOrder {
+		t.Errorf("Estimate should run the calls in order, expected %s got %s", inOrder, eta)
+	}
+	// nearest first goes to 2 and then 3 on the way up
+	b.SetAgingPolicy(elevator.AgingPolicy{MaxWait: time.Hour})
+	nearest := floorTravelTime + stopTime + floorTravelTime
+	if eta := estimateArrival(e, call, now); eta != nearest {
+		t.Errorf("Estimate should follow the aging policy, expected %s got %s", nearest, eta)
+	}
+}
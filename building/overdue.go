@@ -0,0 +1,109 @@
+package building
+
+import (
+	"fmt"
+	"sort"
+)
+
+// Unanswered call escalation.  A hall call that's been waiting longer than
+// MaxWait is overdue -- its car may be stuck.  CheckOverdueCalls is run
+// periodically to escalate them: the call is moved to another car if there is
+// one, and an EventCallOverdue goes out for alerting.  A call is escalated
+// again every MaxWait for as long as it stays unanswered.
+
+// Health is the building's health for the SRE dashboards
+type Health struct {
+	BuildingID         int
+	Status             string
+	ElevatorsInService int
+	ElevatorsTotal     int
+	FireRecall         bool
+	EmergencyPower     bool
+	OverdueCalls       []HallCall
+}
+
+// GetOverdueCalls returns the hall calls that have waited longer than MaxWait, longest first
+func (b *Building) GetOverdueCalls() []HallCall {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.prune()
+	return b.overdueCalls()
+}
+
+// CheckOverdueCalls escalates overdue hall calls and returns the ones it escalated
+func (b *Building) CheckOverdueCalls() []HallCall {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.prune()
+	now := b.clock()
+	escalated := make([]HallCall, 0)
+	for _, overdue := range b.overdueCalls() {
+		hc := b.hallCalls[hallCallKey{overdue.Floor, overdue.Direction}]
+		if !hc.EscalatedAt.IsZero() && now.Sub(hc.EscalatedAt) < b.MaxWait {
+			continue
+		}
+		hc.EscalatedAt = now
+
+		reason := fmt.Sprintf("waiting %s on elevator %d, no other car available", now.Sub(hc.RegisteredAt), hc.ElevatorID)
+		from := hc.ElevatorID
+		if el := b.pickCar(hc.Floor, hc.Direction, hc.ElevatorID); el != nil {
+			if err := b.moveHallCall(hc, el); err == nil {
+				reason = fmt.Sprintf("waiting %s, moved from elevator %d", now.Sub(hc.RegisteredAt), from)
+			}
+		}
+		b.emit(Event{
+			Type:           EventCallOverdue,
+			Floor:          hc.Floor,
+			Direction:      hc.Direction,
+			ElevatorID:     hc.ElevatorID,
+			FromElevatorID: from,
+			TicketID:       hc.TicketID,
+			Reason:         reason,
+		})
+		escalated = append(escalated, *hc)
+	}
+	return escalated
+}
+
+// GetHealth sums up the building's state, it's degraded when calls are overdue
+// or there are fewer than MinInService cars running
+func (b *Building) GetHealth() Health {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.prune()
+	health := Health{
+		BuildingID:     b.ID,
+		Status:         "ok",
+		ElevatorsTotal: len(b.ElevatorList),
+		FireRecall:     b.FireRecall,
+		EmergencyPower: b.EmergencyPower,
+		OverdueCalls:   b.overdueCalls(),
+	}
+	for _, e := range b.ElevatorList {
+		if e.InService {
+			health.ElevatorsInService++
+		}
+	}
+	if len(health.OverdueCalls) > 0 || health.ElevatorsInService < b.MinInService {
+		health.Status = "degraded"
+	}
+	return health
+}
+
+// overdueCalls -- caller holds the lock
+func (b *Building) overdueCalls() []HallCall {
+	overdue := make([]HallCall, 0)
+	if b.MaxWait <= 0 {
+		return overdue
+	}
+	now := b.clock()
+	for _, hc := range b.hallCalls {
+		if now.Sub(hc.RegisteredAt) > b.MaxWait {
+			overdue = append(overdue, *hc)
+		}
+	}
+	sort.Slice(overdue, func(i, j int) bool {
+		return overdue[i].RegisteredAt.Before(overdue[j].RegisteredAt)
+	})
+	return overdue
+}
@@ -0,0 +1,55 @@
+package building
+
+import (
+	"testing"
+	"time"
+)
+
+func TestBuildingOverdueCalls(t *testing.T) {
+	b := NewBuilding(1, 10, 2)
+	b.MaxWait = time.Minute
+	now := time.Date(2024, 3, 1, 9, 0, 0, 0, time.UTC)
+	b.clock = func() time.Time { return now }
+	events, unsubscribe := b.Subscribe(20)
+	defer unsubscribe()
+
+	elID, _ := b.CallElevator(5, 1)
+	if len(b.CheckOverdueCalls()) != 0 {
+		t.Errorf("No calls should be overdue yet")
+	}
+
+	// the car is stuck and the call sits there
+	now = now.Add(2 * time.Minute)
+	if len(b.GetOverdueCalls()) != 1 {
+		t.Errorf("The hall call should be overdue")
+	}
+	health := b.GetHealth()
+	if health.Status != "degraded" || len(health.OverdueCalls) != 1 {
+		t.Errorf("Health should be degraded with 1 overdue call, got %v", health)
+	}
+
+	escalated := b.CheckOverdueCalls()
+	if len(escalated) != 1 || escalated[0].ElevatorID == elID {
+		t.Errorf("The hall call should be escalated to another car, got %v", escalated)
+	}
+	ev, ok := nextEvent(events, EventCallOverdue)
+	if !ok || ev.FromElevatorID != elID || ev.Floor != 5 {
+		t.Errorf("Expected an overdue event for floor 5 from elevator %d, got %v", elID, ev)
+	}
+
+	// not escalated again until another MaxWait has gone by
+	now = now.Add(30 * time.Second)
+	if len(b.CheckOverdueCalls()) != 0 {
+		t.Errorf("The hall call should not be escalated again so soon")
+	}
+	now = now.Add(time.Minute)
+	if len(b.CheckOverdueCalls()) != 1 {
+		t.Errorf("The hall call should be escalated again")
+	}
+
+	// answered -- healthy again
+	b.NextStop(b.GetHallCalls()[0].ElevatorID)
+	if b.GetHealth().Status != "ok" {
+		t.Errorf("Health should be ok once the call is answered")
+	}
+}
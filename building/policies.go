@@ -0,0 +1,71 @@
+package building
+
+import (
+	"time"
+)
+
+// Policies are the building's tunables in one place, for setting them from
+// the config file
+type Policies struct {
+	RecallFloor          int
+	AlternateRecallFloor int
+	LobbyFloor           int
+	MinInService         int
+	MaxWait              time.Duration
+	ReassignThreshold    time.Duration
+	ReassignHoldTime     time.Duration
+}
+
+// SetPolicies changes the building's tunables, zero values leave a setting as it is
+func (b *Building) SetPolicies(p Policies) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	for _, floor := range []int{p.RecallFloor, p.AlternateRecallFloor, p.LobbyFloor} {
+		if floor < 0 || floor > b.NumFloors {
+			return b.errorf(ErrInvalidFloor, -1, "floor: %d does not exist in building: %d", floor, b.ID)
+		}
+	}
+	if p.MinInService < 0 || p.MinInService > len(b.ElevatorList) {
+		return b.errorf(ErrInvalidArgument, -1, "minimum in service: %d is more than the elevators in building: %d", p.MinInService, b.ID)
+	}
+	if p.MaxWait < 0 || p.ReassignThreshold < 0 || p.ReassignHoldTime < 0 {
+		return b.errorf(ErrInvalidArgument, -1, "negative durations are not allowed in building: %d policies", b.ID)
+	}
+	if p.RecallFloor != 0 {
+		b.RecallFloor = p.RecallFloor
+	}
+	if p.AlternateRecallFloor != 0 {
+		b.AlternateRecallFloor = p.AlternateRecallFloor
+	}
+	if p.LobbyFloor != 0 {
+		b.LobbyFloor = p.LobbyFloor
+	}
+	if p.MinInService != 0 {
+		b.MinInService = p.MinInService
+	}
+	if p.MaxWait != 0 {
+		b.MaxWait = p.MaxWait
+	}
+	if p.ReassignThreshold != 0 {
+		b.ReassignThreshold = p.ReassignThreshold
+	}
+	if p.ReassignHoldTime != 0 {
+		b.ReassignHoldTime = p.ReassignHoldTime
+	}
+	return nil
+}
+
+// GetPolicies returns the building's tunables
+func (b *Building) GetPolicies() Policies {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return Policies{
+		RecallFloor:          b.RecallFloor,
+		AlternateRecallFloor: b.AlternateRecallFloor,
+		LobbyFloor:           b.LobbyFloor,
+		MinInService:         b.MinInService,
+		MaxWait:              b.MaxWait,
+		ReassignThreshold:    b.ReassignThreshold,
+		ReassignHoldTime:     b.ReassignHoldTime,
+	}
+}
@@ -0,0 +1,30 @@
+package building
+
+import (
+	"testing"
+	"time"
+)
+
+func TestBuildingPolicies(t *testing.T) {
+	b := NewBuilding(1, 10, 2)
+	before := b.GetPolicies()
+	if err := b.SetPolicies(Policies{LobbyFloor: 2, MaxWait: time.Minute}); err != nil {
+		t.Fatalf("Setting policies should not fail, got %s", err.Error())
+	}
+	p := b.GetPolicies()
+	if p.LobbyFloor != 2 || p.MaxWait != time.Minute {
+		t.Errorf("Lobby should be 2 and max wait 1m, got %v", p)
+	}
+	if p.RecallFloor != before.RecallFloor || p.ReassignThreshold != before.ReassignThreshold {
+		t.Errorf("Unset policies should be left alone, got %v", p)
+	}
+	if err := b.SetPolicies(Policies{RecallFloor: 11}); err == nil {
+		t.Errorf("Recall floor 11 should be refused")
+	}
+	if err := b.SetPolicies(Policies{MinInService: 3}); err == nil {
+		t.Errorf("Minimum in service of 3 should be refused with 2 elevators")
+	}
+	if err := b.SetPolicies(Policies{ReassignHoldTime: -time.Second}); err == nil {
+		t.Errorf("Negative hold time should be refused")
+	}
+}
@@ -0,0 +1,236 @@
+package building
+
+import (
+	"sort"
+	"time"
+
+	"github.com/tcotav/elevatormgr/elevator"
+)
+
+// Dry runs.  A maintenance command is previewed by running it for real on a
+// copy of the building and comparing the copy with how things stood, so the
+// preview can't drift from what the command actually does.
+
+// Preview is the projected effect of a command
+type Preview struct {
+	// calls that would be lost, with the car they were on
+	Dropped []DroppedCall
+	// hall calls that would move to another car
+	Reassigned []Reassignment
+	// every car as it would be left
+	Cars     []CarState
+	Capacity []BankCapacity
+}
+
+// DroppedCall is a call a command would throw away
+type DroppedCall struct {
+	Call       elevator.Call
+	ElevatorID int
+}
+
+// CarState is where a car is and what it has left to do
+type CarState struct {
+	ElevatorID   int
+	CurrentFloor int
+	InService    bool
+	Mode         elevator.Mode
+	DoorsOpen    bool
+	Calls        []elevator.Call
+}
+
+// BankCapacity counts the cars in a bank still taking hall calls
+type BankCapacity struct {
+	Bank         string
+	Cars         int
+	InService    int
+	Dispatchable int
+}
+
+// Preview works out what op would do to the building without changing it.
+// op gets a copy of the building to work on, its error is the command's.
+func (b *Building) Preview(op func(sim *Building) error) (Preview, error) {
+	b.mu.Lock()
+	before := b.copy()
+	sim := b.copy()
+	b.mu.Unlock()
+
+	if err := op(sim); err != nil {
+		return Preview{}, err
+	}
+	sim.mu.Lock()
+	defer sim.mu.Unlock()
+	return diff(before, sim), nil
+}
+
+// GetCapacity returns how many cars in each bank are taking hall calls
+func (b *Building) GetCapacity() []BankCapacity {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.capacity()
+}
+
+// capacity -- caller holds the lock
+func (b *Building) capacity() []BankCapacity {
+	// with no groups the whole building is one bank
+	if len(b.groups) == 0 {
+		return []BankCapacity{bankCapacity("all", b.ElevatorList)}
+	}
+	banks := make([]BankCapacity, 0, len(b.groups)+1)
+	grouped := make(map[int]bool)
+	for _, g := range b.groups {
+		cars := make([]*elevator.Elevator, 0, len(g.Elevators))
+		for _, id := range g.Elevators {
+			if e := b.GetElevator(id); e != nil {
+				cars = append(cars, e)
+				grouped[id] = true
+			}
+		}
+		banks = append(banks, bankCapacity(g.Name, cars))
+	}
+	ungrouped := make([]*elevator.Elevator, 0)
+	for _, e := range b.ElevatorList {
+		if !grouped[e.ElevatorID] {
+			ungrouped = append(ungrouped, e)
+		}
+	}
+	if len(ungrouped) > 0 {
+		banks = append(banks, bankCapacity("ungrouped", ungrouped))
+	}
+	return banks
+}
+
+func bankCapacity(name string, cars []*elevator.Elevator) BankCapacity {
+	bank := BankCapacity{Bank: name, Cars: len(cars)}
+	for _, e := range cars {
+		if e.InService {
+			bank.InService++
+			if e.Mode == elevator.ModeNormal {
+				bank.Dispatchable++
+			}
+		}
+	}
+	return bank
+}
+
+// copy makes a building that shares nothing with b and tells nobody about
+// what happens to it -- caller holds the lock
+func (b *Building) copy() *Building {
+	c := &Building{
+		ID:                   b.ID,
+		NumFloors:            b.NumFloors,
+		RecallFloor:          b.RecallFloor,
+		AlternateRecallFloor: b.AlternateRecallFloor,
+		FireRecall:           b.FireRecall,
+		activeRecallFloor:    b.activeRecallFloor,
+		fireServiceKey:       b.fireServiceKey,
+		EmergencyPower:       b.EmergencyPower,
+		LobbyFloor:           b.LobbyFloor,
+		emergencyRunningCars: b.emergencyRunningCars,
+		priorityCallKey:      b.priorityCallKey,
+		MinInService:         b.MinInService,
+		nextWindowID:         b.nextWindowID,
+		clock:                b.clock,
+		ReassignThreshold:    b.ReassignThreshold,
+		ReassignHoldTime:     b.ReassignHoldTime,
+		MaxWait:              b.MaxWait,
+		agingPolicy:          b.agingPolicy,
+		dispatchStrategy:     b.dispatchStrategy,
+	}
+	c.ElevatorList = make([]*elevator.Elevator, 0, len(b.ElevatorList))
+	for _, e := range b.ElevatorList {
+		car := e.Copy()
+		car.Clock = func() time.Time { return c.clock() }
+		c.ElevatorList = append(c.ElevatorList, car)
+	}
+	c.smokeDetectors = make(map[int]bool)
+	for floor, active := range b.smokeDetectors {
+		c.smokeDetectors[floor] = active
+	}
+	c.emergencySelected = append([]int{}, b.emergencySelected...)
+	c.emergencyReturnQueue = append([]int{}, b.emergencyReturnQueue...)
+	c.priorityCallLog = append([]PriorityCallRecord{}, b.priorityCallLog...)
+	c.maintenanceWindows = make([]*MaintenanceWindow, 0, len(b.maintenanceWindows))
+	for _, w := range b.maintenanceWindows {
+		window := *w
+		c.maintenanceWindows = append(c.maintenanceWindows, &window)
+	}
+	c.hallCalls = make(map[hallCallKey]*HallCall)
+	for key, hc := range b.hallCalls {
+		call := *hc
+		c.hallCalls[key] = &call
+	}
+	c.tickets = make(map[string]*Ticket)
+	for id, t := range b.tickets {
+		ticket := t.copy()
+		c.tickets[id] = &ticket
+	}
+	c.carCalls = make(map[carCallKey]string)
+	for key, id := range b.carCalls {
+		c.carCalls[key] = id
+	}
+	c.answered = make(map[int][]string)
+	for id, tickets := range b.answered {
+		c.answered[id] = append([]string{}, tickets...)
+	}
+	c.floorLabels = make(map[int]string)
+	for floor, label := range b.floorLabels {
+		c.floorLabels[floor] = label
+	}
+	c.groups = make([]Group, 0, len(b.groups))
+	for _, g := range b.groups {
+		c.groups = append(c.groups, Group{Name: g.Name, Elevators: append([]int{}, g.Elevators...)})
+	}
+	c.subscribers = make(map[int]chan Event)
+	return c
+}
+
+// diff compares two copies of a building
+func diff(before *Building, after *Building) Preview {
+	p := Preview{
+		Dropped:    make([]DroppedCall, 0),
+		Reassigned: make([]Reassignment, 0),
+		Cars:       make([]CarState, 0, len(after.ElevatorList)),
+		Capacity:   after.capacity(),
+	}
+	for key, hc := range before.hallCalls {
+		call := elevator.Call{Floor: key.floor, Direction: key.direction, Type: elevator.HallCall, RegisteredAt: hc.RegisteredAt}
+		now, ok := after.hallCalls[key]
+		switch {
+		case !ok:
+			p.Dropped = append(p.Dropped, DroppedCall{Call: call, ElevatorID: hc.ElevatorID})
+		case now.ElevatorID != hc.ElevatorID:
+			p.Reassigned = append(p.Reassigned, Reassignment{Call: call, ElevatorID: now.ElevatorID})
+		}
+	}
+	for _, e := range before.ElevatorList {
+		for _, call := range e.CallList.Snapshot() {
+			if call.Type == elevator.CarCall && !after.carHasCall(e.ElevatorID, call) {
+				p.Dropped = append(p.Dropped, DroppedCall{Call: call, ElevatorID: e.ElevatorID})
+			}
+		}
+	}
+	sort.Slice(p.Dropped, func(i, j int) bool { return lessCall(p.Dropped[i].Call, p.Dropped[j].Call) })
+	sort.Slice(p.Reassigned, func(i, j int) bool { return lessCall(p.Reassigned[i].Call, p.Reassigned[j].Call) })
+
+	for _, e := range after.ElevatorList {
+		p.Cars = append(p.Cars, CarState{
+			ElevatorID:   e.ElevatorID,
+			CurrentFloor: e.CurrentFloor,
+			InService:    e.InService,
+			Mode:         e.Mode,
+			DoorsOpen:    e.DoorsOpen,
+			Calls:        e.CallList.Snapshot(),
+		})
+	}
+	return p
+}
+
+func lessCall(a elevator.Call, b elevator.Call) bool {
+	if a.Floor != b.Floor {
+		return a.Floor < b.Floor
+	}
+	if a.Direction != b.Direction {
+		return a.Direction < b.Direction
+	}
+	return a.Type < b.Type
+}
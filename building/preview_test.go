@@ -0,0 +1,100 @@
+package building
+
+import (
+	"testing"
+
+	"github.com/tcotav/elevatormgr/elevator"
+)
+
+func TestBuildingPreviewReset(t *testing.T) {
+	b := NewBuilding(1, 10, 2)
+	events, unsubscribe := b.Subscribe(20)
+	defer unsubscribe()
+	elID, _ := b.CallElevator(5, 1)
+	b.PushDestinationButton(elID, 8)
+	for len(events) > 0 {
+		<-events
+	}
+
+	p, err := b.Preview(func(sim *Building) error {
+		_, _, err := sim.ResetElevator(elID)
+		return err
+	})
+	if err != nil {
+		t.Errorf("Reset preview should not fail, got %s", err.Error())
+	}
+	if len(p.Reassigned) != 1 || p.Reassigned[0].Call.Floor != 5 || p.Reassigned[0].ElevatorID == elID {
+		t.Errorf("Hall call on floor 5 should move to the other car, got %v", p.Reassigned)
+	}
+	if len(p.Dropped) != 1 || p.Dropped[0].Call.Floor != 8 || p.Dropped[0].Call.Type != elevator.CarCall {
+		t.Errorf("Car call to floor 8 should be dropped, got %v", p.Dropped)
+	}
+	if len(p.Cars) != 2 || len(p.Cars[elID].Calls) != 0 {
+		t.Errorf("Reset car should be left with no calls, got %v", p.Cars)
+	}
+
+	// and nothing really happened
+	if b.GetElevator(elID).CallList.Len() != 2 {
+		t.Errorf("Elevator %d should still have 2 calls, got %d", elID, b.GetElevator(elID).CallList.Len())
+	}
+	if hc := b.GetHallCalls(); len(hc) != 1 || hc[0].ElevatorID != elID {
+		t.Errorf("Hall call should still be on elevator %d, got %v", elID, hc)
+	}
+	if len(events) != 0 {
+		t.Errorf("A preview should not emit events, got %d", len(events))
+	}
+
+	if _, err := b.Preview(func(sim *Building) error {
+		_, _, err := sim.ResetElevator(10)
+		return err
+	}); err == nil {
+		t.Errorf("Previewing a reset of elevator 10 should fail")
+	}
+}
+
+func TestBuildingPreviewCapacity(t *testing.T) {
+	b := NewBuilding(1, 10, 2)
+	b.SetElevatorInServiceStatus(1, false)
+	b.CallElevator(5, 1)
+
+	// taking out the last car leaves nobody for the hall call
+	p, err := b.Preview(func(sim *Building) error {
+		sim.SetElevatorInServiceStatus(0, false)
+		return nil
+	})
+	if err != nil {
+		t.Errorf("Out of service preview should not fail, got %s", err.Error())
+	}
+	if len(p.Dropped) != 1 || p.Dropped[0].Call.Type != elevator.HallCall {
+		t.Errorf("Hall call on floor 5 should be dropped, got %v", p.Dropped)
+	}
+	capacity := p.Capacity[0]
+	if capacity.Cars != 2 || capacity.InService != 0 || capacity.Dispatchable != 0 {
+		t.Errorf("No cars should be left in service, got %v", capacity)
+	}
+	if real := b.GetCapacity()[0]; real.InService != 1 {
+		t.Errorf("Elevator 0 should really still be in service, got %v", real)
+	}
+}
+
+func TestBuildingPreviewOverride(t *testing.T) {
+	b := NewBuilding(1, 10, 2)
+	b.PushDestinationButton(0, 4)
+
+	p, err := b.Preview(func(sim *Building) error {
+		return sim.MaintenanceCallOverride(0, 9, -1)
+	})
+	if err != nil {
+		t.Errorf("Override preview should not fail, got %s", err.Error())
+	}
+	calls := p.Cars[0].Calls
+	if len(calls) != 2 || calls[0].Floor != 9 || !calls[0].Express {
+		t.Errorf("Override should put floor 9 at the head of the queue, got %v", calls)
+	}
+	if len(p.Dropped) != 0 {
+		t.Errorf("Override should not drop calls, got %v", p.Dropped)
+	}
+	if b.GetElevator(0).CallList.Len() != 1 {
+		t.Errorf("Elevator 0 should still have 1 call, got %d", b.GetElevator(0).CallList.Len())
+	}
+}
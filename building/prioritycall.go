@@ -0,0 +1,98 @@
+package building
+
+import (
+	"time"
+
+	"github.com/tcotav/elevatormgr/elevator"
+)
+
+// Priority hall calls (code blue, VIP).  The best car puts its calls aside and
+// runs express to the calling floor and then to the destination before picking
+// its deferred calls back up.  They need the priority key and every attempt,
+// granted or not, goes on the building's priority call log.
+
+// PriorityCallRecord is one entry in the priority call audit trail
+type PriorityCallRecord struct {
+	Time        time.Time
+	Floor       int
+	Destination int
+	ElevatorID  int
+	RequestedBy string
+	Reason      string
+	Authorized  bool
+	Error       string `json:",omitempty"`
+}
+
+// SetPriorityCallKey sets the key required to place a priority call
+func (b *Building) SetPriorityCallKey(key string) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.priorityCallKey = key
+}
+
+// PriorityCall sends the best car express to floor and then to destination.
+// It returns the audit record for the call.
+func (b *Building) PriorityCall(floor int, destination int, key string, requestedBy string, reason string) (PriorityCallRecord, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	record := PriorityCallRecord{
+		Time:        b.clock(),
+		Floor:       floor,
+		Destination: destination,
+		ElevatorID:  -1,
+		RequestedBy: requestedBy,
+		Reason:      reason,
+	}
+	el, err := b.priorityCall(&record, key)
+	if err != nil {
+		record.Error = err.Error()
+	} else {
+		record.ElevatorID = el.ElevatorID
+	}
+	b.priorityCallLog = append(b.priorityCallLog, record)
+	return record, err
+}
+
+// priorityCall does the work for PriorityCall -- caller holds the lock
+func (b *Building) priorityCall(record *PriorityCallRecord, key string) (*elevator.Elevator, error) {
+	if b.priorityCallKey == "" || key != b.priorityCallKey {
+		return nil, b.errorf(ErrNotAuthorized, -1, "not authorized for priority calls in building: %d", b.ID)
+	}
+	record.Authorized = true
+	if b.FireRecall {
+		return nil, b.errorf(ErrConflict, -1, "building: %d is in fire recall, hall calls are not accepted", b.ID)
+	}
+	if record.Floor < 1 || record.Floor > b.NumFloors || record.Destination < 1 || record.Destination > b.NumFloors || record.Floor == record.Destination {
+		return nil, b.errorf(ErrInvalidFloor, -1, "invalid priority call from floor: %d to floor: %d in building: %d", record.Floor, record.Destination, b.ID)
+	}
+
+	// best car is the closest one that stops at both floors, the least busy one if it's a tie
+	var el *elevator.Elevator
+	for _, e := range b.ElevatorList {
+		if !e.InService || e.Mode != elevator.ModeNormal {
+			continue
+		}
+		if !e.Serves(record.Floor) || !e.Serves(record.Destination) {
+			continue
+		}
+		if el == nil {
+			el = e
+			continue
+		}
+		d, elD := e.DistanceToFloor(record.Floor), el.DistanceToFloor(record.Floor)
+		if d < elD || (d == elD && e.CallList.Len() < el.CallList.Len()) {
+			el = e
+		}
+	}
+	if el == nil {
+		return nil, b.errorf(ErrNoElevators, -1, "no elevators available for a priority call from floor: %d to floor: %d in building: %d", record.Floor, record.Destination, b.ID)
+	}
+	return el, el.StartPriorityRun(record.Floor, record.Destination)
+}
+
+// GetPriorityCallLog returns the priority call audit trail
+func (b *Building) GetPriorityCallLog() []PriorityCallRecord {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return append([]PriorityCallRecord{}, b.priorityCallLog...)
+}
@@ -0,0 +1,87 @@
+package building
+
+import (
+	"testing"
+	"time"
+
+	"github.com/tcotav/elevatormgr/elevator"
+)
+
+func TestBuildingPriorityCall(t *testing.T) {
+	b := NewBuilding(1, 10, 2)
+	b.SetPriorityCallKey("codeblue")
+	b.SetElevatorInServiceStatus(1, false)
+
+	elID, _ := b.CallElevator(3, 1)
+	b.PushDestinationButton(elID, 9)
+
+	_, err := b.PriorityCall(6, 2, "wrongkey", "nurse", "code blue")
+	if err == nil {
+		t.Errorf("Priority call should need the priority key")
+	}
+
+	record, err := b.PriorityCall(6, 2, "codeblue", "nurse", "code blue")
+	if err != nil {
+		t.Errorf("Priority call should be placed, got %s", err.Error())
+	}
+	if record.ElevatorID != elID {
+		t.Errorf("Priority call should go to elevator %d, got %d", elID, record.ElevatorID)
+	}
+	e := b.GetElevator(elID)
+	if e.Mode != elevator.ModePriority {
+		t.Errorf("Elevator should be on a priority run, got %s", e.Mode)
+	}
+	if len(e.DeferredCalls) != 2 {
+		t.Errorf("Elevator should have deferred 2 calls, got %d", len(e.DeferredCalls))
+	}
+	if _, err := b.CallElevator(4, 1); err == nil {
+		t.Errorf("Hall calls should skip the car on a priority run")
+	}
+
+	call, _ := b.NextStop(elID)
+	if call.Floor != 6 {
+		t.Errorf("Elevator should be going to floor 6, got %d", call.Floor)
+	}
+	call, _ = b.NextStop(elID)
+	if call.Floor != 2 {
+		t.Errorf("Elevator should be going to floor 2, got %d", call.Floor)
+	}
+	if e.Mode != elevator.ModeNormal {
+		t.Errorf("Elevator should be back in normal mode, got %s", e.Mode)
+	}
+	if e.GetCallList().Len() != 2 {
+		t.Errorf("Elevator should have picked its deferred calls back up, got %d", e.GetCallList().Len())
+	}
+
+	log := b.GetPriorityCallLog()
+	if len(log) != 2 {
+		t.Errorf("Priority call log should have 2 entries, got %d", len(log))
+	}
+	if log[0].Authorized || !log[1].Authorized {
+		t.Errorf("Priority call log should record the denied call and the granted one")
+	}
+}
+
+func TestBuildingPriorityCallServedFloors(t *testing.T) {
+	b := NewBuilding(1, 10, 2)
+	b.SetPriorityCallKey("codeblue")
+	now := time.Date(2024, 3, 1, 2, 0, 0, 0, time.UTC)
+	b.clock = func() time.Time { return now }
+	// car 0 is closer but skips floor 7
+	b.ConfigureElevator(0, ElevatorSpec{ServedFloors: []int{1, 2, 3, 4, 5}})
+	b.MaintenanceCallOverride(1, 9, 1)
+	b.NextStop(1)
+
+	record, err := b.PriorityCall(2, 7, "codeblue", "nurse", "code blue")
+	if err != nil || record.ElevatorID != 1 {
+		t.Errorf("Priority call should go to elevator 1, got %d %v", record.ElevatorID, err)
+	}
+	if !record.Time.Equal(now) {
+		t.Errorf("Priority call should be logged on the building clock, got %s", record.Time)
+	}
+
+	b.SetElevatorInServiceStatus(1, false)
+	if _, err := b.PriorityCall(2, 7, "codeblue", "nurse", "code blue"); err == nil {
+		t.Errorf("Priority call to floor 7 should fail with no car that stops there")
+	}
+}
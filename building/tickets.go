@@ -0,0 +1,169 @@
+package building
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"time"
+
+	"github.com/tcotav/elevatormgr/elevator"
+)
+
+// Call tickets.  Every hall and car call gets a ticket with a unique ID so a
+// passenger app or kiosk can follow what happened to it.  Status changes are
+// kept in the ticket's history and sent out as EventCallStatus events.
+// Finished tickets are kept for ticketRetention and then dropped.
+
+// CallStatus is where a call is in its lifecycle
+type CallStatus string
+
+const (
+	CallRegistered CallStatus = "registered"
+	CallAssigned   CallStatus = "assigned"
+	CallReassigned CallStatus = "reassigned"
+	CallAnswered   CallStatus = "answered"
+	CallCompleted  CallStatus = "completed"
+	CallCancelled  CallStatus = "cancelled"
+	CallExpired    CallStatus = "expired"
+)
+
+// how long finished tickets stick around to be looked up
+const ticketRetention = time.Hour
+
+// Done says whether the call has reached the end of its lifecycle
+func (s CallStatus) Done() bool {
+	return s == CallCompleted || s == CallCancelled || s == CallExpired
+}
+
+// TicketStatusChange is one step in a ticket's history
+type TicketStatusChange struct {
+	Status     CallStatus
+	ElevatorID int
+	Time       time.Time
+}
+
+// Ticket follows one call through its lifecycle
+type Ticket struct {
+	ID         string
+	BuildingID int
+	Type       elevator.CallType
+	Floor      int
+	Direction  int
+	ElevatorID int
+	Status     CallStatus
+	History    []TicketStatusChange
+}
+
+type carCallKey struct {
+	elevatorID int
+	floor      int
+}
+
+// GetTicket looks up a call ticket by ID
+func (b *Building) GetTicket(ticketID string) (Ticket, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.prune()
+	t, ok := b.tickets[ticketID]
+	if !ok {
+		return Ticket{}, b.errorf(ErrCallNotFound, -1, "no call with ID: %s in building: %d", ticketID, b.ID)
+	}
+	return t.copy(), nil
+}
+
+// newTicket creates a ticket for a call that's been made and assigned -- caller holds the lock
+func (b *Building) newTicket(callType elevator.CallType, floor int, direction int, elevatorID int) *Ticket {
+	t := &Ticket{
+		ID:         newTicketID(),
+		BuildingID: b.ID,
+		Type:       callType,
+		Floor:      floor,
+		Direction:  direction,
+		ElevatorID: -1,
+		History:    make([]TicketStatusChange, 0),
+	}
+	b.tickets[t.ID] = t
+	b.setTicketStatus(t.ID, CallRegistered, -1)
+	b.setTicketStatus(t.ID, CallAssigned, elevatorID)
+	return t
+}
+
+// setTicketStatus moves a ticket on and tells the subscribers -- caller holds the lock
+func (b *Building) setTicketStatus(ticketID string, status CallStatus, elevatorID int) {
+	t, ok := b.tickets[ticketID]
+	if !ok || t.Status.Done() {
+		return
+	}
+	now := b.clock()
+	t.Status = status
+	if elevatorID >= 0 {
+		t.ElevatorID = elevatorID
+	}
+	t.History = append(t.History, TicketStatusChange{Status: status, ElevatorID: elevatorID, Time: now})
+	b.emit(Event{
+		Type:       EventCallStatus,
+		Time:       now,
+		Floor:      t.Floor,
+		Direction:  t.Direction,
+		ElevatorID: t.ElevatorID,
+		TicketID:   t.ID,
+		Status:     status,
+	})
+}
+
+// carCallAnswered completes the car call ticket once the car gets there -- caller holds the lock
+func (b *Building) carCallAnswered(floor int, e *elevator.Elevator) {
+	key := carCallKey{e.ElevatorID, floor}
+	if ticketID, ok := b.carCalls[key]; ok {
+		delete(b.carCalls, key)
+		b.setTicketStatus(ticketID, CallCompleted, e.ElevatorID)
+	}
+}
+
+// elevatorDeparted completes the hall calls the car answered at its last stop -- caller holds the lock
+func (b *Building) elevatorDeparted(e *elevator.Elevator) {
+	for _, ticketID := range b.answered[e.ElevatorID] {
+		b.setTicketStatus(ticketID, CallCompleted, e.ElevatorID)
+	}
+	delete(b.answered, e.ElevatorID)
+}
+
+// prune expires calls that have gone missing from their car and drops old
+// finished tickets -- caller holds the lock
+func (b *Building) prune() {
+	b.pruneHallCalls()
+	for key, ticketID := range b.carCalls {
+		t := b.tickets[ticketID]
+		call := elevator.Call{Floor: key.floor, Direction: t.Direction, Type: elevator.CarCall}
+		if !b.carHasCall(key.elevatorID, call) {
+			delete(b.carCalls, key)
+			b.setTicketStatus(ticketID, CallExpired, key.elevatorID)
+		}
+	}
+	cutoff := b.clock().Add(-ticketRetention)
+	for id, t := range b.tickets {
+		if t.Status.Done() && t.History[len(t.History)-1].Time.Before(cutoff) {
+			delete(b.tickets, id)
+		}
+	}
+}
+
+// copy so callers can't reach into the building's ticket
+func (t *Ticket) copy() Ticket {
+	c := *t
+	c.History = append([]TicketStatusChange{}, t.History...)
+	return c
+}
+
+func newTicketID() string {
+	buf := make([]byte, 8)
+	rand.Read(buf)
+	return hex.EncodeToString(buf)
+}
+
+// direction of travel from one floor to another
+func callDirection(from int, to int) int {
+	if to > from {
+		return 1
+	}
+	return -1
+}
@@ -0,0 +1,175 @@
+package building
+
+import (
+	"testing"
+)
+
+func TestBuildingHallCallTicket(t *testing.T) {
+	b := NewBuilding(1, 10, 2)
+
+	ticket, err := b.RegisterHallCall(4, 1)
+	if err != nil {
+		t.Errorf("Hall call should be registered, got %s", err.Error())
+	}
+	if ticket.ID == "" || ticket.Status != CallAssigned {
+		t.Errorf("Hall call should have an assigned ticket, got %v", ticket)
+	}
+	again, _ := b.RegisterHallCall(4, 1)
+	if again.ID != ticket.ID {
+		t.Errorf("Second push should get the same ticket %s, got %s", ticket.ID, again.ID)
+	}
+
+	b.ReassignHallCall(4, 1, 1-ticket.ElevatorID)
+	ticket, _ = b.GetTicket(ticket.ID)
+	if ticket.Status != CallReassigned {
+		t.Errorf("Ticket should be reassigned, got %s", ticket.Status)
+	}
+
+	b.NextStop(ticket.ElevatorID)
+	ticket, _ = b.GetTicket(ticket.ID)
+	if ticket.Status != CallAnswered {
+		t.Errorf("Ticket should be answered, got %s", ticket.Status)
+	}
+
+	// the passenger gets on and picks a floor, the car leaves
+	carTicket, err := b.RegisterCarCall(ticket.ElevatorID, 8)
+	if err != nil {
+		t.Errorf("Car call should be registered, got %s", err.Error())
+	}
+	b.NextStop(ticket.ElevatorID)
+	ticket, _ = b.GetTicket(ticket.ID)
+	if ticket.Status != CallCompleted {
+		t.Errorf("Ticket should be completed, got %s", ticket.Status)
+	}
+	carTicket, _ = b.GetTicket(carTicket.ID)
+	if carTicket.Status != CallCompleted {
+		t.Errorf("Car call ticket should be completed, got %s", carTicket.Status)
+	}
+
+	statuses := make([]CallStatus, 0)
+	for _, change := range ticket.History {
+		statuses = append(statuses, change.Status)
+	}
+	expected := []CallStatus{CallRegistered, CallAssigned, CallReassigned, CallAnswered, CallCompleted}
+	if len(statuses) != len(expected) {
+		t.Errorf("Ticket history should be %v, got %v", expected, statuses)
+	}
+
+	if _, err := b.GetTicket("nope"); err == nil {
+		t.Errorf("Looking up a ticket that doesn't exist should fail")
+	}
+}
+
+func TestBuildingTicketExpiredAndCancelled(t *testing.T) {
+	b := NewBuilding(1, 10, 2)
+
+	cancelled, _ := b.RegisterHallCall(6, -1)
+	b.CancelHallCall(6, -1, "double tap")
+	cancelled, _ = b.GetTicket(cancelled.ID)
+	if cancelled.Status != CallCancelled {
+		t.Errorf("Ticket should be cancelled, got %s", cancelled.Status)
+	}
+
+	hall, _ := b.RegisterHallCall(3, 1)
+	car, _ := b.RegisterCarCall(1, 9)
+	b.ActivateFireRecall()
+	hall, _ = b.GetTicket(hall.ID)
+	car, _ = b.GetTicket(car.ID)
+	if hall.Status != CallExpired || car.Status != CallExpired {
+		t.Errorf("Fire recall should expire the calls, got %s and %s", hall.Status, car.Status)
+	}
+}
+
+func TestBuildingHallAndCarCallSameStop(t *testing.T) {
+	// car call first, then somebody on 5 wants to go up too
+	b := NewBuilding(1, 10, 1)
+	carTicket, err := b.RegisterCarCall(0, 5)
+	if err != nil {
+		t.Errorf("Car call should be registered, got %s", err.Error())
+	}
+	hallTicket, err := b.RegisterHallCall(5, 1)
+	if err != nil || hallTicket.ElevatorID != 0 {
+		t.Errorf("Hall call should be registered on elevator 0, got %v %v", hallTicket, err)
+	}
+	if len(b.GetHallCalls()) != 1 {
+		t.Errorf("Hall call should be in the registry")
+	}
+
+	// one stop answers both
+	b.NextStop(0)
+	if b.GetElevator(0).CallList.Len() != 0 {
+		t.Errorf("Elevator 0 should have no calls left, got %v", b.GetElevator(0).CallList.Calls)
+	}
+	carTicket, _ = b.GetTicket(carTicket.ID)
+	hallTicket, _ = b.GetTicket(hallTicket.ID)
+	if carTicket.Status != CallCompleted || hallTicket.Status != CallAnswered {
+		t.Errorf("Car call should be completed and hall call answered, got %s and %s", carTicket.Status, hallTicket.Status)
+	}
+
+	// the other way round
+	b = NewBuilding(1, 10, 1)
+	hallTicket, err = b.RegisterHallCall(5, 1)
+	if err != nil {
+		t.Errorf("Hall call should be registered, got %s", err.Error())
+	}
+	carTicket, err = b.RegisterCarCall(0, 5)
+	if err != nil {
+		t.Errorf("Car call for the same stop should be registered, got %s", err.Error())
+	}
+	b.NextStop(0)
+	if b.GetElevator(0).CallList.Len() != 0 {
+		t.Errorf("Elevator 0 should have no calls left, got %v", b.GetElevator(0).CallList.Calls)
+	}
+	carTicket, _ = b.GetTicket(carTicket.ID)
+	hallTicket, _ = b.GetTicket(hallTicket.ID)
+	if carTicket.Status != CallCompleted || hallTicket.Status != CallAnswered {
+		t.Errorf("Car call should be completed and hall call answered, got %s and %s", carTicket.Status, hallTicket.Status)
+	}
+}
+
+func TestBuildingMoveHallCallOntoCarCall(t *testing.T) {
+	b := NewBuilding(1, 10, 2)
+	hallTicket, _ := b.RegisterHallCall(5, 1)
+	other := 1 - hallTicket.ElevatorID
+	b.RegisterCarCall(other, 5)
+
+	if err := b.ReassignHallCall(5, 1, other); err != nil {
+		t.Errorf("Hall call should move onto a car with a car call to the same stop, got %s", err.Error())
+	}
+	if b.GetElevator(other).CallList.Len() != 2 {
+		t.Errorf("Elevator %d should have the hall call and the car call, got %v", other, b.GetElevator(other).CallList.Calls)
+	}
+	// draining the car hands the hall call back, onto another car call to 5
+	b.RegisterCarCall(hallTicket.ElevatorID, 5)
+	reassigned, err := b.DrainElevator(other)
+	if err != nil || len(reassigned) != 1 || reassigned[0].ElevatorID != hallTicket.ElevatorID {
+		t.Errorf("Hall call should go back to elevator %d, got %v %v", hallTicket.ElevatorID, reassigned, err)
+	}
+}
+
+func TestBuildingTicketForExistingStop(t *testing.T) {
+	b := NewBuilding(1, 10, 1)
+	// maintenance already sent the car to 6
+	b.MaintenanceCallOverride(0, 6, 1)
+	ticket, err := b.RegisterCarCall(0, 6)
+	if err != nil || ticket.ID == "" {
+		t.Errorf("Car call to a floor the car already stops at should get a ticket, got %v %v", ticket, err)
+	}
+	// a hall call the car picked up without going through the registry
+	b.GetElevator(0).CallElevator(8, 1)
+	hallTicket, err := b.RegisterHallCall(8, 1)
+	if err != nil || hallTicket.ID == "" || hallTicket.ElevatorID != 0 {
+		t.Errorf("Hall call at a stop the car already makes should get a ticket, got %v %v", hallTicket, err)
+	}
+
+	b.NextStop(0)
+	ticket, _ = b.GetTicket(ticket.ID)
+	if ticket.Status != CallCompleted {
+		t.Errorf("Car call ticket should be completed at 6, got %s", ticket.Status)
+	}
+	b.NextStop(0)
+	hallTicket, _ = b.GetTicket(hallTicket.ID)
+	if hallTicket.Status != CallAnswered {
+		t.Errorf("Hall call ticket should be answered at 8, got %s", hallTicket.Status)
+	}
+}
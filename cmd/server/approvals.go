@@ -0,0 +1,350 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	log "github.com/sirupsen/logrus"
+)
+
+// Two-person approval.  Dangerous commands don't run when they're made, they
+// are parked as a pending change and answered with 202.  A second user with
+// the role the command needs has to approve it before approvalTTL runs out,
+// the original request is then run through the router on their behalf.
+// Fire recall and emergency power are deliberately not on the list, they are
+// life safety and can't wait for a second pair of hands.
+
+// ApprovalStatus is where a pending change is at
+type ApprovalStatus string
+
+const (
+	ApprovalPending  ApprovalStatus = "pending"
+	ApprovalApproved ApprovalStatus = "approved"
+	ApprovalRejected ApprovalStatus = "rejected"
+	ApprovalExpired  ApprovalStatus = "expired"
+)
+
+// PendingChange is a dangerous command waiting on a second user
+type PendingChange struct {
+	ID          string          `json:"id"`
+	Action      string          `json:"action"`
+	Building    int             `json:"building"`
+	Method      string          `json:"method"`
+	URL         string          `json:"url"`
+	Body        json.RawMessage `json:"body,omitempty"` // the v1 routes take JSON bodies
+	Role        Role            `json:"role"`
+	RequestedBy string          `json:"requestedBy"`
+	RequestedAt time.Time       `json:"requestedAt"`
+	ExpiresAt   time.Time       `json:"expiresAt"`
+	Status      ApprovalStatus  `json:"status"`
+	DecidedBy   string          `json:"decidedBy,omitempty"`
+	DecidedAt   time.Time       `json:"decidedAt,omitempty"`
+	Result      int             `json:"result,omitempty"` // status code of the approved command
+}
+
+// how long a change waits for approval
+var approvalTTL = 15 * time.Minute
+
+// how long decided changes stay in the queue for people to look at
+const approvalRetention = time.Hour
+
+type approvalQueue struct {
+	mu      sync.Mutex
+	changes map[string]*PendingChange
+	clock   func() time.Time
+}
+
+var approvals = &approvalQueue{changes: make(map[string]*PendingChange), clock: time.Now}
+
+// expire times out pending changes and forgets old decided ones -- caller holds the lock
+func (q *approvalQueue) expire() {
+	now := q.clock()
+	for id, p := range q.changes {
+		if p.Status == ApprovalPending && !now.Before(p.ExpiresAt) {
+			p.Status = ApprovalExpired
+			p.DecidedAt = p.ExpiresAt
+			log.Warn(fmt.Sprintf("Pending change %s (%s %s by %s) expired without approval.", p.ID, p.Method, p.URL, p.RequestedBy))
+		}
+		if p.Status != ApprovalPending && now.Sub(p.DecidedAt) > approvalRetention {
+			delete(q.changes, id)
+		}
+	}
+}
+
+// request parks a change, asking for the same thing twice gets the same change
+func (q *approvalQueue) request(action string, buildingID int, r *http.Request, body json.RawMessage, role Role, requestedBy string) (PendingChange, error) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	q.expire()
+	for _, p := range q.changes {
+		if p.Status == ApprovalPending && p.Method == r.Method && p.URL == r.URL.RequestURI() && bytes.Equal(p.Body, body) {
+			return *p, nil
+		}
+	}
+	id, err := newApprovalID()
+	if err != nil {
+		return PendingChange{}, err
+	}
+	now := q.clock()
+	p := &PendingChange{
+		ID:          id,
+		Action:      action,
+		Building:    buildingID,
+		Method:      r.Method,
+		URL:         r.URL.RequestURI(),
+		Body:        body,
+		Role:        role,
+		RequestedBy: requestedBy,
+		RequestedAt: now,
+		ExpiresAt:   now.Add(approvalTTL),
+		Status:      ApprovalPending,
+	}
+	q.changes[id] = p
+	return *p, nil
+}
+
+// the error codes for what decide turns down
+var approvalCodes = map[int]string{
+	http.StatusNotFound:  "approval_not_found",
+	http.StatusConflict:  "approval_decided",
+	http.StatusForbidden: "approver_not_allowed",
+}
+
+// decide approves or rejects a pending change, key is whoever is deciding
+func (q *approvalQueue) decide(id string, key APIKey, status ApprovalStatus) (PendingChange, int, error) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	q.expire()
+	p, ok := q.changes[id]
+	if !ok {
+		return PendingChange{}, http.StatusNotFound, fmt.Errorf("pending change: %s does not exist", id)
+	}
+	// only someone who could make the change can decide on it
+	if !key.servesBuilding(p.Building) {
+		return PendingChange{}, http.StatusForbidden, fmt.Errorf("pending change: %s is for building: %d, %s is not authorized for it", id, p.Building, key.Name)
+	}
+	principal, role := key.Name, key.Role
+	if p.Status != ApprovalPending {
+		return *p, http.StatusConflict, fmt.Errorf("pending change: %s is already %s", id, p.Status)
+	}
+	if status == ApprovalApproved {
+		if principal == p.RequestedBy {
+			return *p, http.StatusForbidden, fmt.Errorf("pending change: %s needs approving by someone other than %s", id, principal)
+		}
+		if roleRank[role] < roleRank[p.Role] {
+			return *p, http.StatusForbidden, fmt.Errorf("pending change: %s needs approving by a %s", id, p.Role)
+		}
+	}
+	p.Status = status
+	p.DecidedBy = principal
+	p.DecidedAt = q.clock()
+	return *p, http.StatusOK, nil
+}
+
+// setResult records how the approved command went
+func (q *approvalQueue) setResult(id string, code int) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if p, ok := q.changes[id]; ok {
+		p.Result = code
+	}
+}
+
+// list is the changes for the buildings key is good for
+func (q *approvalQueue) list(key APIKey) []PendingChange {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	q.expire()
+	changes := make([]PendingChange, 0, len(q.changes))
+	for _, p := range q.changes {
+		if key.servesBuilding(p.Building) {
+			changes = append(changes, *p)
+		}
+	}
+	sort.Slice(changes, func(i, j int) bool { return changes[i].RequestedAt.Before(changes[j].RequestedAt) })
+	return changes
+}
+
+func newApprovalID() (string, error) {
+	b := make([]byte, 8)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
+
+// approvedKey marks a request being run after approval.  It rides on the
+// request context, gin clears its own keys when a request is run again.
+type approvedKey struct{}
+
+func approvedChange(c *gin.Context) (PendingChange, bool) {
+	p, ok := c.Request.Context().Value(approvedKey{}).(PendingChange)
+	return p, ok
+}
+
+// requireApproval parks the request as a pending change when needed says so,
+// it goes after requireRole and the role is what an approver needs
+func requireApproval(action string, role Role, needed func(c *gin.Context) bool) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if p, ok := approvedChange(c); ok && p.Method == c.Request.Method && p.URL == c.Request.URL.RequestURI() {
+			c.Next()
+			return
+		}
+		// a dry run changes nothing, so there's nothing to approve
+		if dryRun(c) || needed != nil && !needed(c) {
+			c.Next()
+			return
+		}
+		body, err := peekBody(c)
+		if err == nil && body != nil && !json.Valid(body) {
+			err = fmt.Errorf("request body is not valid JSON")
+		}
+		if err != nil {
+			handleError(c, "approval", err)
+			c.Abort()
+			return
+		}
+		p, err := approvals.request(action, requestBuilding(c).ID, c.Request, body, role, c.GetString(principalKey))
+		if err != nil {
+			handleError(c, "approval", err)
+			c.Abort()
+			return
+		}
+		log.Info(fmt.Sprintf("Pending change %s: %s %s by %s needs approval by %s.", p.ID, p.Method, p.URL, p.RequestedBy, p.ExpiresAt.Format(time.RFC3339)))
+		c.AbortWithStatusJSON(http.StatusAccepted, gin.H{"approval": p})
+	}
+}
+
+// peakPeriod is a stretch of the day the building is busy, hours local time
+type peakPeriod struct {
+	Start int
+	End   int
+}
+
+var peakHours = []peakPeriod{{7, 10}, {16, 19}}
+
+// duringPeak is for commands that only need approval when the building is busy
+func duringPeak(c *gin.Context) bool {
+	return isPeak(approvals.clock())
+}
+
+// isPeak checks whether t is in peak hours
+func isPeak(t time.Time) bool {
+	hour := t.Local().Hour()
+	for _, p := range peakHours {
+		if hour >= p.Start && hour < p.End {
+			return true
+		}
+	}
+	return false
+}
+
+// overlapsPeak checks whether any of start to end falls in peak hours
+func overlapsPeak(start time.Time, end time.Time) bool {
+	if end.Sub(start) >= 24*time.Hour {
+		return true
+	}
+	for t := start.Local(); t.Before(end); t = time.Date(t.Year(), t.Month(), t.Day(), t.Hour()+1, 0, 0, 0, t.Location()) {
+		if isPeak(t) {
+			return true
+		}
+	}
+	return false
+}
+
+// windowDuringPeak is for approving maintenance windows that would drain a car in peak hours
+func windowDuringPeak(c *gin.Context) bool {
+	raw, err := peekBody(c)
+	if err != nil {
+		return false
+	}
+	var body maintenanceWindowRequest
+	if err := json.Unmarshal(raw, &body); err != nil {
+		return false
+	}
+	return overlapsPeak(body.Start, body.End)
+}
+
+// the changes waiting on approval, and recently decided ones
+func GetApprovals(c *gin.Context) {
+	c.JSON(http.StatusOK, gin.H{"approvals": approvals.list(callerKey(c))})
+}
+
+// approve a pending change and run it, the response is the command's
+func ApproveChange(router *gin.Engine) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		p, code, err := approvals.decide(c.Param("id"), callerKey(c), ApprovalApproved)
+		if err != nil {
+			log.Warn(fmt.Sprintf("approve - %s", err.Error()))
+			respondError(c, code, approvalCodes[code], err)
+			return
+		}
+		log.Info(fmt.Sprintf("Pending change %s: %s %s by %s approved by %s.", p.ID, p.Method, p.URL, p.RequestedBy, p.DecidedBy))
+		u, err := url.ParseRequestURI(p.URL)
+		if err != nil {
+			handleError(c, "approve", err)
+			return
+		}
+		req := c.Request.Clone(context.WithValue(c.Request.Context(), approvedKey{}, p))
+		req.Method = p.Method
+		req.URL = u
+		req.RequestURI = p.URL
+		req.Body = io.NopCloser(bytes.NewReader(p.Body))
+		req.ContentLength = int64(len(p.Body))
+		c.Request = req
+		router.HandleContext(c)
+		// the context now carries the command's handlers, don't let them run twice
+		c.Abort()
+		approvals.setResult(p.ID, c.Writer.Status())
+	}
+}
+
+// turn a pending change down
+func RejectChange(c *gin.Context) {
+	p, code, err := approvals.decide(c.Param("id"), callerKey(c), ApprovalRejected)
+	if err != nil {
+		log.Warn(fmt.Sprintf("reject - %s", err.Error()))
+		respondError(c, code, approvalCodes[code], err)
+		return
+	}
+	log.Info(fmt.Sprintf("Pending change %s: %s %s by %s rejected by %s.", p.ID, p.Method, p.URL, p.RequestedBy, p.DecidedBy))
+	c.JSON(http.StatusOK, gin.H{"approval": p})
+}
+
+// runApprovalExpiry times out pending changes even when nobody is looking at the queue
+func runApprovalExpiry(interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for range ticker.C {
+		approvals.mu.Lock()
+		approvals.expire()
+		approvals.mu.Unlock()
+	}
+}
+
+// peekBody reads the request body and puts it back for the handler
+func peekBody(c *gin.Context) ([]byte, error) {
+	if c.Request.Body == nil {
+		return nil, nil
+	}
+	body, err := io.ReadAll(c.Request.Body)
+	if err != nil {
+		return nil, err
+	}
+	c.Request.Body = io.NopCloser(bytes.NewReader(body))
+	if len(body) == 0 {
+		return nil, nil
+	}
+	return body, nil
+}
@@ -0,0 +1,230 @@
+package main
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/tcotav/elevatormgr/audit"
+)
+
+func pendingChange(t *testing.T, w *httptest.ResponseRecorder) PendingChange {
+	t.Helper()
+	if w.Code != http.StatusAccepted {
+		t.Fatalf("Expected status code 202, got %d", w.Code)
+	}
+	var body struct {
+		Approval PendingChange `json:"approval"`
+	}
+	json.Unmarshal(w.Body.Bytes(), &body)
+	return body.Approval
+}
+
+func decideChange(router http.Handler, id string, decision string, token string) *httptest.ResponseRecorder {
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("POST", "/approvals/"+id+"/"+decision, nil)
+	req.Header.Set("Authorization", "Bearer "+token)
+	router.ServeHTTP(w, req)
+	return w
+}
+
+func TestApprovals(t *testing.T) {
+	router := setupRouter()
+	apiKeys.set(append(testKeys(), APIKey{Name: "tech", Token: "tech-token", Role: RoleTechnician, Buildings: []int{1}}))
+	defer apiKeys.set(testKeys())
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("POST", "/resetElevator/1?reason=stuck", nil)
+	authorize(req)
+	router.ServeHTTP(w, req)
+	p := pendingChange(t, w)
+	if p.Status != ApprovalPending || p.RequestedBy != "test" || p.Action != "resetElevator" {
+		t.Errorf("Reset should be pending for test, got %v", p)
+	}
+
+	// asking again doesn't queue it twice
+	w = httptest.NewRecorder()
+	req, _ = http.NewRequest("POST", "/resetElevator/1?reason=stuck", nil)
+	authorize(req)
+	router.ServeHTTP(w, req)
+	if again := pendingChange(t, w); again.ID != p.ID {
+		t.Errorf("Asking twice should give the same pending change, got %s and %s", p.ID, again.ID)
+	}
+
+	w = httptest.NewRecorder()
+	req, _ = http.NewRequest("GET", "/approvals", nil)
+	authorize(req)
+	router.ServeHTTP(w, req)
+	if w.Code != http.StatusOK {
+		t.Errorf("Expected status code 200, got %d", w.Code)
+	}
+
+	// no approving your own change, and technicians can't approve resets
+	if w = decideChange(router, p.ID, "approve", testToken); w.Code != http.StatusForbidden {
+		t.Errorf("Expected status code 403 approving your own change, got %d", w.Code)
+	}
+	if w = decideChange(router, p.ID, "approve", "tech-token"); w.Code != http.StatusForbidden {
+		t.Errorf("Expected status code 403 for a technician approving a reset, got %d", w.Code)
+	}
+	if w = decideChange(router, "nope", "approve", testApproverToken); w.Code != http.StatusNotFound {
+		t.Errorf("Expected status code 404, got %d", w.Code)
+	}
+
+	if w = decideChange(router, p.ID, "approve", testApproverToken); w.Code != http.StatusOK {
+		t.Errorf("Expected status code 200 from the approved reset, got %d", w.Code)
+	}
+	entries := auditLog.Entries(audit.Filter{Action: "resetElevator"})
+	last := entries[len(entries)-1]
+	if last.Actor != "test" || last.ApprovedBy != "approver" || last.Reason != "stuck" {
+		t.Errorf("Audit entry should be by test approved by approver, got %v", last)
+	}
+	if w = decideChange(router, p.ID, "approve", testApproverToken); w.Code != http.StatusConflict {
+		t.Errorf("Expected status code 409 approving twice, got %d", w.Code)
+	}
+
+	// rejected changes never run
+	w = httptest.NewRecorder()
+	req, _ = http.NewRequest("POST", "/resetElevator/2", nil)
+	authorize(req)
+	router.ServeHTTP(w, req)
+	p = pendingChange(t, w)
+	if w = decideChange(router, p.ID, "reject", "tech-token"); w.Code != http.StatusOK {
+		t.Errorf("Expected status code 200 rejecting, got %d", w.Code)
+	}
+	if w = decideChange(router, p.ID, "approve", testApproverToken); w.Code != http.StatusConflict {
+		t.Errorf("Expected status code 409 approving a rejected change, got %d", w.Code)
+	}
+}
+
+func TestApprovalExpiry(t *testing.T) {
+	router := setupRouter()
+	now := time.Date(2024, 3, 1, 12, 0, 0, 0, time.Local)
+	clock := approvals.clock
+	approvals.clock = func() time.Time { return now }
+	defer func() { approvals.clock = clock }()
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("POST", "/resetElevator/0?reason=expiry", nil)
+	authorize(req)
+	router.ServeHTTP(w, req)
+	p := pendingChange(t, w)
+
+	now = now.Add(approvalTTL)
+	if w = decideChange(router, p.ID, "approve", testApproverToken); w.Code != http.StatusConflict {
+		t.Errorf("Expected status code 409 approving an expired change, got %d", w.Code)
+	}
+	for _, change := range approvals.list(testKeys()[0]) {
+		if change.ID == p.ID && change.Status != ApprovalExpired {
+			t.Errorf("Change should have expired, got %s", change.Status)
+		}
+	}
+
+	// decided changes drop out of the queue after a while
+	now = now.Add(approvalRetention + time.Minute)
+	for _, change := range approvals.list(testKeys()[0]) {
+		if change.ID == p.ID {
+			t.Errorf("Expired change should have been dropped from the queue")
+		}
+	}
+}
+
+func TestOutOfServiceDuringPeak(t *testing.T) {
+	router := setupRouter()
+	now := time.Date(2024, 3, 1, 13, 0, 0, 0, time.Local)
+	clock := approvals.clock
+	approvals.clock = func() time.Time { return now }
+	defer func() { approvals.clock = clock }()
+
+	// quiet time goes straight through
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("POST", "/takeElevatorOutOfService/2", nil)
+	authorize(req)
+	router.ServeHTTP(w, req)
+	if w.Code != http.StatusOK {
+		t.Errorf("Expected status code 200 off peak, got %d", w.Code)
+	}
+	w = httptest.NewRecorder()
+	req, _ = http.NewRequest("POST", "/elevatorBackInService/2", nil)
+	authorize(req)
+	router.ServeHTTP(w, req)
+
+	// rush hour needs a second person
+	now = time.Date(2024, 3, 1, 8, 30, 0, 0, time.Local)
+	w = httptest.NewRecorder()
+	req, _ = http.NewRequest("POST", "/takeElevatorOutOfService/2", nil)
+	authorize(req)
+	router.ServeHTTP(w, req)
+	p := pendingChange(t, w)
+	if p.Role != RoleTechnician {
+		t.Errorf("A technician should be able to approve, got %s", p.Role)
+	}
+	if w = decideChange(router, p.ID, "approve", testApproverToken); w.Code != http.StatusOK {
+		t.Errorf("Expected status code 200 from the approved change, got %d", w.Code)
+	}
+	if bld.GetElevator(2).InService {
+		t.Errorf("Elevator 2 should be out of service once approved")
+	}
+	bld.SetElevatorInServiceStatus(2, true)
+}
+
+func TestApprovalsBuildingScope(t *testing.T) {
+	useBuildings(t)
+	apiKeys.set(append(testKeys(),
+		APIKey{Name: "second", Token: "second-token", Role: RoleSupervisor, Buildings: []int{2}},
+		APIKey{Name: "second-approver", Token: "second-approver-token", Role: RoleSupervisor, Buildings: []int{2}}))
+	defer apiKeys.set(testKeys())
+	savedLog := auditLog
+	auditLog = audit.New(nil)
+	defer func() { auditLog = savedLog }()
+	router := setupRouter()
+	serve := func(method string, path string, token string) *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(method, path, nil)
+		req.Header.Set("Authorization", "Bearer "+token)
+		router.ServeHTTP(w, req)
+		return w
+	}
+
+	p := pendingChange(t, serve("POST", "/v1/buildings/2/elevators/0/reset", "second-token"))
+	if p.Building != 2 {
+		t.Errorf("Pending change should be for building 2, got %d", p.Building)
+	}
+	pendingChange(t, serve("POST", "/v1/buildings/1/elevators/0/reset", testToken))
+
+	// each side only sees its own building's changes
+	var list struct {
+		Approvals []PendingChange `json:"approvals"`
+	}
+	w := serve("GET", "/v1/approvals", "second-token")
+	json.Unmarshal(w.Body.Bytes(), &list)
+	if w.Code != http.StatusOK || len(list.Approvals) != 1 || list.Approvals[0].ID != p.ID {
+		t.Errorf("Building 2 supervisor should see building 2's change only, got %d %s", w.Code, w.Body.String())
+	}
+
+	// a building 1 key can't decide on building 2, and the change stays pending
+	if w := serve("POST", "/v1/approvals/"+p.ID+"/approve", testApproverToken); w.Code != http.StatusForbidden {
+		t.Errorf("Approving another building's change should be refused, got %d %s", w.Code, w.Body.String())
+	}
+	if w := serve("POST", "/v1/approvals/"+p.ID+"/reject", testApproverToken); w.Code != http.StatusForbidden {
+		t.Errorf("Rejecting another building's change should be refused, got %d", w.Code)
+	}
+	if w := serve("POST", "/v1/approvals/"+p.ID+"/approve", "second-approver-token"); w.Code != http.StatusOK {
+		t.Errorf("Building 2 approver should run the reset, got %d %s", w.Code, w.Body.String())
+	}
+
+	var entries struct {
+		Entries []audit.Entry `json:"entries"`
+	}
+	w = serve("GET", "/v1/audit-log", testToken)
+	json.Unmarshal(w.Body.Bytes(), &entries)
+	if w.Code != http.StatusOK || len(entries.Entries) != 0 {
+		t.Errorf("Building 1 supervisor should not see building 2's reset, got %d %s", w.Code, w.Body.String())
+	}
+	w = serve("GET", "/v1/audit-log", "second-token")
+	json.Unmarshal(w.Body.Bytes(), &entries)
+	if w.Code != http.StatusOK || len(entries.Entries) != 1 || entries.Entries[0].BuildingID != 2 {
+		t.Errorf("Building 2 supervisor should see building 2's reset, got %d %s", w.Code, w.Body.String())
+	}
+}
@@ -0,0 +1,150 @@
+package main
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	log "github.com/sirupsen/logrus"
+	"github.com/tcotav/elevatormgr/audit"
+	"github.com/tcotav/elevatormgr/building"
+	"github.com/tcotav/elevatormgr/config"
+)
+
+// auditLog records overrides, resets and service changes, main points it at
+// the AUDIT_LOG file when that's set
+var auditLog = audit.New(nil)
+
+// auditLogPath is the AUDIT_LOG file, empty when the log is only in memory
+var auditLogPath string
+
+// auditState is the state an audit entry records before and after an action,
+// the whole building when elevatorID is -1
+func auditState(b *building.Building, elevatorID int) []byte {
+	var state []byte
+	var err error
+	if elevatorID == -1 {
+		state, err = b.GetAllElevatorState()
+	} else {
+		state, err = b.GetElevatorState(elevatorID)
+	}
+	if err != nil {
+		return nil
+	}
+	return state
+}
+
+// recordAudit adds an action taken through c to the audit log.  err is for
+// actions that went through but didn't fully succeed.
+func recordAudit(c *gin.Context, action string, elevatorID int, before []byte, reason string, err error) {
+	actor := c.GetString(principalKey)
+	if actor == "" {
+		actor = "anonymous"
+	}
+	b := requestBuilding(c)
+	entry := audit.Entry{
+		Action:     action,
+		Actor:      actor,
+		SourceIP:   c.ClientIP(),
+		BuildingID: b.ID,
+		ElevatorID: elevatorID,
+		Before:     before,
+		After:      auditState(b, elevatorID),
+		Reason:     reason,
+	}
+	// two-person changes are down to whoever asked, run by whoever approved
+	if p, ok := approvedChange(c); ok {
+		entry.Actor = p.RequestedBy
+		entry.ApprovedBy = p.DecidedBy
+	}
+	if err != nil {
+		entry.Error = err.Error()
+	}
+	if _, err := auditLog.Append(entry); err != nil {
+		log.Error(fmt.Sprintf("audit - %s could not be recorded: %s", action, err.Error()))
+	}
+}
+
+// recordScheduledAudit adds a maintenance window the scheduler started or
+// ended to the audit log, on behalf of the technician who booked it
+func recordScheduledAudit(b *building.Building, action string, w building.MaintenanceWindow) {
+	after, _ := b.GetElevatorState(w.ElevatorID)
+	entry := audit.Entry{
+		Action:     action,
+		Actor:      w.Technician,
+		SourceIP:   "scheduler",
+		BuildingID: b.ID,
+		ElevatorID: w.ElevatorID,
+		After:      after,
+		Reason:     w.Reason,
+	}
+	if _, err := auditLog.Append(entry); err != nil {
+		log.Error(fmt.Sprintf("audit - %s could not be recorded: %s", action, err.Error()))
+	}
+}
+
+// query the audit log, filters are action, actor, elevator, and since/until in RFC3339
+func GetAuditLog(c *gin.Context) {
+	errloc := "auditlog"
+	// only the caller's own buildings
+	filter := audit.Filter{Action: c.Query("action"), Actor: c.Query("actor"), Buildings: append([]int{}, callerKey(c).Buildings...)}
+	if s := c.Query("elevator"); s != "" {
+		elevatorID, err := strconv.Atoi(s)
+		if err != nil {
+			handleError(c, errloc, err)
+			return
+		}
+		filter.ElevatorID = &elevatorID
+	}
+	var err error
+	if s := c.Query("since"); s != "" {
+		if filter.Since, err = time.Parse(time.RFC3339, s); err != nil {
+			handleError(c, errloc, err)
+			return
+		}
+	}
+	if s := c.Query("until"); s != "" {
+		if filter.Until, err = time.Parse(time.RFC3339, s); err != nil {
+			handleError(c, errloc, err)
+			return
+		}
+	}
+	c.JSON(http.StatusOK, gin.H{"entries": auditLog.Entries(filter)})
+}
+
+// check the audit log's hash chain, in memory and in the AUDIT_LOG file
+func VerifyAuditLog(c *gin.Context) {
+	err := auditLog.Verify()
+	// what's in memory was read when we started, the file is what gets kept
+	if err == nil && auditLogPath != "" {
+		err = audit.VerifyFile(auditLogPath)
+	}
+	if err != nil {
+		log.Error(fmt.Sprintf("auditverify - %s", err.Error()))
+		c.JSON(http.StatusConflict, gin.H{"verified": false, "error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"verified": true})
+}
+
+// recordReloadAudit adds a config reload from a SIGHUP to the audit log,
+// there's nobody to put it down to but whoever can signal the process
+func recordReloadAudit(before []byte, changes []config.Change) {
+	entry := audit.Entry{
+		Action:     "reloadConfig",
+		Actor:      "SIGHUP",
+		SourceIP:   "signal",
+		BuildingID: bld.ID,
+		ElevatorID: -1,
+		Before:     before,
+		After:      auditState(bld, -1),
+	}
+	if err := refusedChanges(changes); err != nil {
+		entry.Error = err.Error()
+	}
+	if _, err := auditLog.Append(entry); err != nil {
+		log.Error(fmt.Sprintf("audit - reloadConfig could not be recorded: %s", err.Error()))
+	}
+}
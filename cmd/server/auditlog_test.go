@@ -0,0 +1,124 @@
+package main
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/tcotav/elevatormgr/audit"
+)
+
+func TestAuditLog(t *testing.T) {
+	router := setupRouter()
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("POST", "/takeElevatorOutOfService/2?reason=noisy+cable", nil)
+	req.RemoteAddr = "10.0.0.5:40000"
+	authorize(req)
+	router.ServeHTTP(w, req)
+	if w.Code != http.StatusOK {
+		t.Errorf("Expected status code 200, got %d", w.Code)
+	}
+	w = httptest.NewRecorder()
+	req, _ = http.NewRequest("POST", "/elevatorBackInService/2", nil)
+	authorize(req)
+	router.ServeHTTP(w, req)
+
+	w = httptest.NewRecorder()
+	req, _ = http.NewRequest("GET", "/auditLog?action=takeElevatorOutOfService&elevator=2", nil)
+	authorize(req)
+	router.ServeHTTP(w, req)
+	if w.Code != http.StatusOK {
+		t.Errorf("Expected status code 200, got %d", w.Code)
+	}
+	var body struct {
+		Entries []audit.Entry `json:"entries"`
+	}
+	if err := json.Unmarshal(w.Body.Bytes(), &body); err != nil {
+		t.Errorf("Expected no error on json unmarshal of audit log, got %s", err.Error())
+	}
+	if len(body.Entries) == 0 {
+		t.Fatalf("Expected the out of service change in the audit log")
+	}
+	entry := body.Entries[len(body.Entries)-1]
+	if entry.Actor != "test" || entry.Reason != "noisy cable" || entry.BuildingID != 1 || entry.SourceIP != "10.0.0.5" {
+		t.Errorf("Audit entry should have actor, reason, building and source, got %v", entry)
+	}
+	var before, after map[string]interface{}
+	json.Unmarshal(entry.Before, &before)
+	json.Unmarshal(entry.After, &after)
+	if before["InService"] != true || after["InService"] != false {
+		t.Errorf("Audit entry should show the car going out of service, got %v and %v", before["InService"], after["InService"])
+	}
+
+	w = httptest.NewRecorder()
+	req, _ = http.NewRequest("GET", "/auditLog/verify", nil)
+	authorize(req)
+	router.ServeHTTP(w, req)
+	if w.Code != http.StatusOK {
+		t.Errorf("Expected status code 200, got %d", w.Code)
+	}
+
+	w = httptest.NewRecorder()
+	req, _ = http.NewRequest("GET", "/auditLog?since=yesterday", nil)
+	authorize(req)
+	router.ServeHTTP(w, req)
+	if w.Code != http.StatusBadRequest {
+		t.Errorf("Expected status code 400, got %d", w.Code)
+	}
+
+	// failed actions don't make it into the log
+	n := len(auditLog.Entries(audit.Filter{}))
+	w = httptest.NewRecorder()
+	req, _ = http.NewRequest("POST", "/resetElevator/10", nil)
+	authorize(req)
+	router.ServeHTTP(w, req)
+	if len(auditLog.Entries(audit.Filter{})) != n {
+		t.Errorf("A failed reset should not be audited")
+	}
+}
+
+func TestVerifyAuditLogFile(t *testing.T) {
+	router := setupRouter()
+	path := filepath.Join(t.TempDir(), "audit.log")
+	l, err := audit.Open(path)
+	if err != nil {
+		t.Fatalf("Audit log should open, got %s", err.Error())
+	}
+	savedLog, savedPath := auditLog, auditLogPath
+	auditLog, auditLogPath = l, path
+	defer func() { auditLog, auditLogPath = savedLog, savedPath }()
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("POST", "/takeElevatorOutOfService/2?reason=noisy+cable", nil)
+	authorize(req)
+	router.ServeHTTP(w, req)
+
+	w = httptest.NewRecorder()
+	req, _ = http.NewRequest("GET", "/auditLog/verify", nil)
+	authorize(req)
+	router.ServeHTTP(w, req)
+	if w.Code != http.StatusOK {
+		t.Errorf("Expected status code 200, got %d %s", w.Code, w.Body.String())
+	}
+
+	// somebody edits the file behind our back, memory still looks fine
+	data, _ := os.ReadFile(path)
+	os.WriteFile(path, []byte(strings.Replace(string(data), "noisy cable", "routine", 1)), 0600)
+	w = httptest.NewRecorder()
+	req, _ = http.NewRequest("GET", "/auditLog/verify", nil)
+	authorize(req)
+	router.ServeHTTP(w, req)
+	if w.Code != http.StatusConflict {
+		t.Errorf("Expected status code 409 for a tampered file, got %d %s", w.Code, w.Body.String())
+	}
+
+	w = httptest.NewRecorder()
+	req, _ = http.NewRequest("POST", "/elevatorBackInService/2", nil)
+	authorize(req)
+	router.ServeHTTP(w, req)
+}
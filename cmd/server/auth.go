@@ -0,0 +1,205 @@
+package main
+
+import (
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+
+	"github.com/gin-gonic/gin"
+	log "github.com/sirupsen/logrus"
+)
+
+// Role is what a caller is allowed to do, each role can do everything the
+// roles below it can
+type Role string
+
+const (
+	RolePassengerPanel Role = "passenger-panel"
+	RoleTechnician     Role = "technician"
+	RoleSupervisor     Role = "supervisor"
+)
+
+var roleRank = map[Role]int{
+	RolePassengerPanel: 1,
+	RoleTechnician:     2,
+	RoleSupervisor:     3,
+}
+
+// APIKey is one credential from the auth config.  The token is sent either as
+// "Authorization: Bearer <token>" or "X-API-Key: <token>".  A key only works
+// for the buildings listed against it.
+type APIKey struct {
+	Name      string `json:"name"`
+	Token     string `json:"token"`
+	Role      Role   `json:"role"`
+	Buildings []int  `json:"buildings"`
+}
+
+func (k APIKey) servesBuilding(buildingID int) bool {
+	for _, id := range k.Buildings {
+		if id == buildingID {
+			return true
+		}
+	}
+	return false
+}
+
+// authConfig is the layout of the file AUTH_CONFIG points at
+type authConfig struct {
+	Keys []APIKey `json:"keys"`
+}
+
+// keyring holds the keys the server accepts
+type keyring struct {
+	mu   sync.RWMutex
+	keys []APIKey
+}
+
+var apiKeys = &keyring{}
+
+func (k *keyring) set(keys []APIKey) {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+	k.keys = keys
+}
+
+// lookup finds the key for a token, comparing in constant time
+func (k *keyring) lookup(token string) *APIKey {
+	k.mu.RLock()
+	defer k.mu.RUnlock()
+	for i := range k.keys {
+		if subtle.ConstantTimeCompare([]byte(k.keys[i].Token), []byte(token)) == 1 {
+			key := k.keys[i]
+			return &key
+		}
+	}
+	return nil
+}
+
+// loadAuthConfig reads and checks the api keys in the file at path
+func loadAuthConfig(path string) ([]APIKey, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var cfg authConfig
+	if err := json.Unmarshal(data, &cfg); err != nil {
+		return nil, fmt.Errorf("auth config %s: %w", path, err)
+	}
+	return cfg.Keys, validateKeys(cfg.Keys)
+}
+
+func validateKeys(keys []APIKey) error {
+	seen := make(map[string]bool)
+	for _, k := range keys {
+		if k.Name == "" || k.Token == "" {
+			return fmt.Errorf("api key needs a name and a token")
+		}
+		if _, ok := roleRank[k.Role]; !ok {
+			return fmt.Errorf("api key %s has unknown role: %s", k.Name, k.Role)
+		}
+		if seen[k.Token] {
+			return fmt.Errorf("api key %s reuses another key's token", k.Name)
+		}
+		seen[k.Token] = true
+	}
+	return nil
+}
+
+// credential pulls the token out of the request headers
+func credential(c *gin.Context) string {
+	if auth := c.GetHeader("Authorization"); strings.HasPrefix(auth, "Bearer ") {
+		return strings.TrimSpace(strings.TrimPrefix(auth, "Bearer "))
+	}
+	return c.GetHeader("X-API-Key")
+}
+
+// where requireRole leaves the caller's key and its name and role
+const (
+	principalKey = "principal"
+	roleKey      = "role"
+	apiKeyKey    = "apiKey"
+)
+
+// requireRole only lets through callers with at least role for this building
+func requireRole(role Role) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		key := checkKey(c, role)
+		if key == nil {
+			return
+		}
+		if b := requestBuilding(c); !key.servesBuilding(b.ID) {
+			denyRequest(c, http.StatusForbidden, key.Name, fmt.Sprintf("not authorized for building: %d", b.ID))
+			return
+		}
+		setCaller(c, key)
+		c.Next()
+	}
+}
+
+// requireServerRole is requireRole for the routes that aren't about one
+// building.  The handlers only show and touch the caller's own buildings.
+func requireServerRole(role Role) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		key := checkKey(c, role)
+		if key == nil {
+			return
+		}
+		setCaller(c, key)
+		c.Next()
+	}
+}
+
+// checkKey finds the caller's key and checks its role, it turns the request
+// away and returns nil when it won't do
+func checkKey(c *gin.Context, role Role) *APIKey {
+	token := credential(c)
+	if token == "" {
+		denyRequest(c, http.StatusUnauthorized, "", "missing credentials")
+		return nil
+	}
+	key := apiKeys.lookup(token)
+	if key == nil {
+		denyRequest(c, http.StatusUnauthorized, "", "invalid credentials")
+		return nil
+	}
+	if roleRank[key.Role] < roleRank[role] {
+		denyRequest(c, http.StatusForbidden, key.Name, fmt.Sprintf("role %s required", role))
+		return nil
+	}
+	return key
+}
+
+func setCaller(c *gin.Context, key *APIKey) {
+	c.Set(principalKey, key.Name)
+	c.Set(roleKey, string(key.Role))
+	c.Set(apiKeyKey, *key)
+}
+
+// callerKey is the key requireRole let through
+func callerKey(c *gin.Context) APIKey {
+	key, _ := c.Get(apiKeyKey)
+	k, _ := key.(APIKey)
+	return k
+}
+
+func denyRequest(c *gin.Context, code int, principal string, reason string) {
+	log.WithFields(log.Fields{
+		"status_code": code,
+		"method":      c.Request.Method,
+		"path":        c.Request.URL.Path,
+		"remote_addr": c.ClientIP(),
+		"principal":   principal,
+	}).Warn(fmt.Sprintf("access denied - %s", reason))
+	errCode := "forbidden"
+	if code == http.StatusUnauthorized {
+		c.Header("WWW-Authenticate", `Bearer realm="elevatormgr"`)
+		errCode = "unauthorized"
+	}
+	respondError(c, code, errCode, errors.New(reason))
+}
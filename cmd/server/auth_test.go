@@ -0,0 +1,95 @@
+package main
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestMaintenanceRouteAuth(t *testing.T) {
+	router := setupRouter()
+	apiKeys.set([]APIKey{
+		{Name: "panel", Token: "panel-token", Role: RolePassengerPanel, Buildings: []int{1}},
+		{Name: "tech", Token: "tech-token", Role: RoleTechnician, Buildings: []int{1}},
+		{Name: "elsewhere", Token: "other-token", Role: RoleSupervisor, Buildings: []int{2}},
+		{Name: "test", Token: testToken, Role: RoleSupervisor, Buildings: []int{1}},
+	})
+	defer apiKeys.set(testKeys())
+
+	tests := []struct {
+		path   string
+		header string
+		value  string
+		code   int
+	}{
+		// nobody home
+		{"/maintenanceWindows", "", "", http.StatusUnauthorized},
+		{"/maintenanceWindows", "Authorization", "Bearer wrong", http.StatusUnauthorized},
+		// passenger panels don't get maintenance
+		{"/maintenanceWindows", "Authorization", "Bearer panel-token", http.StatusForbidden},
+		// right role, wrong building
+		{"/maintenanceWindows", "X-API-Key", "other-token", http.StatusForbidden},
+		{"/maintenanceWindows", "X-API-Key", "tech-token", http.StatusOK},
+		{"/maintenanceWindows", "Authorization", "Bearer tech-token", http.StatusOK},
+		// resets are for supervisors, and then need approving
+		{"/resetElevator/0", "Authorization", "Bearer tech-token", http.StatusForbidden},
+		{"/resetElevator/0", "Authorization", "Bearer " + testToken, http.StatusAccepted},
+	}
+	for _, tt := range tests {
+		method := "GET"
+		if tt.path == "/resetElevator/0" {
+			method = "POST"
+		}
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(method, tt.path, nil)
+		if tt.header != "" {
+			req.Header.Set(tt.header, tt.value)
+		}
+		router.ServeHTTP(w, req)
+		if w.Code != tt.code {
+			t.Errorf("Expected status code %d for %s with %s, got %d", tt.code, tt.path, tt.value, w.Code)
+		}
+		if w.Code == http.StatusUnauthorized && w.Header().Get("WWW-Authenticate") == "" {
+			t.Errorf("Expected a WWW-Authenticate header on 401")
+		}
+	}
+
+	// user routes stay open
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/getAllElevatorState", nil)
+	router.ServeHTTP(w, req)
+	if w.Code != http.StatusOK {
+		t.Errorf("Expected status code 200, got %d", w.Code)
+	}
+}
+
+func TestLoadAuthConfig(t *testing.T) {
+	dir := t.TempDir()
+	good := filepath.Join(dir, "good.json")
+	os.WriteFile(good, []byte(`{"keys": [{"name": "alice", "token": "abc", "role": "technician", "buildings": [1]}]}`), 0600)
+	keys, err := loadAuthConfig(good)
+	if err != nil {
+		t.Errorf("Expected no error loading auth config, got %s", err.Error())
+	}
+	if len(keys) != 1 || keys[0].Role != RoleTechnician {
+		t.Errorf("Expected 1 technician key, got %v", keys)
+	}
+
+	bad := filepath.Join(dir, "bad.json")
+	os.WriteFile(bad, []byte(`{"keys": [{"name": "bob", "token": "abc", "role": "janitor"}]}`), 0600)
+	if _, err := loadAuthConfig(bad); err == nil {
+		t.Errorf("Expected an error for an unknown role")
+	}
+
+	dup := filepath.Join(dir, "dup.json")
+	os.WriteFile(dup, []byte(`{"keys": [{"name": "a", "token": "x", "role": "technician"}, {"name": "b", "token": "x", "role": "supervisor"}]}`), 0600)
+	if _, err := loadAuthConfig(dup); err == nil {
+		t.Errorf("Expected an error for a reused token")
+	}
+
+	if _, err := loadAuthConfig(filepath.Join(dir, "missing.json")); err == nil {
+		t.Errorf("Expected an error for a missing file")
+	}
+}
@@ -0,0 +1,201 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"os/signal"
+	"sort"
+	"strconv"
+	"sync"
+	"syscall"
+
+	"github.com/gin-gonic/gin"
+	log "github.com/sirupsen/logrus"
+	"github.com/tcotav/elevatormgr/building"
+	"github.com/tcotav/elevatormgr/config"
+)
+
+// the buildings this server runs.  The v1 routes name theirs, the old ones
+// work on bld -- the first building in the config file
+var (
+	buildingsMu sync.RWMutex
+	buildings   = map[int]*building.Building{bld.ID: bld}
+	// the file they came from, main sets it from CONFIG
+	configPath string
+)
+
+// buildingKey is where withBuilding leaves the building a request is for
+const buildingKey = "building"
+
+// lookupBuilding finds a running building by ID, nil if there isn't one
+func lookupBuilding(buildingID int) *building.Building {
+	buildingsMu.RLock()
+	defer buildingsMu.RUnlock()
+	return buildings[buildingID]
+}
+
+// withBuilding picks the building out of the path for the v1 routes, it goes
+// before requireRole so keys are checked against the right building
+func withBuilding() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		buildingID, err := strconv.Atoi(c.Param("building"))
+		if err != nil {
+			handleError(c, "building", err)
+			c.Abort()
+			return
+		}
+		b := lookupBuilding(buildingID)
+		if b == nil {
+			body := newErrorBody(c, "building_not_found", fmt.Errorf("building: %d does not exist", buildingID))
+			body.Building = &buildingID
+			c.AbortWithStatusJSON(http.StatusNotFound, body)
+			return
+		}
+		c.Set(buildingKey, b)
+		c.Next()
+	}
+}
+
+// requestBuilding is the building a request is for, the old routes only know bld
+func requestBuilding(c *gin.Context) *building.Building {
+	if b, ok := c.Get(buildingKey); ok {
+		return b.(*building.Building)
+	}
+	return bld
+}
+
+// allBuildings returns every building the server runs, in ID order
+func allBuildings() []*building.Building {
+	buildingsMu.RLock()
+	defer buildingsMu.RUnlock()
+	all := make([]*building.Building, 0, len(buildings))
+	for _, b := range buildings {
+		all = append(all, b)
+	}
+	sort.Slice(all, func(i, j int) bool { return all[i].ID < all[j].ID })
+	return all
+}
+
+// loadBuildings replaces the buildings with the ones in cfg, only for startup
+func loadBuildings(cfg *config.Config) error {
+	loaded := make(map[int]*building.Building)
+	for _, bc := range cfg.Buildings {
+		b, err := bc.NewBuilding()
+		if err != nil {
+			return err
+		}
+		loaded[b.ID] = b
+	}
+	first, ok := loaded[cfg.Buildings[0].ID]
+	if !ok {
+		return fmt.Errorf("config has no buildings")
+	}
+	buildingsMu.Lock()
+	defer buildingsMu.Unlock()
+	buildings = loaded
+	bld = first
+	return nil
+}
+
+// startBuilding hands a building its keys and starts logging its events
+func startBuilding(b *building.Building) {
+	b.SetFireServiceKey(os.Getenv("FIRE_SERVICE_KEY"))
+	b.SetPriorityCallKey(os.Getenv("PRIORITY_CALL_KEY"))
+	go logEvents(b)
+}
+
+// loadConfig reads the config file the server was started with
+func loadConfig() (*config.Config, error) {
+	if configPath == "" {
+		return nil, fmt.Errorf("server was started without a CONFIG file")
+	}
+	return config.Load(configPath)
+}
+
+// reloadBuildings brings the running buildings in line with cfg as far as
+// it safely can -- see config.Reload.  The listen addresses only change on a
+// restart.
+func reloadBuildings(cfg *config.Config) []config.Change {
+	buildingsMu.Lock()
+	next, changes := config.Reload(cfg, buildings)
+	added := make([]*building.Building, 0)
+	for id, b := range next {
+		if _, ok := buildings[id]; !ok {
+			added = append(added, b)
+		}
+	}
+	buildings = next
+	buildingsMu.Unlock()
+
+	for _, b := range added {
+		startBuilding(b)
+	}
+	for _, change := range changes {
+		entry := log.WithFields(log.Fields{"building": change.BuildingID, "setting": change.Setting})
+		if change.Refused {
+			entry.Warn(fmt.Sprintf("config reload - %s", change.String()))
+		} else {
+			entry.Info(fmt.Sprintf("config reload - %s", change.String()))
+		}
+	}
+	return changes
+}
+
+// refusedChanges is the changes a reload couldn't make as one error, nil
+// when it made them all
+func refusedChanges(changes []config.Change) error {
+	var refused []error
+	for _, change := range changes {
+		if change.Refused {
+			refused = append(refused, errors.New(change.String()))
+		}
+	}
+	return errors.Join(refused...)
+}
+
+// watchReload reloads the config file whenever the process gets a SIGHUP
+func watchReload() {
+	hup := make(chan os.Signal, 1)
+	signal.Notify(hup, syscall.SIGHUP)
+	for range hup {
+		cfg, err := loadConfig()
+		if err != nil {
+			log.Error(fmt.Sprintf("config reload - %s", err.Error()))
+			continue
+		}
+		before := auditState(bld, -1)
+		recordReloadAudit(before, reloadBuildings(cfg))
+	}
+}
+
+// re-read the config file and make the changes that are safe live, with
+// dryRun just say what they'd be
+func ReloadConfig(c *gin.Context) {
+	errloc := "reloadconfig"
+	// a reload can change any building, so it takes a key good for all of them
+	key := callerKey(c)
+	for _, b := range allBuildings() {
+		if !key.servesBuilding(b.ID) {
+			denyRequest(c, http.StatusForbidden, key.Name, fmt.Sprintf("not authorized for building: %d", b.ID))
+			return
+		}
+	}
+	cfg, err := loadConfig()
+	if err != nil {
+		handleError(c, errloc, err)
+		return
+	}
+	if dryRun(c) {
+		buildingsMu.RLock()
+		changes, previews := config.PreviewReload(cfg, buildings)
+		buildingsMu.RUnlock()
+		c.JSON(http.StatusOK, gin.H{"dryRun": true, "changes": changes, "previews": previews})
+		return
+	}
+	before := auditState(bld, -1)
+	changes := reloadBuildings(cfg)
+	recordAudit(c, "reloadConfig", -1, before, c.Query("reason"), refusedChanges(changes))
+	c.JSON(http.StatusOK, gin.H{"changes": changes})
+}
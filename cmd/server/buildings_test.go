@@ -0,0 +1,89 @@
+package main
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/tcotav/elevatormgr/audit"
+	"github.com/tcotav/elevatormgr/building"
+)
+
+func TestReloadConfig(t *testing.T) {
+	router := setupRouter()
+	defer func() {
+		configPath = ""
+		buildingsMu.Lock()
+		buildings = map[int]*building.Building{bld.ID: bld}
+		buildingsMu.Unlock()
+		bld.SetDispatchStrategy(building.DispatchClosest)
+	}()
+
+	configPath = filepath.Join(t.TempDir(), "buildings.yaml")
+	os.WriteFile(configPath, []byte(`
+buildings:
+  - id: 1
+    floors: [{number: 1}, {number: 2}, {number: 3}, {number: 4}, {number: 5},
+             {number: 6}, {number: 7}, {number: 8}, {number: 9}, {number: 10}]
+    elevators: [{id: 0}, {id: 1}, {id: 2}]
+    dispatch: shortest-wait
+  - id: 2
+    floors: [{number: 1}, {number: 2}]
+    elevators: [{id: 0}]
+`), 0600)
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("POST", "/reloadConfig", nil)
+	router.ServeHTTP(w, req)
+	if w.Code != http.StatusUnauthorized {
+		t.Errorf("Expected status code 401, got %d", w.Code)
+	}
+
+	w = httptest.NewRecorder()
+	req, _ = http.NewRequest("POST", "/reloadConfig?dryRun=true", nil)
+	authorize(req)
+	router.ServeHTTP(w, req)
+	if w.Code != http.StatusOK || bld.GetDispatchStrategy() != building.DispatchClosest || len(allBuildings()) != 1 {
+		t.Errorf("Dry run should change nothing, got %d %s", w.Code, w.Body.String())
+	}
+
+	audited := len(auditLog.Entries(audit.Filter{Action: "reloadConfig"}))
+	w = httptest.NewRecorder()
+	req, _ = http.NewRequest("POST", "/reloadConfig", nil)
+	authorize(req)
+	router.ServeHTTP(w, req)
+	if w.Code != http.StatusOK {
+		t.Errorf("Expected status code 200, got %d %s", w.Code, w.Body.String())
+	}
+	if bld.GetDispatchStrategy() != building.DispatchShortestWait {
+		t.Errorf("Dispatch should be shortest-wait, got %s", bld.GetDispatchStrategy())
+	}
+	if all := allBuildings(); len(all) != 2 || all[1].ID != 2 {
+		t.Errorf("Building 2 should be running, got %d buildings", len(all))
+	}
+	if len(auditLog.Entries(audit.Filter{Action: "reloadConfig"})) != audited+1 {
+		t.Errorf("Reload should be audited")
+	}
+
+	// a reload can touch building 2 now, so it takes a key for both
+	w = httptest.NewRecorder()
+	req, _ = http.NewRequest("POST", "/v1/config/reload", nil)
+	authorize(req)
+	router.ServeHTTP(w, req)
+	if w.Code != http.StatusForbidden {
+		t.Errorf("Reload with a key for building 1 only should be refused, got %d", w.Code)
+	}
+	apiKeys.set(append(testKeys(), APIKey{Name: "site", Token: "site-token", Role: RoleSupervisor, Buildings: []int{1, 2}}))
+	defer apiKeys.set(testKeys())
+
+	os.WriteFile(configPath, []byte("buildings: [{id: 1, lifts: 3}]"), 0600)
+	w = httptest.NewRecorder()
+	req, _ = http.NewRequest("POST", "/reloadConfig", nil)
+	req.Header.Set("Authorization", "Bearer site-token")
+	router.ServeHTTP(w, req)
+	if w.Code != http.StatusBadRequest || len(allBuildings()) != 2 {
+		t.Errorf("A bad config should be refused and change nothing, got %d", w.Code)
+	}
+}
@@ -0,0 +1,100 @@
+package main
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	log "github.com/sirupsen/logrus"
+	"github.com/tcotav/elevatormgr/building"
+)
+
+// what a new car is like, every field is optional -- the ID defaults to the
+// next one free and a car with no servedFloors stops everywhere
+type commissionRequest struct {
+	Elevator     *int    `json:"elevator"`
+	ServedFloors []int   `json:"servedFloors"`
+	Capacity     int     `json:"capacity"`
+	Speed        float64 `json:"speed"`
+}
+
+// put a new car into service
+func CommissionElevator(c *gin.Context) {
+	errloc := "commission"
+	var body commissionRequest
+	if c.Request.ContentLength != 0 {
+		if err := c.ShouldBindJSON(&body); err != nil {
+			handleError(c, errloc, err)
+			return
+		}
+	}
+	elevatorID := -1
+	if body.Elevator != nil {
+		elevatorID = *body.Elevator
+	}
+	spec := building.ElevatorSpec{ServedFloors: body.ServedFloors, Capacity: body.Capacity, Speed: body.Speed}
+	if dryRun(c) {
+		previewCommand(c, errloc, func(sim *building.Building) error {
+			_, err := sim.AddElevator(elevatorID, spec)
+			return err
+		})
+		return
+	}
+	elevatorID, err := bld.AddElevator(elevatorID, spec)
+	if err != nil {
+		handleError(c, errloc, err)
+		return
+	}
+	log.Info(fmt.Sprintf("Elevator %d was commissioned.", elevatorID))
+	recordAudit(c, "commissionElevator", elevatorID, nil, c.Query("reason"), nil)
+	c.JSON(http.StatusOK, gin.H{"elevator": elevatorID})
+}
+
+// take a drained car out of the building for good
+func DecommissionElevator(c *gin.Context) {
+	errloc := "decommission"
+	elevatorID, err := strconv.Atoi(c.Param("elevator"))
+	if err != nil {
+		handleError(c, errloc, err)
+		return
+	}
+	if dryRun(c) {
+		previewCommand(c, errloc, func(sim *building.Building) error {
+			return sim.RemoveElevator(elevatorID)
+		})
+		return
+	}
+	before := auditState(bld, elevatorID)
+	if err := bld.RemoveElevator(elevatorID); err != nil {
+		handleError(c, errloc, err)
+		return
+	}
+	log.Info(fmt.Sprintf("Elevator %d was decommissioned.", elevatorID))
+	recordAudit(c, "decommissionElevator", elevatorID, before, c.Query("reason"), nil)
+	c.JSON(http.StatusOK, gin.H{"elevator": elevatorID})
+}
+
+// add floors to the top of the building, floors is the new total
+func ExtendFloors(c *gin.Context) {
+	errloc := "extendfloors"
+	floors, err := strconv.Atoi(c.Param("floors"))
+	if err != nil {
+		handleError(c, errloc, err)
+		return
+	}
+	if dryRun(c) {
+		previewCommand(c, errloc, func(sim *building.Building) error {
+			return sim.ExtendFloors(floors)
+		})
+		return
+	}
+	before := auditState(bld, -1)
+	if err := bld.ExtendFloors(floors); err != nil {
+		handleError(c, errloc, err)
+		return
+	}
+	log.Info(fmt.Sprintf("Building %d was extended to %d floors.", bld.ID, floors))
+	recordAudit(c, "extendFloors", -1, before, c.Query("reason"), nil)
+	c.JSON(http.StatusOK, bld.GetFloors())
+}
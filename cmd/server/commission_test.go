@@ -0,0 +1,104 @@
+package main
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/tcotav/elevatormgr/building"
+)
+
+func TestCommissionElevator(t *testing.T) {
+	// the other tests count on the usual 10 floors and 3 cars
+	saved := bld
+	bld = building.NewBuilding(1, 10, 3)
+	defer func() { bld = saved }()
+	router := setupRouter()
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("POST", "/commissionElevator", strings.NewReader(`{"servedFloors": [1, 10], "capacity": 20}`))
+	authorize(req)
+	router.ServeHTTP(w, req)
+	var body struct {
+		Elevator int `json:"elevator"`
+	}
+	json.Unmarshal(w.Body.Bytes(), &body)
+	if w.Code != http.StatusOK || body.Elevator != 3 {
+		t.Errorf("Expected elevator 3 to be commissioned, got %d %s", w.Code, w.Body.String())
+	}
+	if e := bld.GetElevator(3); e == nil || e.Serves(5) {
+		t.Errorf("Elevator 3 should skip floor 5")
+	}
+
+	// with no body the car gets the next ID and stops everywhere
+	w = httptest.NewRecorder()
+	req, _ = http.NewRequest("POST", "/commissionElevator?dryRun=true", nil)
+	authorize(req)
+	router.ServeHTTP(w, req)
+	if w.Code != http.StatusOK || len(bld.ElevatorList) != 4 {
+		t.Errorf("Dry run should not add a car, got %d %s", w.Code, w.Body.String())
+	}
+
+	w = httptest.NewRecorder()
+	req, _ = http.NewRequest("POST", "/decommissionElevator/1", nil)
+	authorize(req)
+	router.ServeHTTP(w, req)
+	if w.Code != http.StatusConflict {
+		t.Errorf("Elevator 1 is in service, expected status code 409, got %d", w.Code)
+	}
+	bld.SetElevatorInServiceStatus(1, false)
+	w = httptest.NewRecorder()
+	req, _ = http.NewRequest("POST", "/decommissionElevator/1", nil)
+	authorize(req)
+	router.ServeHTTP(w, req)
+	if w.Code != http.StatusOK || bld.GetElevator(1) != nil {
+		t.Errorf("Drained elevator 1 should be decommissioned, got %d %s", w.Code, w.Body.String())
+	}
+
+	// the cars either side of the gap still answer to their IDs
+	w = httptest.NewRecorder()
+	req, _ = http.NewRequest("POST", "/pushDestination/3/10", nil)
+	router.ServeHTTP(w, req)
+	if w.Code != http.StatusOK {
+		t.Errorf("Elevator 3 should take a call to 10, got %d %s", w.Code, w.Body.String())
+	}
+	w = httptest.NewRecorder()
+	req, _ = http.NewRequest("POST", "/pushDestination/1/5", nil)
+	router.ServeHTTP(w, req)
+	if w.Code != http.StatusNotFound {
+		t.Errorf("Elevator 1 is gone, expected status code 404, got %d", w.Code)
+	}
+}
+
+func TestExtendFloors(t *testing.T) {
+	saved := bld
+	bld = building.NewBuilding(1, 10, 3)
+	defer func() { bld = saved }()
+	router := setupRouter()
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("POST", "/extendFloors/8", nil)
+	authorize(req)
+	router.ServeHTTP(w, req)
+	if w.Code != http.StatusConflict {
+		t.Errorf("Expected status code 409, got %d", w.Code)
+	}
+
+	w = httptest.NewRecorder()
+	req, _ = http.NewRequest("POST", "/extendFloors/12", nil)
+	authorize(req)
+	router.ServeHTTP(w, req)
+	var floors []building.Floor
+	json.Unmarshal(w.Body.Bytes(), &floors)
+	if w.Code != http.StatusOK || len(floors) != 12 {
+		t.Errorf("Building should have 12 floors, got %d %s", w.Code, w.Body.String())
+	}
+	w = httptest.NewRecorder()
+	req, _ = http.NewRequest("POST", "/callElevator/12/-1", nil)
+	router.ServeHTTP(w, req)
+	if w.Code != http.StatusOK {
+		t.Errorf("Floor 12 should take calls, got %d %s", w.Code, w.Body.String())
+	}
+}
@@ -0,0 +1,42 @@
+package main
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/tcotav/elevatormgr/building"
+)
+
+// dryRun says whether the caller only wants to see what a command would do,
+// ?dryRun=true on any maintenance route
+func dryRun(c *gin.Context) bool {
+	on, _ := strconv.ParseBool(c.Query("dryRun"))
+	return on
+}
+
+// checkDryRun turns away a dryRun we can't read, better than guessing and
+// running the command for real
+func checkDryRun() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if s, ok := c.GetQuery("dryRun"); ok {
+			if _, err := strconv.ParseBool(s); err != nil {
+				handleError(c, "dryrun", fmt.Errorf("invalid dryRun value: %s", s))
+				c.Abort()
+				return
+			}
+		}
+		c.Next()
+	}
+}
+
+// previewCommand answers a dry run with what op would do to the building
+func previewCommand(c *gin.Context, source string, op func(sim *building.Building) error) {
+	p, err := requestBuilding(c).Preview(op)
+	if err != nil {
+		handleError(c, source, err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"dryRun": true, "preview": p})
+}
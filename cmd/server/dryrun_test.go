@@ -0,0 +1,154 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/tcotav/elevatormgr/audit"
+	"github.com/tcotav/elevatormgr/building"
+	"github.com/tcotav/elevatormgr/elevator"
+)
+
+type previewResponse struct {
+	DryRun  bool             `json:"dryRun"`
+	Preview building.Preview `json:"preview"`
+}
+
+func TestDryRun(t *testing.T) {
+	router := setupRouter()
+	audited := len(auditLog.Entries(audit.Filter{}))
+	calls := bld.GetElevator(1).CallList.Len()
+
+	// resets don't need approving when they're only a preview
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("POST", "/resetElevator/1?dryRun=true", nil)
+	authorize(req)
+	router.ServeHTTP(w, req)
+	if w.Code != http.StatusOK {
+		t.Errorf("Expected status code 200, got %d", w.Code)
+	}
+	var resp previewResponse
+	if err := json.Unmarshal(w.Body.Bytes(), &resp); err != nil {
+		t.Errorf("Expected no error on json unmarshal of preview, got %s", err.Error())
+	}
+	if !resp.DryRun || len(resp.Preview.Cars) != len(bld.ElevatorList) || len(resp.Preview.Capacity) == 0 {
+		t.Errorf("Expected a preview of every car, got %v", resp)
+	}
+
+	w = httptest.NewRecorder()
+	req, _ = http.NewRequest("POST", "/maintenanceCallOverride/1/7/1?dryRun=1", nil)
+	authorize(req)
+	router.ServeHTTP(w, req)
+	json.Unmarshal(w.Body.Bytes(), &resp)
+	if w.Code != http.StatusOK || resp.Preview.Cars[1].Calls[0].Floor != 7 {
+		t.Errorf("Override preview should put floor 7 first, got %d %v", w.Code, resp.Preview.Cars[1])
+	}
+	if bld.GetElevator(1).CallList.Len() != calls {
+		t.Errorf("Dry run should leave elevator 1 with %d calls, got %d", calls, bld.GetElevator(1).CallList.Len())
+	}
+
+	// the command's own errors come back as they would for real
+	w = httptest.NewRecorder()
+	req, _ = http.NewRequest("POST", "/drainElevator/10?dryRun=true", nil)
+	authorize(req)
+	router.ServeHTTP(w, req)
+	if w.Code != http.StatusNotFound {
+		t.Errorf("Expected status code 404, got %d", w.Code)
+	}
+
+	// a dryRun we can't read doesn't fall through to the real thing
+	w = httptest.NewRecorder()
+	req, _ = http.NewRequest("POST", "/takeElevatorOutOfService/1?dryRun=ture", nil)
+	authorize(req)
+	router.ServeHTTP(w, req)
+	if w.Code != http.StatusBadRequest || !bld.GetElevator(1).InService {
+		t.Errorf("Expected status code 400 and elevator 1 in service, got %d", w.Code)
+	}
+
+	// bookings come back with the window they'd make
+	windows := len(bld.GetMaintenanceWindows(time.Now()))
+	start := time.Now().Add(48 * time.Hour).UTC().Format(time.RFC3339)
+	end := time.Now().Add(50 * time.Hour).UTC().Format(time.RFC3339)
+	body := fmt.Sprintf(`{"elevator": 0, "start": "%s", "end": "%s", "reason": "cable swap", "technician": "pat"}`, start, end)
+	w = httptest.NewRecorder()
+	req, _ = http.NewRequest("POST", "/maintenanceWindows?dryRun=true", strings.NewReader(body))
+	authorize(req)
+	router.ServeHTTP(w, req)
+	var booking struct {
+		Window building.MaintenanceWindow `json:"window"`
+	}
+	json.Unmarshal(w.Body.Bytes(), &booking)
+	if w.Code != http.StatusOK || booking.Window.ID == 0 || booking.Window.Reason != "cable swap" {
+		t.Errorf("Expected the window that would be booked, got %d %v", w.Code, booking.Window)
+	}
+	if len(bld.GetMaintenanceWindows(time.Now())) != windows {
+		t.Errorf("Dry run should not book a window")
+	}
+
+	if len(auditLog.Entries(audit.Filter{})) != audited {
+		t.Errorf("Dry runs should not be audited")
+	}
+}
+
+func TestDryRunFireServiceAndEmergencyPower(t *testing.T) {
+	first, _ := useBuildings(t)
+	router := setupRouter()
+	audited := len(auditLog.Entries(audit.Filter{}))
+
+	var resp previewResponse
+	w := serveV1(router, "POST", "/v1/buildings/1/fire-recall?dryRun=true", "")
+	json.Unmarshal(w.Body.Bytes(), &resp)
+	if w.Code != http.StatusOK || !resp.DryRun || resp.Preview.Cars[0].Mode != elevator.ModeFireRecall {
+		t.Errorf("Fire recall preview should recall the cars, got %d %s", w.Code, w.Body.String())
+	}
+	if first.FireRecall {
+		t.Errorf("Dry run should not recall the cars")
+	}
+	w = serveV1(router, "PUT", "/v1/buildings/1/floors/1/smoke-detector?dryRun=true", `{"active": true}`)
+	if w.Code != http.StatusOK || first.FireRecall {
+		t.Errorf("Smoke detector preview should leave the building alone, got %d %s", w.Code, w.Body.String())
+	}
+	w = serveV1(router, "POST", "/v1/buildings/1/emergency-power?dryRun=true", `{"runningCars": 1}`)
+	json.Unmarshal(w.Body.Bytes(), &resp)
+	if w.Code != http.StatusOK || resp.Preview.Cars[1].Mode != elevator.ModeEmergencyParked {
+		t.Errorf("Emergency power preview should park the cars it can't run, got %d %s", w.Code, w.Body.String())
+	}
+	if first.EmergencyPower {
+		t.Errorf("Dry run should not put the building on emergency power")
+	}
+
+	// the command's own errors come back as they would for real
+	w = serveV1(router, "DELETE", "/v1/buildings/1/emergency-power?dryRun=true", "")
+	if w.Code != http.StatusConflict {
+		t.Errorf("Ending emergency power that isn't on should be 409, got %d", w.Code)
+	}
+	w = serveV1(router, "PATCH", "/v1/buildings/1/emergency-power?dryRun=ture", `{"elevators": [0]}`)
+	if w.Code != http.StatusBadRequest {
+		t.Errorf("Expected status code 400 for a dryRun we can't read, got %d", w.Code)
+	}
+
+	first.ActivateFireRecall()
+	w = httptest.NewRecorder()
+	req, _ := http.NewRequest("POST", "/resetFireRecall?dryRun=true", nil)
+	authorize(req)
+	router.ServeHTTP(w, req)
+	if w.Code != http.StatusOK || !first.FireRecall {
+		t.Errorf("Reset preview should leave the recall on, got %d %s", w.Code, w.Body.String())
+	}
+	w = httptest.NewRecorder()
+	req, _ = http.NewRequest("POST", "/emergencyPower/1?dryRun=true", nil)
+	authorize(req)
+	router.ServeHTTP(w, req)
+	if w.Code != http.StatusOK || first.EmergencyPower {
+		t.Errorf("Emergency power preview should leave the building alone, got %d %s", w.Code, w.Body.String())
+	}
+
+	if len(auditLog.Entries(audit.Filter{})) != audited {
+		t.Errorf("Dry runs should not be audited")
+	}
+}
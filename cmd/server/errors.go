@@ -0,0 +1,109 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	log "github.com/sirupsen/logrus"
+	"github.com/tcotav/elevatormgr/building"
+	"github.com/tcotav/elevatormgr/elevator"
+)
+
+// Errors.  Every route answers a failure with an ErrorBody, the status and
+// code come from the sentinel errors in the building and elevator packages.
+// Anything else is the request's fault -- a path param that isn't a number, a
+// body that doesn't parse -- and gets a 400, except JSON encoding failures
+// which are ours and get a 500.
+
+// ErrorBody is what every error response looks like
+type ErrorBody struct {
+	Code     string `json:"code"`
+	Message  string `json:"message"`
+	Building *int   `json:"building,omitempty"`
+	Elevator *int   `json:"elevator,omitempty"`
+	// the message again, for clients from before there was a code
+	Error string `json:"error"`
+}
+
+// unplacedBody is the error body for a car change that went ahead but left
+// some of its hall calls without a car, reassigned says where the rest went
+type unplacedBody struct {
+	ErrorBody
+	Reassigned []building.Reassignment `json:"reassigned"`
+}
+
+// first match wins, so the more specific sentinels go first
+var errorCodes = []struct {
+	err    error
+	status int
+	code   string
+}{
+	{building.ErrElevatorNotFound, http.StatusNotFound, "elevator_not_found"},
+	{building.ErrCallNotFound, http.StatusNotFound, "call_not_found"},
+	{building.ErrNotInService, http.StatusConflict, "not_in_service"},
+	{elevator.ErrWrongMode, http.StatusConflict, "wrong_mode"},
+	{elevator.ErrNotReady, http.StatusConflict, "not_ready"},
+	{elevator.ErrDuplicateCall, http.StatusConflict, "duplicate_call"},
+	{elevator.ErrNoCalls, http.StatusConflict, "no_calls"},
+	{building.ErrNoElevators, http.StatusConflict, "no_elevators"},
+	{building.ErrConflict, http.StatusConflict, "conflict"},
+	{building.ErrNotAuthorized, http.StatusForbidden, "not_authorized"},
+	{elevator.ErrInvalidFloor, http.StatusBadRequest, "invalid_floor"},
+	{elevator.ErrFloorNotServed, http.StatusBadRequest, "floor_not_served"},
+	{building.ErrInvalidArgument, http.StatusBadRequest, "invalid_argument"},
+}
+
+// errorStatus works out the status code and error code for err
+func errorStatus(err error) (int, string) {
+	for _, ec := range errorCodes {
+		if errors.Is(err, ec.err) {
+			return ec.status, ec.code
+		}
+	}
+	var unsupportedType *json.UnsupportedTypeError
+	var unsupportedValue *json.UnsupportedValueError
+	var marshaler *json.MarshalerError
+	if errors.As(err, &unsupportedType) || errors.As(err, &unsupportedValue) || errors.As(err, &marshaler) {
+		return http.StatusInternalServerError, "internal"
+	}
+	return http.StatusBadRequest, "bad_request"
+}
+
+// newErrorBody describes err, the building and elevator come from err when it
+// knows and from the request when it doesn't
+func newErrorBody(c *gin.Context, code string, err error) ErrorBody {
+	body := ErrorBody{Code: code, Message: err.Error(), Error: err.Error()}
+	var e *elevator.Error
+	if errors.As(err, &e) {
+		buildingID := e.BuildingID
+		body.Building = &buildingID
+		if e.ElevatorID >= 0 {
+			elevatorID := e.ElevatorID
+			body.Elevator = &elevatorID
+		}
+	}
+	if b, ok := c.Get(buildingKey); ok && body.Building == nil {
+		buildingID := b.(*building.Building).ID
+		body.Building = &buildingID
+	}
+	if elevatorID, err := strconv.Atoi(c.Param("elevator")); err == nil && body.Elevator == nil {
+		body.Elevator = &elevatorID
+	}
+	return body
+}
+
+// respondError stops the request with an error body
+func respondError(c *gin.Context, status int, code string, err error) {
+	c.AbortWithStatusJSON(status, newErrorBody(c, code, err))
+}
+
+// handleError logs err and answers with the status it maps to
+func handleError(c *gin.Context, source string, err error) {
+	status, code := errorStatus(err)
+	log.Error(fmt.Sprintf("%s - %s", source, err.Error()))
+	respondError(c, status, code, err)
+}
@@ -0,0 +1,74 @@
+package main
+
+import (
+	"encoding/json"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/tcotav/elevatormgr/building"
+)
+
+func errorBody(t *testing.T, w *httptest.ResponseRecorder) ErrorBody {
+	t.Helper()
+	var body ErrorBody
+	if err := json.Unmarshal(w.Body.Bytes(), &body); err != nil {
+		t.Fatalf("Expected an error body, got %s", w.Body.String())
+	}
+	return body
+}
+
+func TestErrorBody(t *testing.T) {
+	first, _ := useBuildings(t)
+	router := setupRouter()
+
+	w := serveV1(router, "POST", "/v1/buildings/1/elevators/7/car-calls", `{"floor": 3}`)
+	body := errorBody(t, w)
+	if w.Code != http.StatusNotFound || body.Code != "elevator_not_found" {
+		t.Errorf("Expected a 404 elevator_not_found, got %d %s", w.Code, body.Code)
+	}
+	if body.Building == nil || *body.Building != 1 || body.Elevator == nil || *body.Elevator != 7 {
+		t.Errorf("Error should be about elevator 7 in building 1, got %s", w.Body.String())
+	}
+	if body.Message == "" || body.Message != body.Error {
+		t.Errorf("Error should carry its message, got %s", w.Body.String())
+	}
+
+	first.SetElevatorInServiceStatus(1, false)
+	w = serveV1(router, "POST", "/v1/buildings/1/elevators/1/car-calls", `{"floor": 3}`)
+	if body = errorBody(t, w); w.Code != http.StatusConflict || body.Code != "not_in_service" {
+		t.Errorf("Expected a 409 not_in_service, got %d %s", w.Code, body.Code)
+	}
+
+	// the old routes answer the same way
+	w = httptest.NewRecorder()
+	req, _ := http.NewRequest("POST", "/pushDestination/x/3", nil)
+	router.ServeHTTP(w, req)
+	if body = errorBody(t, w); w.Code != http.StatusBadRequest || body.Code != "bad_request" || body.Elevator != nil {
+		t.Errorf("Expected a 400 bad_request with no elevator, got %d %s", w.Code, w.Body.String())
+	}
+
+	w = serveV1(router, "GET", "/v1/buildings/9/floors", "")
+	if body = errorBody(t, w); w.Code != http.StatusNotFound || body.Code != "building_not_found" || body.Building == nil || *body.Building != 9 {
+		t.Errorf("Expected a 404 building_not_found for building 9, got %d %s", w.Code, w.Body.String())
+	}
+
+	w = httptest.NewRecorder()
+	req, _ = http.NewRequest("GET", "/v1/buildings/1/priority-calls", nil)
+	router.ServeHTTP(w, req)
+	if body = errorBody(t, w); w.Code != http.StatusUnauthorized || body.Code != "unauthorized" {
+		t.Errorf("Expected a 401 unauthorized, got %d %s", w.Code, body.Code)
+	}
+}
+
+func TestErrorStatus(t *testing.T) {
+	_, err := json.Marshal(math.Inf(1))
+	if status, code := errorStatus(err); status != http.StatusInternalServerError || code != "internal" {
+		t.Errorf("JSON encoding failures should be a 500, got %d %s", status, code)
+	}
+	_, err = building.NewBuilding(1, 10, 1).RegisterHallCall(11, 1)
+	if status, code := errorStatus(err); status != http.StatusBadRequest || code != "invalid_floor" {
+		t.Errorf("Floor 11 should be a 400 invalid_floor, got %d %s", status, code)
+	}
+}
@@ -0,0 +1,1153 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	log "github.com/sirupsen/logrus"
+	"github.com/tcotav/elevatormgr/audit"
+	"github.com/tcotav/elevatormgr/building"
+	"github.com/tcotav/elevatormgr/config"
+	"github.com/tcotav/elevatormgr/elevator"
+	"github.com/tcotav/elevatormgr/rpc"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+// The gRPC API, see rpc/elevatormgr.proto.  It works on the same buildings
+// as the HTTP routes and goes through the same building calls, keys, audit
+// log and approval queue, so both APIs see the same thing.
+
+// controlServer is the ElevatorControl service
+type controlServer struct {
+	rpc.UnimplementedElevatorControlServer
+}
+
+// how often WatchElevators looks for changes nothing sent an event for
+var watchInterval = time.Second
+
+// newGRPCServer sets up the gRPC server with the service and logging
+func newGRPCServer() *grpc.Server {
+	s := grpc.NewServer(grpc.UnaryInterceptor(logUnary), grpc.StreamInterceptor(logStream))
+	rpc.RegisterElevatorControlServer(s, &controlServer{})
+	return s
+}
+
+// serveGRPC runs the gRPC API on listen until the process ends
+func serveGRPC(listen string) {
+	lis, err := net.Listen("tcp", listen)
+	if err != nil {
+		log.Fatal(fmt.Sprintf("grpc - %s", err.Error()))
+	}
+	log.Info(fmt.Sprintf("Starting gRPC server on %s", listen))
+	if err := newGRPCServer().Serve(lis); err != nil {
+		log.Fatal(fmt.Sprintf("grpc - %s", err.Error()))
+	}
+}
+
+// logRPC logs a finished call the way jsonLogger logs a request
+func logRPC(ctx context.Context, method string, start time.Time, err error) {
+	logMap := map[string]interface{}{
+		"status_code":   status.Code(err).String(),
+		"method":        "GRPC",
+		"path":          method,
+		"start_time":    start.Format("2006/01/02 - 15:04:05"),
+		"remote_addr":   peerAddr(ctx),
+		"response_time": time.Since(start).String(),
+	}
+	s, _ := json.Marshal(logMap)
+	fmt.Fprintln(gin.DefaultWriter, string(s))
+}
+
+func logUnary(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	start := time.Now()
+	resp, err := handler(ctx, req)
+	logRPC(ctx, info.FullMethod, start, err)
+	return resp, err
+}
+
+func logStream(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	start := time.Now()
+	err := handler(srv, ss)
+	logRPC(ss.Context(), info.FullMethod, start, err)
+	return err
+}
+
+func peerAddr(ctx context.Context) string {
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		if host, _, err := net.SplitHostPort(p.Addr.String()); err == nil {
+			return host
+		}
+		return p.Addr.String()
+	}
+	return ""
+}
+
+// the gRPC code for each HTTP status errorStatus hands out
+var grpcCodes = map[int]codes.Code{
+	http.StatusBadRequest:          codes.InvalidArgument,
+	http.StatusUnauthorized:        codes.Unauthenticated,
+	http.StatusForbidden:           codes.PermissionDenied,
+	http.StatusNotFound:            codes.NotFound,
+	http.StatusConflict:            codes.FailedPrecondition,
+	http.StatusInternalServerError: codes.Internal,
+}
+
+// rpcStatus is the gRPC version of an ErrorBody, the code goes in an
+// ErrorInfo along with the building and elevator when they're known
+func rpcStatus(httpStatus int, code string, err error, buildingID int, elevatorID int) error {
+	info := &errdetails.ErrorInfo{Reason: code, Domain: "elevatormgr", Metadata: map[string]string{}}
+	var e *elevator.Error
+	if errors.As(err, &e) {
+		buildingID, elevatorID = e.BuildingID, e.ElevatorID
+	}
+	if buildingID >= 0 {
+		info.Metadata["building"] = strconv.Itoa(buildingID)
+	}
+	if elevatorID >= 0 {
+		info.Metadata["elevator"] = strconv.Itoa(elevatorID)
+	}
+	st := status.New(grpcCodes[httpStatus], err.Error())
+	if withInfo, derr := st.WithDetails(info); derr == nil {
+		st = withInfo
+	}
+	return st.Err()
+}
+
+// rpcError logs err and turns it into a gRPC error, like handleError
+func rpcError(source string, err error, buildingID int, elevatorID int) error {
+	httpStatus, code := errorStatus(err)
+	log.Error(fmt.Sprintf("%s - %s", source, err.Error()))
+	return rpcStatus(httpStatus, code, err, buildingID, elevatorID)
+}
+
+// rpcBuilding finds the building a call is for
+func rpcBuilding(buildingID int32) (*building.Building, error) {
+	b := lookupBuilding(int(buildingID))
+	if b == nil {
+		return nil, rpcStatus(http.StatusNotFound, "building_not_found", fmt.Errorf("building: %d does not exist", buildingID), int(buildingID), -1)
+	}
+	return b, nil
+}
+
+// rpcCredential pulls the token out of the call metadata, same as credential
+func rpcCredential(ctx context.Context) string {
+	md, _ := metadata.FromIncomingContext(ctx)
+	if auth := md.Get("authorization"); len(auth) > 0 && strings.HasPrefix(auth[0], "Bearer ") {
+		return strings.TrimSpace(strings.TrimPrefix(auth[0], "Bearer "))
+	}
+	if key := md.Get("x-api-key"); len(key) > 0 {
+		return key[0]
+	}
+	return ""
+}
+
+// rpcHeader is the first value of a metadata key, for the fire service and
+// priority keys the HTTP routes take as headers
+func rpcHeader(ctx context.Context, name string) string {
+	md, _ := metadata.FromIncomingContext(ctx)
+	if v := md.Get(name); len(v) > 0 {
+		return v[0]
+	}
+	return ""
+}
+
+// rpcAuthorize is requireRole for gRPC, it returns the caller's key
+func rpcAuthorize(ctx context.Context, b *building.Building, role Role) (*APIKey, error) {
+	deny := func(httpStatus int, principal string, reason string) (*APIKey, error) {
+		log.WithFields(log.Fields{
+			"status_code": grpcCodes[httpStatus].String(),
+			"method":      "GRPC",
+			"path":        methodName(ctx),
+			"remote_addr": peerAddr(ctx),
+			"principal":   principal,
+		}).Warn(fmt.Sprintf("access denied - %s", reason))
+		code := "forbidden"
+		if httpStatus == http.StatusUnauthorized {
+			code = "unauthorized"
+		}
+		return nil, rpcStatus(httpStatus, code, errors.New(reason), b.ID, -1)
+	}
+	token := rpcCredential(ctx)
+	if token == "" {
+		return deny(http.StatusUnauthorized, "", "missing credentials")
+	}
+	key := apiKeys.lookup(token)
+	if key == nil {
+		return deny(http.StatusUnauthorized, "", "invalid credentials")
+	}
+	if roleRank[key.Role] < roleRank[role] {
+		return deny(http.StatusForbidden, key.Name, fmt.Sprintf("role %s required", role))
+	}
+	if !key.servesBuilding(b.ID) {
+		return deny(http.StatusForbidden, key.Name, fmt.Sprintf("not authorized for building: %d", b.ID))
+	}
+	return key, nil
+}
+
+func methodName(ctx context.Context) string {
+	method, _ := grpc.Method(ctx)
+	return method
+}
+
+// recordRPCAudit is recordAudit for an action taken over gRPC, key is nil for
+// the calls that don't take an API key
+func recordRPCAudit(ctx context.Context, key *APIKey, b *building.Building, action string, elevatorID int, before []byte, reason string, err error) {
+	actor := "anonymous"
+	if key != nil {
+		actor = key.Name
+	}
+	entry := audit.Entry{
+		Action:     action,
+		Actor:      actor,
+		SourceIP:   peerAddr(ctx),
+		BuildingID: b.ID,
+		ElevatorID: elevatorID,
+		Before:     before,
+		After:      auditState(b, elevatorID),
+		Reason:     reason,
+	}
+	if err != nil {
+		entry.Error = err.Error()
+	}
+	if _, err := auditLog.Append(entry); err != nil {
+		log.Error(fmt.Sprintf("audit - %s could not be recorded: %s", action, err.Error()))
+	}
+}
+
+// requestApproval parks a gRPC command as the v1 request that does the same
+// thing, so it's approved and run through /v1/approvals like any other
+func requestApproval(key *APIKey, buildingID int, action string, role Role, method string, target string, body interface{}) (*rpc.PendingChange, error) {
+	var raw json.RawMessage
+	if body != nil {
+		b, err := json.Marshal(body)
+		if err != nil {
+			return nil, err
+		}
+		raw = b
+	}
+	r, err := http.NewRequest(method, target, nil)
+	if err != nil {
+		return nil, err
+	}
+	p, err := approvals.request(action, buildingID, r, raw, role, key.Name)
+	if err != nil {
+		return nil, err
+	}
+	log.Info(fmt.Sprintf("Pending change %s: %s %s by %s needs approval by %s.", p.ID, p.Method, p.URL, p.RequestedBy, p.ExpiresAt.Format(time.RFC3339)))
+	return &rpc.PendingChange{ApprovalId: p.ID, Action: p.Action, ExpiresAt: unixNano(p.ExpiresAt)}, nil
+}
+
+// withReason puts the reason on a parked request's URL, where the HTTP
+// handlers look for it when it's run
+func withReason(target string, reason string) string {
+	if reason == "" {
+		return target
+	}
+	return target + "?reason=" + url.QueryEscape(reason)
+}
+
+var rpcModes = map[elevator.Mode]rpc.Mode{
+	elevator.ModeNormal:          rpc.Mode_MODE_NORMAL,
+	elevator.ModeFireRecall:      rpc.Mode_MODE_FIRE_RECALL,
+	elevator.ModeFirefighter:     rpc.Mode_MODE_FIREFIGHTER,
+	elevator.ModeEmergencyReturn: rpc.Mode_MODE_EMERGENCY_RETURN,
+	elevator.ModeEmergencyParked: rpc.Mode_MODE_EMERGENCY_PARKED,
+	elevator.ModeIndependent:     rpc.Mode_MODE_INDEPENDENT,
+	elevator.ModePriority:        rpc.Mode_MODE_PRIORITY,
+	elevator.ModeDraining:        rpc.Mode_MODE_DRAINING,
+}
+
+var rpcCallTypes = map[elevator.CallType]rpc.CallType{
+	elevator.HallCall: rpc.CallType_CALL_TYPE_HALL,
+	elevator.CarCall:  rpc.CallType_CALL_TYPE_CAR,
+}
+
+var rpcCallStatuses = map[building.CallStatus]rpc.CallStatus{
+	building.CallRegistered: rpc.CallStatus_CALL_STATUS_REGISTERED,
+	building.CallAssigned:   rpc.CallStatus_CALL_STATUS_ASSIGNED,
+	building.CallReassigned: rpc.CallStatus_CALL_STATUS_REASSIGNED,
+	building.CallAnswered:   rpc.CallStatus_CALL_STATUS_ANSWERED,
+	building.CallCompleted:  rpc.CallStatus_CALL_STATUS_COMPLETED,
+	building.CallCancelled:  rpc.CallStatus_CALL_STATUS_CANCELLED,
+	building.CallExpired:    rpc.CallStatus_CALL_STATUS_EXPIRED,
+}
+
+// unixNano is a time for the wire, zero stays zero
+func unixNano(t time.Time) int64 {
+	if t.IsZero() {
+		return 0
+	}
+	return t.UnixNano()
+}
+
+func int32s(ints []int) []int32 {
+	out := make([]int32, 0, len(ints))
+	for _, i := range ints {
+		out = append(out, int32(i))
+	}
+	return out
+}
+
+func toRPCCall(c elevator.Call) *rpc.Call {
+	return &rpc.Call{
+		Floor:        int32(c.Floor),
+		Direction:    int32(c.Direction),
+		Type:         rpcCallTypes[c.Type],
+		RegisteredAt: unixNano(c.RegisteredAt),
+		Express:      c.Express,
+	}
+}
+
+func toRPCCalls(calls []elevator.Call) []*rpc.Call {
+	out := make([]*rpc.Call, 0, len(calls))
+	for _, c := range calls {
+		out = append(out, toRPCCall(c))
+	}
+	return out
+}
+
+func toRPCElevator(e *elevator.Elevator) *rpc.Elevator {
+	re := &rpc.Elevator{
+		Building:      int32(e.BuildingID),
+		Id:            int32(e.ElevatorID),
+		CurrentFloor:  int32(e.CurrentFloor),
+		Direction:     int32(e.Direction),
+		MaxFloor:      int32(e.MaxFloor),
+		InService:     e.InService,
+		Mode:          rpcModes[e.Mode],
+		DoorsOpen:     e.DoorsOpen,
+		DeferredCalls: toRPCCalls(e.DeferredCalls),
+		ServedFloors:  int32s(e.ServedFloors),
+		Capacity:      int32(e.Capacity),
+		Speed:         e.Speed,
+	}
+	if e.CallList != nil {
+		re.Calls = toRPCCalls(e.CallList.Calls)
+	}
+	return re
+}
+
+func toRPCTicket(t building.Ticket) *rpc.Ticket {
+	rt := &rpc.Ticket{
+		Id:        t.ID,
+		Building:  int32(t.BuildingID),
+		Type:      rpcCallTypes[t.Type],
+		Floor:     int32(t.Floor),
+		Direction: int32(t.Direction),
+		Elevator:  int32(t.ElevatorID),
+		Status:    rpcCallStatuses[t.Status],
+	}
+	for _, h := range t.History {
+		rt.History = append(rt.History, &rpc.TicketStatusChange{
+			Status:   rpcCallStatuses[h.Status],
+			Elevator: int32(h.ElevatorID),
+			Time:     unixNano(h.Time),
+		})
+	}
+	return rt
+}
+
+func toRPCHallCall(hc building.HallCall) *rpc.HallCall {
+	return &rpc.HallCall{
+		Floor:        int32(hc.Floor),
+		Direction:    int32(hc.Direction),
+		Elevator:     int32(hc.ElevatorID),
+		RegisteredAt: unixNano(hc.RegisteredAt),
+		AssignedAt:   unixNano(hc.AssignedAt),
+		Ticket:       hc.TicketID,
+		EscalatedAt:  unixNano(hc.EscalatedAt),
+	}
+}
+
+func toRPCPriorityCallRecord(r building.PriorityCallRecord) *rpc.PriorityCallRecord {
+	return &rpc.PriorityCallRecord{
+		Time:        unixNano(r.Time),
+		Floor:       int32(r.Floor),
+		Destination: int32(r.Destination),
+		Elevator:    int32(r.ElevatorID),
+		RequestedBy: r.RequestedBy,
+		Reason:      r.Reason,
+		Authorized:  r.Authorized,
+		Error:       r.Error,
+	}
+}
+
+func toRPCMaintenanceWindow(w building.MaintenanceWindow) *rpc.MaintenanceWindow {
+	return &rpc.MaintenanceWindow{
+		Id:         int32(w.ID),
+		Elevator:   int32(w.ElevatorID),
+		Start:      unixNano(w.Start),
+		End:        unixNano(w.End),
+		Reason:     w.Reason,
+		Technician: w.Technician,
+		Active:     w.Active,
+	}
+}
+
+func toRPCEmergencyPower(s building.EmergencyPowerStatus) *rpc.EmergencyPowerStatus {
+	return &rpc.EmergencyPowerStatus{
+		Active:      s.Active,
+		RunningCars: int32(s.RunningCars),
+		Selected:    int32s(s.Selected),
+		ReturnQueue: int32s(s.ReturnQueue),
+	}
+}
+
+func toRPCBuilding(b *building.Building) *rpc.Building {
+	return &rpc.Building{Id: int32(b.ID), Floors: int32(len(b.GetFloors())), Elevators: int32s(b.GetElevatorIDs())}
+}
+
+func ints(in []int32) []int {
+	out := make([]int, 0, len(in))
+	for _, i := range in {
+		out = append(out, int(i))
+	}
+	return out
+}
+
+// rpcElevators reads every car's state, the raw state is for spotting changes
+func rpcElevators(b *building.Building) (*rpc.GetElevatorsResponse, []byte, error) {
+	state, err := b.GetAllElevatorState()
+	if err != nil {
+		return nil, nil, err
+	}
+	var cars []*elevator.Elevator
+	if err := json.Unmarshal(state, &cars); err != nil {
+		return nil, nil, err
+	}
+	resp := &rpc.GetElevatorsResponse{}
+	for _, e := range cars {
+		resp.Elevators = append(resp.Elevators, toRPCElevator(e))
+	}
+	return resp, state, nil
+}
+
+// rpcElevator reads one car's state
+func rpcElevator(b *building.Building, elevatorID int) (*rpc.Elevator, error) {
+	state, err := b.GetElevatorState(elevatorID)
+	if err != nil {
+		return nil, err
+	}
+	var e elevator.Elevator
+	if err := json.Unmarshal(state, &e); err != nil {
+		return nil, err
+	}
+	return toRPCElevator(&e), nil
+}
+
+// rpcCarChange is the reply to a command that moved a car's hall calls.  Like
+// the HTTP routes, calls no car could take are an error once the rest are
+// placed, and the error carries the change so where the rest went isn't lost.
+func rpcCarChange(source string, b *building.Building, elevatorID int, reassigned []building.Reassignment, unplaced error) (*rpc.CarChange, error) {
+	change := &rpc.CarChange{}
+	for _, r := range reassigned {
+		change.Reassigned = append(change.Reassigned, &rpc.Reassignment{Call: toRPCCall(r.Call), Elevator: int32(r.ElevatorID)})
+	}
+	e, err := rpcElevator(b, elevatorID)
+	if unplaced != nil {
+		log.Error(fmt.Sprintf("%s - %s", source, unplaced.Error()))
+		change.Elevator = e
+		st := status.Convert(rpcStatus(http.StatusConflict, "calls_unplaced", unplaced, b.ID, elevatorID))
+		if withChange, derr := st.WithDetails(change); derr == nil {
+			st = withChange
+		}
+		return nil, st.Err()
+	}
+	if err != nil {
+		return nil, rpcError(source, err, b.ID, elevatorID)
+	}
+	change.Elevator = e
+	return change, nil
+}
+
+func (s *controlServer) ListBuildings(ctx context.Context, req *rpc.ListBuildingsRequest) (*rpc.ListBuildingsResponse, error) {
+	resp := &rpc.ListBuildingsResponse{}
+	for _, b := range allBuildings() {
+		resp.Buildings = append(resp.Buildings, toRPCBuilding(b))
+	}
+	return resp, nil
+}
+
+func (s *controlServer) GetElevators(ctx context.Context, req *rpc.GetElevatorsRequest) (*rpc.GetElevatorsResponse, error) {
+	b, err := rpcBuilding(req.Building)
+	if err != nil {
+		return nil, err
+	}
+	resp, _, err := rpcElevators(b)
+	if err != nil {
+		return nil, rpcError("getallstate", err, b.ID, -1)
+	}
+	return resp, nil
+}
+
+func (s *controlServer) GetElevator(ctx context.Context, req *rpc.GetElevatorRequest) (*rpc.Elevator, error) {
+	b, err := rpcBuilding(req.Building)
+	if err != nil {
+		return nil, err
+	}
+	e, err := rpcElevator(b, int(req.Elevator))
+	if err != nil {
+		return nil, rpcError("showelev", err, b.ID, int(req.Elevator))
+	}
+	return e, nil
+}
+
+// WatchElevators sends the cars whenever they change.  Call events say when
+// something happened, and the building is looked at every watchInterval for
+// the changes that don't have an event.
+func (s *controlServer) WatchElevators(req *rpc.WatchElevatorsRequest, stream rpc.ElevatorControl_WatchElevatorsServer) error {
+	b, err := rpcBuilding(req.Building)
+	if err != nil {
+		return err
+	}
+	events, unsubscribe := b.Subscribe(20)
+	defer unsubscribe()
+	ticker := time.NewTicker(watchInterval)
+	defer ticker.Stop()
+	var last []byte
+	for {
+		resp, state, err := rpcElevators(b)
+		if err != nil {
+			return rpcError("watchelevators", err, b.ID, -1)
+		}
+		if !bytes.Equal(state, last) {
+			if err := stream.Send(resp); err != nil {
+				return err
+			}
+			last = state
+		}
+		select {
+		case _, ok := <-events:
+			if !ok {
+				return nil
+			}
+		case <-ticker.C:
+		case <-stream.Context().Done():
+			return nil
+		}
+	}
+}
+
+func (s *controlServer) CallElevator(ctx context.Context, req *rpc.CallElevatorRequest) (*rpc.Ticket, error) {
+	b, err := rpcBuilding(req.Building)
+	if err != nil {
+		return nil, err
+	}
+	ticket, err := b.RegisterHallCall(int(req.Floor), int(req.Direction))
+	if err != nil {
+		return nil, rpcError("callelev", err, b.ID, -1)
+	}
+	log.Info(fmt.Sprintf("Elevator %d was called to floor %d in direction %d.", ticket.ElevatorID, req.Floor, req.Direction))
+	return toRPCTicket(ticket), nil
+}
+
+func (s *controlServer) PushDestination(ctx context.Context, req *rpc.PushDestinationRequest) (*rpc.Ticket, error) {
+	b, err := rpcBuilding(req.Building)
+	if err != nil {
+		return nil, err
+	}
+	ticket, err := b.RegisterCarCall(int(req.Elevator), int(req.Floor))
+	if err != nil {
+		return nil, rpcError("pushdest", err, b.ID, int(req.Elevator))
+	}
+	log.Info(fmt.Sprintf("Elevator %d was called to floor %d.", req.Elevator, req.Floor))
+	return toRPCTicket(ticket), nil
+}
+
+func (s *controlServer) CancelHallCall(ctx context.Context, req *rpc.CancelHallCallRequest) (*rpc.CancelResponse, error) {
+	b, err := rpcBuilding(req.Building)
+	if err != nil {
+		return nil, err
+	}
+	if err := b.CancelHallCall(int(req.Floor), int(req.Direction), req.Reason); err != nil {
+		return nil, rpcError("cancelhallcall", err, b.ID, -1)
+	}
+	log.Info(fmt.Sprintf("Hall call at floor %d direction %d was cancelled.", req.Floor, req.Direction))
+	return &rpc.CancelResponse{}, nil
+}
+
+func (s *controlServer) CancelCarCall(ctx context.Context, req *rpc.CancelCarCallRequest) (*rpc.CancelResponse, error) {
+	b, err := rpcBuilding(req.Building)
+	if err != nil {
+		return nil, err
+	}
+	if err := b.CancelCarCall(int(req.Elevator), int(req.Floor), req.Reason); err != nil {
+		return nil, rpcError("cancelcarcall", err, b.ID, int(req.Elevator))
+	}
+	log.Info(fmt.Sprintf("Elevator %d call to floor %d was cancelled.", req.Elevator, req.Floor))
+	return &rpc.CancelResponse{}, nil
+}
+
+func (s *controlServer) GetCall(ctx context.Context, req *rpc.GetCallRequest) (*rpc.Ticket, error) {
+	b, err := rpcBuilding(req.Building)
+	if err != nil {
+		return nil, err
+	}
+	ticket, err := b.GetTicket(req.Id)
+	if err != nil {
+		return nil, rpcError("getcall", err, b.ID, -1)
+	}
+	return toRPCTicket(ticket), nil
+}
+
+// WatchCall is StreamCall for gRPC
+func (s *controlServer) WatchCall(req *rpc.GetCallRequest, stream rpc.ElevatorControl_WatchCallServer) error {
+	b, err := rpcBuilding(req.Building)
+	if err != nil {
+		return err
+	}
+	// subscribe first so nothing slips by between the lookup and the stream
+	events, unsubscribe := b.Subscribe(20)
+	defer unsubscribe()
+	ticket, err := b.GetTicket(req.Id)
+	if err != nil {
+		return rpcError("watchcall", err, b.ID, -1)
+	}
+	if err := stream.Send(toRPCTicket(ticket)); err != nil || ticket.Status.Done() {
+		return err
+	}
+	for {
+		select {
+		case ev, ok := <-events:
+			if !ok {
+				return nil
+			}
+			if ev.TicketID != req.Id {
+				continue
+			}
+			ticket, err := b.GetTicket(req.Id)
+			if err != nil {
+				return rpcError("watchcall", err, b.ID, -1)
+			}
+			if err := stream.Send(toRPCTicket(ticket)); err != nil || ticket.Status.Done() {
+				return err
+			}
+		case <-stream.Context().Done():
+			return nil
+		}
+	}
+}
+
+func (s *controlServer) GetHallCalls(ctx context.Context, req *rpc.GetHallCallsRequest) (*rpc.GetHallCallsResponse, error) {
+	b, err := rpcBuilding(req.Building)
+	if err != nil {
+		return nil, err
+	}
+	calls := b.GetHallCalls()
+	if req.Overdue {
+		calls = b.GetOverdueCalls()
+	}
+	resp := &rpc.GetHallCallsResponse{}
+	for _, hc := range calls {
+		resp.HallCalls = append(resp.HallCalls, toRPCHallCall(hc))
+	}
+	return resp, nil
+}
+
+// taking a car out of service needs approving during peak hours, same as PATCH
+func (s *controlServer) SetInService(ctx context.Context, req *rpc.SetInServiceRequest) (*rpc.CarChange, error) {
+	errloc := "setinservice"
+	b, err := rpcBuilding(req.Building)
+	if err != nil {
+		return nil, err
+	}
+	key, err := rpcAuthorize(ctx, b, RoleTechnician)
+	if err != nil {
+		return nil, err
+	}
+	elevatorID := int(req.Elevator)
+	if !req.InService && duringPeak(nil) {
+		target := withReason(fmt.Sprintf("/v1/buildings/%d/elevators/%d", b.ID, elevatorID), req.Reason)
+		p, err := requestApproval(key, b.ID, "takeElevatorOutOfService", RoleTechnician, http.MethodPatch, target, elevatorPatch{InService: &req.InService})
+		if err != nil {
+			return nil, rpcError(errloc, err, b.ID, elevatorID)
+		}
+		return &rpc.CarChange{ApprovalId: p.ApprovalId}, nil
+	}
+	before := auditState(b, elevatorID)
+	reassigned, applied, err := b.SetElevatorInServiceStatus(elevatorID, req.InService)
+	if !applied {
+		return nil, rpcError(errloc, err, b.ID, elevatorID)
+	}
+	if req.InService {
+		log.Info(fmt.Sprintf("Elevator %d was put back in service.", elevatorID))
+		recordRPCAudit(ctx, key, b, "elevatorBackInService", elevatorID, before, req.Reason, nil)
+		return rpcCarChange(errloc, b, elevatorID, nil, nil)
+	}
+	// out of service goes ahead even when some calls can't be placed
+	log.Info(fmt.Sprintf("Elevator %d was taken out of service. %d hall calls were impacted.", elevatorID, len(reassigned)))
+	recordRPCAudit(ctx, key, b, "takeElevatorOutOfService", elevatorID, before, req.Reason, err)
+	return rpcCarChange(errloc, b, elevatorID, reassigned, err)
+}
+
+func (s *controlServer) SetIndependentService(ctx context.Context, req *rpc.SetIndependentServiceRequest) (*rpc.CarChange, error) {
+	errloc := "independentservice"
+	b, err := rpcBuilding(req.Building)
+	if err != nil {
+		return nil, err
+	}
+	key, err := rpcAuthorize(ctx, b, RoleTechnician)
+	if err != nil {
+		return nil, err
+	}
+	elevatorID := int(req.Elevator)
+	before := auditState(b, elevatorID)
+	if err := b.SetIndependentService(elevatorID, req.On); err != nil {
+		return nil, rpcError(errloc, err, b.ID, elevatorID)
+	}
+	log.Info(fmt.Sprintf("Elevator %d independent service set to %t.", elevatorID, req.On))
+	recordRPCAudit(ctx, key, b, "independentService", elevatorID, before, req.Reason, nil)
+	return rpcCarChange(errloc, b, elevatorID, nil, nil)
+}
+
+func (s *controlServer) DrainElevator(ctx context.Context, req *rpc.ElevatorCommand) (*rpc.CarChange, error) {
+	errloc := "drainelev"
+	b, err := rpcBuilding(req.Building)
+	if err != nil {
+		return nil, err
+	}
+	key, err := rpcAuthorize(ctx, b, RoleTechnician)
+	if err != nil {
+		return nil, err
+	}
+	elevatorID := int(req.Elevator)
+	if duringPeak(nil) {
+		target := withReason(fmt.Sprintf("/v1/buildings/%d/elevators/%d/drain", b.ID, elevatorID), req.Reason)
+		p, err := requestApproval(key, b.ID, "takeElevatorOutOfService", RoleTechnician, http.MethodPost, target, nil)
+		if err != nil {
+			return nil, rpcError(errloc, err, b.ID, elevatorID)
+		}
+		return &rpc.CarChange{ApprovalId: p.ApprovalId}, nil
+	}
+	before := auditState(b, elevatorID)
+	reassigned, err := b.DrainElevator(elevatorID)
+	if err != nil {
+		return nil, rpcError(errloc, err, b.ID, elevatorID)
+	}
+	log.Info(fmt.Sprintf("Elevator %d is draining. %d hall calls were reassigned.", elevatorID, len(reassigned)))
+	recordRPCAudit(ctx, key, b, "drainElevator", elevatorID, before, req.Reason, nil)
+	return rpcCarChange(errloc, b, elevatorID, reassigned, nil)
+}
+
+func (s *controlServer) OverrideCall(ctx context.Context, req *rpc.OverrideCallRequest) (*rpc.CarChange, error) {
+	errloc := "maintoverride"
+	b, err := rpcBuilding(req.Building)
+	if err != nil {
+		return nil, err
+	}
+	key, err := rpcAuthorize(ctx, b, RoleTechnician)
+	if err != nil {
+		return nil, err
+	}
+	elevatorID := int(req.Elevator)
+	before := auditState(b, elevatorID)
+	if err := b.MaintenanceCallOverride(elevatorID, int(req.Floor), int(req.Direction)); err != nil {
+		return nil, rpcError(errloc, err, b.ID, elevatorID)
+	}
+	log.Info(fmt.Sprintf("Maintenance override: elevator %d was called to floor %d", elevatorID, req.Floor))
+	recordRPCAudit(ctx, key, b, "maintenanceCallOverride", elevatorID, before, req.Reason, nil)
+	return rpcCarChange(errloc, b, elevatorID, nil, nil)
+}
+
+func (s *controlServer) ReassignHallCall(ctx context.Context, req *rpc.ReassignHallCallRequest) (*rpc.HallCall, error) {
+	errloc := "reassignhallcall"
+	b, err := rpcBuilding(req.Building)
+	if err != nil {
+		return nil, err
+	}
+	key, err := rpcAuthorize(ctx, b, RoleTechnician)
+	if err != nil {
+		return nil, err
+	}
+	floor, direction, elevatorID := int(req.Floor), int(req.Direction), int(req.Elevator)
+	before := auditState(b, -1)
+	if err := b.ReassignHallCall(floor, direction, elevatorID); err != nil {
+		return nil, rpcError(errloc, err, b.ID, elevatorID)
+	}
+	log.Info(fmt.Sprintf("Hall call at floor %d direction %d was moved to elevator %d.", floor, direction, elevatorID))
+	recordRPCAudit(ctx, key, b, "reassignHallCall", -1, before, req.Reason, nil)
+	for _, hc := range b.GetHallCalls() {
+		if hc.Floor == floor && hc.Direction == direction {
+			return toRPCHallCall(hc), nil
+		}
+	}
+	// answered in the meantime
+	return &rpc.HallCall{}, nil
+}
+
+// a reset always needs a second supervisor, so it only ever gets parked
+func (s *controlServer) ResetElevator(ctx context.Context, req *rpc.ElevatorCommand) (*rpc.CarChange, error) {
+	b, err := rpcBuilding(req.Building)
+	if err != nil {
+		return nil, err
+	}
+	key, err := rpcAuthorize(ctx, b, RoleSupervisor)
+	if err != nil {
+		return nil, err
+	}
+	target := withReason(fmt.Sprintf("/v1/buildings/%d/elevators/%d/reset", b.ID, req.Elevator), req.Reason)
+	p, err := requestApproval(key, b.ID, "resetElevator", RoleSupervisor, http.MethodPost, target, nil)
+	if err != nil {
+		return nil, rpcError("resetelev", err, b.ID, int(req.Elevator))
+	}
+	return &rpc.CarChange{ApprovalId: p.ApprovalId}, nil
+}
+
+func (s *controlServer) GetPriorityCallLog(ctx context.Context, req *rpc.GetPriorityCallLogRequest) (*rpc.GetPriorityCallLogResponse, error) {
+	b, err := rpcBuilding(req.Building)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := rpcAuthorize(ctx, b, RoleTechnician); err != nil {
+		return nil, err
+	}
+	resp := &rpc.GetPriorityCallLogResponse{}
+	for _, r := range b.GetPriorityCallLog() {
+		resp.Records = append(resp.Records, toRPCPriorityCallRecord(r))
+	}
+	return resp, nil
+}
+
+func (s *controlServer) GetMaintenanceWindows(ctx context.Context, req *rpc.GetMaintenanceWindowsRequest) (*rpc.GetMaintenanceWindowsResponse, error) {
+	b, err := rpcBuilding(req.Building)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := rpcAuthorize(ctx, b, RoleTechnician); err != nil {
+		return nil, err
+	}
+	resp := &rpc.GetMaintenanceWindowsResponse{}
+	for _, w := range b.GetMaintenanceWindows(time.Now()) {
+		resp.Windows = append(resp.Windows, toRPCMaintenanceWindow(w))
+	}
+	return resp, nil
+}
+
+// a window that runs into peak hours needs approving, same as POST
+func (s *controlServer) ScheduleMaintenance(ctx context.Context, req *rpc.ScheduleMaintenanceRequest) (*rpc.MaintenanceWindowChange, error) {
+	errloc := "schedulemaint"
+	b, err := rpcBuilding(req.Building)
+	if err != nil {
+		return nil, err
+	}
+	key, err := rpcAuthorize(ctx, b, RoleTechnician)
+	if err != nil {
+		return nil, err
+	}
+	elevatorID := int(req.Elevator)
+	if req.Start == 0 || req.End == 0 || req.Reason == "" || req.Technician == "" {
+		return nil, rpcError(errloc, fmt.Errorf("start, end, reason and technician are required"), b.ID, elevatorID)
+	}
+	window := building.MaintenanceWindow{
+		ElevatorID: elevatorID,
+		Start:      time.Unix(0, req.Start),
+		End:        time.Unix(0, req.End),
+		Reason:     req.Reason,
+		Technician: req.Technician,
+	}
+	if overlapsPeak(window.Start, window.End) {
+		body := maintenanceWindowRequest{Elevator: elevatorID, Start: window.Start, End: window.End, Reason: window.Reason, Technician: window.Technician}
+		p, err := requestApproval(key, b.ID, "scheduleMaintenance", RoleTechnician, http.MethodPost, fmt.Sprintf("/v1/buildings/%d/maintenance-windows", b.ID), body)
+		if err != nil {
+			return nil, rpcError(errloc, err, b.ID, elevatorID)
+		}
+		return &rpc.MaintenanceWindowChange{ApprovalId: p.ApprovalId}, nil
+	}
+	w, err := b.ScheduleMaintenance(window)
+	if err != nil {
+		return nil, rpcError(errloc, err, b.ID, elevatorID)
+	}
+	log.Info(fmt.Sprintf("Maintenance window %d scheduled for elevator %d from %s to %s by %s.", w.ID, w.ElevatorID, w.Start, w.End, w.Technician))
+	recordRPCAudit(ctx, key, b, "scheduleMaintenance", w.ElevatorID, nil, w.Reason, nil)
+	return &rpc.MaintenanceWindowChange{Window: toRPCMaintenanceWindow(w)}, nil
+}
+
+func (s *controlServer) GetAgingPolicy(ctx context.Context, req *rpc.GetAgingPolicyRequest) (*rpc.AgingPolicy, error) {
+	b, err := rpcBuilding(req.Building)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := rpcAuthorize(ctx, b, RoleTechnician); err != nil {
+		return nil, err
+	}
+	policy := b.GetAgingPolicy()
+	return &rpc.AgingPolicy{MaxWait: int64(policy.MaxWait), Weight: policy.Weight}, nil
+}
+
+// the aging policy always needs a second supervisor, so it only ever gets parked
+func (s *controlServer) SetAgingPolicy(ctx context.Context, req *rpc.SetAgingPolicyRequest) (*rpc.PendingChange, error) {
+	b, err := rpcBuilding(req.Building)
+	if err != nil {
+		return nil, err
+	}
+	key, err := rpcAuthorize(ctx, b, RoleSupervisor)
+	if err != nil {
+		return nil, err
+	}
+	body := agingPolicyRequest{MaxWait: config.Duration(req.GetPolicy().GetMaxWait()), Weight: req.GetPolicy().GetWeight()}
+	target := withReason(fmt.Sprintf("/v1/buildings/%d/aging-policy", b.ID), req.Reason)
+	p, err := requestApproval(key, b.ID, "agingPolicy", RoleSupervisor, http.MethodPut, target, body)
+	if err != nil {
+		return nil, rpcError("agingpolicy", err, b.ID, -1)
+	}
+	return p, nil
+}
+
+// priority calls take the priority key instead of an API key, like HTTP
+func (s *controlServer) PriorityCall(ctx context.Context, req *rpc.PriorityCallRequest) (*rpc.PriorityCallRecord, error) {
+	errloc := "prioritycall"
+	b, err := rpcBuilding(req.Building)
+	if err != nil {
+		return nil, err
+	}
+	if req.RequestedBy == "" {
+		return nil, rpcError(errloc, fmt.Errorf("requested_by is required"), b.ID, -1)
+	}
+	record, err := b.PriorityCall(int(req.Floor), int(req.Destination), rpcHeader(ctx, "x-priority-key"), req.RequestedBy, req.Reason)
+	if err != nil {
+		return nil, rpcError(errloc, err, b.ID, -1)
+	}
+	log.Warn(fmt.Sprintf("Priority call: elevator %d sent from floor %d to floor %d for %s.", record.ElevatorID, req.Floor, req.Destination, record.RequestedBy))
+	return toRPCPriorityCallRecord(record), nil
+}
+
+func (s *controlServer) SetSmokeDetector(ctx context.Context, req *rpc.SetSmokeDetectorRequest) (*rpc.SmokeDetector, error) {
+	b, err := rpcBuilding(req.Building)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := rpcAuthorize(ctx, b, RoleTechnician); err != nil {
+		return nil, err
+	}
+	if err := b.SetSmokeDetector(int(req.Floor), req.Active); err != nil {
+		return nil, rpcError("smokedetector", err, b.ID, -1)
+	}
+	log.Info(fmt.Sprintf("Smoke detector on floor %d set to %t.", req.Floor, req.Active))
+	return &rpc.SmokeDetector{Floor: req.Floor, Active: req.Active}, nil
+}
+
+func (s *controlServer) ActivateFireRecall(ctx context.Context, req *rpc.BuildingCommand) (*rpc.FireRecall, error) {
+	b, err := rpcBuilding(req.Building)
+	if err != nil {
+		return nil, err
+	}
+	key, err := rpcAuthorize(ctx, b, RoleTechnician)
+	if err != nil {
+		return nil, err
+	}
+	before := auditState(b, -1)
+	recallFloor, err := b.ActivateFireRecall()
+	if err != nil {
+		return nil, rpcError("firerecall", err, b.ID, -1)
+	}
+	log.Warn(fmt.Sprintf("Fire recall activated, cars recalled to floor %d.", recallFloor))
+	recordRPCAudit(ctx, key, b, "fireRecall", -1, before, req.Reason, nil)
+	return &rpc.FireRecall{Active: true, RecallFloor: int32(recallFloor)}, nil
+}
+
+func (s *controlServer) ResetFireRecall(ctx context.Context, req *rpc.BuildingCommand) (*rpc.FireRecall, error) {
+	b, err := rpcBuilding(req.Building)
+	if err != nil {
+		return nil, err
+	}
+	key, err := rpcAuthorize(ctx, b, RoleTechnician)
+	if err != nil {
+		return nil, err
+	}
+	before := auditState(b, -1)
+	if err := b.ResetFireRecall(); err != nil {
+		return nil, rpcError("resetfirerecall", err, b.ID, -1)
+	}
+	log.Warn("Fire recall was reset.")
+	recordRPCAudit(ctx, key, b, "resetFireRecall", -1, before, req.Reason, nil)
+	return &rpc.FireRecall{}, nil
+}
+
+func (s *controlServer) ActivateEmergencyPower(ctx context.Context, req *rpc.ActivateEmergencyPowerRequest) (*rpc.EmergencyPowerStatus, error) {
+	b, err := rpcBuilding(req.Building)
+	if err != nil {
+		return nil, err
+	}
+	key, err := rpcAuthorize(ctx, b, RoleTechnician)
+	if err != nil {
+		return nil, err
+	}
+	before := auditState(b, -1)
+	if err := b.ActivateEmergencyPower(int(req.RunningCars)); err != nil {
+		return nil, rpcError("emergencypower", err, b.ID, -1)
+	}
+	log.Warn(fmt.Sprintf("Emergency power activated, %d cars will run.", req.RunningCars))
+	recordRPCAudit(ctx, key, b, "emergencyPower", -1, before, req.Reason, nil)
+	return toRPCEmergencyPower(b.GetEmergencyPowerStatus()), nil
+}
+
+func (s *controlServer) SetEmergencyPowerCars(ctx context.Context, req *rpc.SetEmergencyPowerCarsRequest) (*rpc.EmergencyPowerStatus, error) {
+	b, err := rpcBuilding(req.Building)
+	if err != nil {
+		return nil, err
+	}
+	key, err := rpcAuthorize(ctx, b, RoleTechnician)
+	if err != nil {
+		return nil, err
+	}
+	before := auditState(b, -1)
+	if err := b.SetEmergencyPowerCars(ints(req.Elevators)); err != nil {
+		return nil, rpcError("emergencypowercars", err, b.ID, -1)
+	}
+	log.Info(fmt.Sprintf("Emergency power cars set to %v.", req.Elevators))
+	recordRPCAudit(ctx, key, b, "emergencyPowerCars", -1, before, req.Reason, nil)
+	return toRPCEmergencyPower(b.GetEmergencyPowerStatus()), nil
+}
+
+func (s *controlServer) EndEmergencyPower(ctx context.Context, req *rpc.BuildingCommand) (*rpc.EmergencyPowerStatus, error) {
+	b, err := rpcBuilding(req.Building)
+	if err != nil {
+		return nil, err
+	}
+	key, err := rpcAuthorize(ctx, b, RoleTechnician)
+	if err != nil {
+		return nil, err
+	}
+	before := auditState(b, -1)
+	if err := b.EndEmergencyPower(); err != nil {
+		return nil, rpcError("endemergencypower", err, b.ID, -1)
+	}
+	log.Warn("Emergency power ended.")
+	recordRPCAudit(ctx, key, b, "endEmergencyPower", -1, before, req.Reason, nil)
+	return toRPCEmergencyPower(b.GetEmergencyPowerStatus()), nil
+}
+
+func (s *controlServer) GetEmergencyPower(ctx context.Context, req *rpc.GetEmergencyPowerRequest) (*rpc.EmergencyPowerStatus, error) {
+	b, err := rpcBuilding(req.Building)
+	if err != nil {
+		return nil, err
+	}
+	return toRPCEmergencyPower(b.GetEmergencyPowerStatus()), nil
+}
+
+// fire service Phase II is on the firefighters' key, like HTTP
+func (s *controlServer) SetFirefighterService(ctx context.Context, req *rpc.SetFirefighterServiceRequest) (*rpc.Elevator, error) {
+	errloc := "firefighterservice"
+	b, err := rpcBuilding(req.Building)
+	if err != nil {
+		return nil, err
+	}
+	elevatorID := int(req.Elevator)
+	before := auditState(b, elevatorID)
+	if err := b.SetFirefighterService(elevatorID, req.On, rpcHeader(ctx, "x-fire-service-key")); err != nil {
+		return nil, rpcError(errloc, err, b.ID, elevatorID)
+	}
+	log.Warn(fmt.Sprintf("Elevator %d firefighter service set to %t.", elevatorID, req.On))
+	recordRPCAudit(ctx, nil, b, "firefighterService", elevatorID, before, req.Reason, nil)
+	return s.GetElevator(ctx, &rpc.GetElevatorRequest{Building: req.Building, Elevator: req.Elevator})
+}
+
+func (s *controlServer) FirefighterCall(ctx context.Context, req *rpc.FirefighterCallRequest) (*rpc.Elevator, error) {
+	b, err := rpcBuilding(req.Building)
+	if err != nil {
+		return nil, err
+	}
+	elevatorID := int(req.Elevator)
+	if err := b.FirefighterCarCall(elevatorID, int(req.Floor), rpcHeader(ctx, "x-fire-service-key")); err != nil {
+		return nil, rpcError("firefightercall", err, b.ID, elevatorID)
+	}
+	log.Info(fmt.Sprintf("Firefighter call: elevator %d was called to floor %d.", elevatorID, req.Floor))
+	return s.GetElevator(ctx, &rpc.GetElevatorRequest{Building: req.Building, Elevator: req.Elevator})
+}
+
+func (s *controlServer) SetFirefighterDoor(ctx context.Context, req *rpc.SetFirefighterDoorRequest) (*rpc.Elevator, error) {
+	b, err := rpcBuilding(req.Building)
+	if err != nil {
+		return nil, err
+	}
+	elevatorID := int(req.Elevator)
+	if err := b.FirefighterDoorButton(elevatorID, req.Pressed, rpcHeader(ctx, "x-fire-service-key")); err != nil {
+		return nil, rpcError("firefighterdoor", err, b.ID, elevatorID)
+	}
+	return s.GetElevator(ctx, &rpc.GetElevatorRequest{Building: req.Building, Elevator: req.Elevator})
+}
+
+func (s *controlServer) CommissionElevator(ctx context.Context, req *rpc.CommissionElevatorRequest) (*rpc.Elevator, error) {
+	errloc := "createelev"
+	b, err := rpcBuilding(req.Building)
+	if err != nil {
+		return nil, err
+	}
+	key, err := rpcAuthorize(ctx, b, RoleSupervisor)
+	if err != nil {
+		return nil, err
+	}
+	elevatorID := -1
+	if req.Elevator != nil {
+		elevatorID = int(*req.Elevator)
+	}
+	spec := building.ElevatorSpec{ServedFloors: ints(req.ServedFloors), Capacity: int(req.Capacity), Speed: req.Speed}
+	elevatorID, err = b.AddElevator(elevatorID, spec)
+	if err != nil {
+		return nil, rpcError(errloc, err, b.ID, elevatorID)
+	}
+	log.Info(fmt.Sprintf("Elevator %d was commissioned.", elevatorID))
+	recordRPCAudit(ctx, key, b, "commissionElevator", elevatorID, nil, req.Reason, nil)
+	e, err := rpcElevator(b, elevatorID)
+	if err != nil {
+		return nil, rpcError(errloc, err, b.ID, elevatorID)
+	}
+	return e, nil
+}
+
+func (s *controlServer) DecommissionElevator(ctx context.Context, req *rpc.ElevatorCommand) (*rpc.Elevator, error) {
+	errloc := "deleteelev"
+	b, err := rpcBuilding(req.Building)
+	if err != nil {
+		return nil, err
+	}
+	key, err := rpcAuthorize(ctx, b, RoleSupervisor)
+	if err != nil {
+		return nil, err
+	}
+	elevatorID := int(req.Elevator)
+	e, err := rpcElevator(b, elevatorID)
+	if err != nil {
+		return nil, rpcError(errloc, err, b.ID, elevatorID)
+	}
+	before := auditState(b, elevatorID)
+	if err := b.RemoveElevator(elevatorID); err != nil {
+		return nil, rpcError(errloc, err, b.ID, elevatorID)
+	}
+	log.Info(fmt.Sprintf("Elevator %d was decommissioned.", elevatorID))
+	recordRPCAudit(ctx, key, b, "decommissionElevator", elevatorID, before, req.Reason, nil)
+	return e, nil
+}
+
+func (s *controlServer) ExtendFloors(ctx context.Context, req *rpc.ExtendFloorsRequest) (*rpc.Building, error) {
+	b, err := rpcBuilding(req.Building)
+	if err != nil {
+		return nil, err
+	}
+	key, err := rpcAuthorize(ctx, b, RoleSupervisor)
+	if err != nil {
+		return nil, err
+	}
+	before := auditState(b, -1)
+	if err := b.ExtendFloors(int(req.Floors)); err != nil {
+		return nil, rpcError("extendfloors", err, b.ID, -1)
+	}
+	log.Info(fmt.Sprintf("Building %d was extended to %d floors.", b.ID, req.Floors))
+	recordRPCAudit(ctx, key, b, "extendFloors", -1, before, req.Reason, nil)
+	return toRPCBuilding(b), nil
+}
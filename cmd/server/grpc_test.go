@@ -0,0 +1,405 @@
+package main
+
+import (
+	"context"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/tcotav/elevatormgr/audit"
+	"github.com/tcotav/elevatormgr/building"
+	"github.com/tcotav/elevatormgr/rpc"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+// grpcClient runs the gRPC API in memory until the test is done
+func grpcClient(t *testing.T) rpc.ElevatorControlClient {
+	t.Helper()
+	lis := bufconn.Listen(1 << 20)
+	s := newGRPCServer()
+	go s.Serve(lis)
+	conn, err := grpc.Dial("bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return lis.DialContext(ctx) }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		t.Fatalf("Should connect to the gRPC server, got %s", err.Error())
+	}
+	t.Cleanup(func() {
+		conn.Close()
+		s.Stop()
+	})
+	return rpc.NewElevatorControlClient(conn)
+}
+
+// withToken signs a gRPC call onto the maintenance calls
+func withToken(token string) context.Context {
+	return metadata.AppendToOutgoingContext(context.Background(), "authorization", "Bearer "+token)
+}
+
+// errorInfo is the code and metadata a gRPC error carries
+func errorInfo(t *testing.T, err error) (codes.Code, *errdetails.ErrorInfo) {
+	t.Helper()
+	st := status.Convert(err)
+	for _, d := range st.Details() {
+		if info, ok := d.(*errdetails.ErrorInfo); ok {
+			return st.Code(), info
+		}
+	}
+	t.Fatalf("Error should carry an ErrorInfo, got %v", err)
+	return st.Code(), nil
+}
+
+func TestGRPCPassenger(t *testing.T) {
+	first, second := useBuildings(t)
+	client := grpcClient(t)
+	ctx := context.Background()
+
+	resp, err := client.ListBuildings(ctx, &rpc.ListBuildingsRequest{})
+	if err != nil || len(resp.Buildings) != 2 || resp.Buildings[1].Floors != 5 {
+		t.Errorf("Should list buildings 1 and 2, got %v %v", resp, err)
+	}
+
+	ticket, err := client.CallElevator(ctx, &rpc.CallElevatorRequest{Building: 2, Floor: 4, Direction: -1})
+	if err != nil || ticket.Status != rpc.CallStatus_CALL_STATUS_ASSIGNED || ticket.Building != 2 {
+		t.Fatalf("Hall call should be assigned in building 2, got %v %v", ticket, err)
+	}
+	if calls := second.GetHallCalls(); len(calls) != 1 || calls[0].TicketID != ticket.Id {
+		t.Errorf("Building 2 should have the hall call, got %v", calls)
+	}
+	if len(first.GetHallCalls()) != 0 {
+		t.Errorf("Building 1 should not have the hall call")
+	}
+
+	ticket, err = client.PushDestination(ctx, &rpc.PushDestinationRequest{Building: 1, Elevator: 2, Floor: 7})
+	if err != nil || ticket.Type != rpc.CallType_CALL_TYPE_CAR {
+		t.Fatalf("Car call should be made, got %v %v", ticket, err)
+	}
+	e, err := client.GetElevator(ctx, &rpc.GetElevatorRequest{Building: 1, Elevator: 2})
+	if err != nil || len(e.Calls) != 1 || e.Calls[0].Floor != 7 || e.Mode != rpc.Mode_MODE_NORMAL {
+		t.Errorf("Elevator 2 should have a call to 7, got %v %v", e, err)
+	}
+
+	// the same errors as HTTP, with the same codes
+	_, err = client.PushDestination(ctx, &rpc.PushDestinationRequest{Building: 1, Elevator: 7, Floor: 3})
+	code, info := errorInfo(t, err)
+	if code != codes.NotFound || info.Reason != "elevator_not_found" || info.Metadata["building"] != "1" || info.Metadata["elevator"] != "7" {
+		t.Errorf("Expected NotFound elevator_not_found for elevator 7 in building 1, got %s %v", code, info)
+	}
+	_, err = client.GetElevators(ctx, &rpc.GetElevatorsRequest{Building: 9})
+	if code, info := errorInfo(t, err); code != codes.NotFound || info.Reason != "building_not_found" {
+		t.Errorf("Expected NotFound building_not_found, got %s %v", code, info)
+	}
+	_, err = client.CallElevator(ctx, &rpc.CallElevatorRequest{Building: 1, Floor: 11, Direction: 1})
+	if code, info := errorInfo(t, err); code != codes.InvalidArgument || info.Reason != "invalid_floor" {
+		t.Errorf("Expected InvalidArgument invalid_floor, got %s %v", code, info)
+	}
+}
+
+func TestGRPCMaintenance(t *testing.T) {
+	first, _ := useBuildings(t)
+	savedLog := auditLog
+	auditLog = audit.New(nil)
+	defer func() { auditLog = savedLog }()
+	client := grpcClient(t)
+
+	_, err := client.DrainElevator(context.Background(), &rpc.ElevatorCommand{Building: 1, Elevator: 1})
+	if code, info := errorInfo(t, err); code != codes.Unauthenticated || info.Reason != "unauthorized" {
+		t.Errorf("Expected Unauthenticated without a key, got %s %v", code, info)
+	}
+	_, err = client.DrainElevator(withToken(testToken), &rpc.ElevatorCommand{Building: 2, Elevator: 0})
+	if code, _ := errorInfo(t, err); code != codes.PermissionDenied {
+		t.Errorf("Expected PermissionDenied for building 2, got %s", code)
+	}
+
+	first.RegisterHallCall(5, 1)
+	change, err := client.SetInService(withToken(testToken), &rpc.SetInServiceRequest{Building: 1, Elevator: 0, InService: false, Reason: "door sensor"})
+	if err != nil || change.Elevator.InService || change.ApprovalId != "" {
+		t.Fatalf("Elevator 0 should be out of service, got %v %v", change, err)
+	}
+	if first.GetElevator(0).InService {
+		t.Errorf("Elevator 0 should be out of service in the building too")
+	}
+	entries := auditLog.Entries(audit.Filter{Action: "takeElevatorOutOfService"})
+	if len(entries) != 1 || entries[0].Actor != "test" || entries[0].Reason != "door sensor" {
+		t.Errorf("Taking elevator 0 out should be audited, got %+v", entries)
+	}
+
+	change, err = client.SetInService(withToken(testToken), &rpc.SetInServiceRequest{Building: 1, Elevator: 0, InService: true})
+	if err != nil || !change.Elevator.InService {
+		t.Errorf("Elevator 0 should be back in service, got %v %v", change, err)
+	}
+	change, err = client.SetIndependentService(withToken(testToken), &rpc.SetIndependentServiceRequest{Building: 1, Elevator: 1, On: true})
+	if err != nil || change.Elevator.Mode != rpc.Mode_MODE_INDEPENDENT {
+		t.Errorf("Elevator 1 should be on independent service, got %v %v", change, err)
+	}
+
+	// a reset is parked for a second supervisor, who approves it over HTTP
+	change, err = client.ResetElevator(withToken(testToken), &rpc.ElevatorCommand{Building: 1, Elevator: 2, Reason: "stuck"})
+	if err != nil || change.ApprovalId == "" {
+		t.Fatalf("Reset should wait on approval, got %v %v", change, err)
+	}
+	first.RegisterCarCall(2, 8)
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("POST", "/v1/approvals/"+change.ApprovalId+"/approve", nil)
+	req.Header.Set("Authorization", "Bearer "+testApproverToken)
+	setupRouter().ServeHTTP(w, req)
+	if w.Code != http.StatusOK || len(first.GetElevator(2).CallList.Calls) != 0 {
+		t.Errorf("Elevator 2 should be reset once approved, got %d %s", w.Code, w.Body.String())
+	}
+	entries = auditLog.Entries(audit.Filter{Action: "resetElevator"})
+	if len(entries) != 1 || entries[0].Actor != "test" || entries[0].ApprovedBy != "approver" || entries[0].Reason != "stuck" {
+		t.Errorf("Reset should be down to test and approver, got %+v", entries)
+	}
+}
+
+func TestGRPCOutOfServiceDuringPeak(t *testing.T) {
+	first, _ := useBuildings(t)
+	client := grpcClient(t)
+	clock := approvals.clock
+	approvals.clock = func() time.Time { return time.Date(2024, 3, 1, 8, 30, 0, 0, time.Local) }
+	defer func() { approvals.clock = clock }()
+
+	change, err := client.SetInService(withToken(testToken), &rpc.SetInServiceRequest{Building: 1, Elevator: 1, InService: false})
+	if err != nil || change.ApprovalId == "" {
+		t.Fatalf("Out of service should wait on approval during peak, got %v %v", change, err)
+	}
+	if !first.GetElevator(1).InService {
+		t.Errorf("Elevator 1 should stay in service until approved")
+	}
+	if w := decideChange(setupRouter(), change.ApprovalId, "approve", testApproverToken); w.Code != http.StatusOK || first.GetElevator(1).InService {
+		t.Errorf("Elevator 1 should be out of service once approved, got %d %s", w.Code, w.Body.String())
+	}
+
+	change, err = client.DrainElevator(withToken(testToken), &rpc.ElevatorCommand{Building: 1, Elevator: 2, Reason: "door sensor"})
+	if err != nil || change.ApprovalId == "" || !first.GetElevator(2).InService {
+		t.Fatalf("Drain should wait on approval during peak, got %v %v", change, err)
+	}
+	if w := decideChange(setupRouter(), change.ApprovalId, "approve", testApproverToken); w.Code != http.StatusOK || first.GetElevator(2).InService {
+		t.Errorf("Elevator 2 should be drained once approved, got %d %s", w.Code, w.Body.String())
+	}
+}
+
+func TestGRPCWatch(t *testing.T) {
+	first, _ := useBuildings(t)
+	client := grpcClient(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	cars, err := client.WatchElevators(ctx, &rpc.WatchElevatorsRequest{Building: 1})
+	if err != nil {
+		t.Fatalf("Should watch the cars, got %s", err.Error())
+	}
+	state, err := cars.Recv()
+	if err != nil || len(state.Elevators) != 3 {
+		t.Fatalf("Should get all 3 cars to start with, got %v %v", state, err)
+	}
+	if _, err := first.RegisterCarCall(1, 6); err != nil {
+		t.Fatalf("Car call should be made, got %s", err.Error())
+	}
+	state, err = cars.Recv()
+	if err != nil || len(state.Elevators[1].Calls) != 1 {
+		t.Errorf("Should get elevator 1 with its call to 6, got %v %v", state, err)
+	}
+
+	ticket, err := first.RegisterHallCall(3, 1)
+	if err != nil {
+		t.Fatalf("Hall call should be made, got %s", err.Error())
+	}
+	calls, err := client.WatchCall(ctx, &rpc.GetCallRequest{Building: 1, Id: ticket.ID})
+	if err != nil {
+		t.Fatalf("Should watch the call, got %s", err.Error())
+	}
+	if got, err := calls.Recv(); err != nil || got.Status != rpc.CallStatus_CALL_STATUS_ASSIGNED {
+		t.Errorf("Call should start out assigned, got %v %v", got, err)
+	}
+	first.CancelHallCall(3, 1, "")
+	if got, err := calls.Recv(); err != nil || got.Status != rpc.CallStatus_CALL_STATUS_CANCELLED {
+		t.Errorf("Call should be cancelled, got %v %v", got, err)
+	}
+	if _, err := calls.Recv(); err != io.EOF {
+		t.Errorf("Stream should end once the call is done, got %v", err)
+	}
+}
+
+func TestGRPCCallsUnplaced(t *testing.T) {
+	first, _ := useBuildings(t)
+	client := grpcClient(t)
+
+	// only elevator 0 goes above floor 5
+	first.ConfigureElevator(1, building.ElevatorSpec{ServedFloors: []int{1, 2, 3, 4, 5}})
+	first.ConfigureElevator(2, building.ElevatorSpec{ServedFloors: []int{1, 2, 3, 4, 5}})
+	first.RegisterHallCall(8, -1)
+	first.RegisterHallCall(3, 1)
+	first.ReassignHallCall(3, 1, 0)
+
+	_, err := client.SetInService(withToken(testToken), &rpc.SetInServiceRequest{Building: 1, Elevator: 0, InService: false})
+	code, info := errorInfo(t, err)
+	if code != codes.FailedPrecondition || info.Reason != "calls_unplaced" {
+		t.Fatalf("Expected FailedPrecondition calls_unplaced, got %s %v", code, info)
+	}
+	var change *rpc.CarChange
+	for _, d := range status.Convert(err).Details() {
+		if c, ok := d.(*rpc.CarChange); ok {
+			change = c
+		}
+	}
+	if change == nil || change.Elevator.InService || len(change.Reassigned) != 2 {
+		t.Fatalf("Error should carry elevator 0 and both hall calls, got %v", change)
+	}
+	for _, r := range change.Reassigned {
+		if r.Call.Floor == 3 && (r.Elevator != 1 && r.Elevator != 2) || r.Call.Floor == 8 && r.Elevator != -1 {
+			t.Errorf("Floor 3 should go to another car and floor 8 nowhere, got %v", r)
+		}
+	}
+}
+
+func TestGRPCFireServiceAndEmergencyPower(t *testing.T) {
+	first, second := useBuildings(t)
+	first.SetFireServiceKey("firekey")
+	client := grpcClient(t)
+
+	_, err := client.ActivateFireRecall(context.Background(), &rpc.BuildingCommand{Building: 1})
+	if code, _ := errorInfo(t, err); code != codes.Unauthenticated || first.FireRecall {
+		t.Errorf("Fire recall without an API key should be refused, got %s", code)
+	}
+	_, err = client.ActivateEmergencyPower(withToken(testToken), &rpc.ActivateEmergencyPowerRequest{Building: 2, RunningCars: 1})
+	if code, _ := errorInfo(t, err); code != codes.PermissionDenied || second.EmergencyPower {
+		t.Errorf("Emergency power in another building should be refused, got %s", code)
+	}
+
+	if d, err := client.SetSmokeDetector(withToken(testToken), &rpc.SetSmokeDetectorRequest{Building: 1, Floor: 3, Active: true}); err != nil || !d.Active {
+		t.Errorf("Smoke detector on floor 3 should be set, got %v %v", d, err)
+	}
+	recall, err := client.ActivateFireRecall(withToken(testToken), &rpc.BuildingCommand{Building: 1, Reason: "alarm"})
+	if err != nil || !recall.Active || recall.RecallFloor != 1 || !first.FireRecall {
+		t.Fatalf("Fire recall should send the cars to floor 1, got %v %v", recall, err)
+	}
+
+	// Phase II is on the fire key alone
+	fireKey := metadata.AppendToOutgoingContext(context.Background(), "x-fire-service-key", "firekey")
+	_, err = client.SetFirefighterService(context.Background(), &rpc.SetFirefighterServiceRequest{Building: 1, Elevator: 0, On: true})
+	if code, info := errorInfo(t, err); code != codes.PermissionDenied || info.Reason != "not_authorized" {
+		t.Errorf("Firefighter service without the fire key should be refused, got %s %v", code, info)
+	}
+	e, err := client.SetFirefighterService(fireKey, &rpc.SetFirefighterServiceRequest{Building: 1, Elevator: 0, On: true})
+	if err != nil || e.Mode != rpc.Mode_MODE_FIREFIGHTER {
+		t.Fatalf("Elevator 0 should be on firefighter service, got %v %v", e, err)
+	}
+	// let go of the door open button to close the doors from the recall
+	if e, err := client.SetFirefighterDoor(fireKey, &rpc.SetFirefighterDoorRequest{Building: 1, Elevator: 0, Pressed: false}); err != nil || e.DoorsOpen {
+		t.Errorf("Elevator 0 should close its doors, got %v %v", e, err)
+	}
+	if e, err := client.FirefighterCall(fireKey, &rpc.FirefighterCallRequest{Building: 1, Elevator: 0, Floor: 6}); err != nil || len(e.Calls) != 1 {
+		t.Errorf("Elevator 0 should have a call to 6, got %v %v", e, err)
+	}
+	client.SetFirefighterService(fireKey, &rpc.SetFirefighterServiceRequest{Building: 1, Elevator: 0, On: false})
+	if _, err := client.ResetFireRecall(withToken(testToken), &rpc.BuildingCommand{Building: 1}); err != nil || first.FireRecall {
+		t.Errorf("Fire recall should be reset, got %v", err)
+	}
+
+	power, err := client.ActivateEmergencyPower(withToken(testToken), &rpc.ActivateEmergencyPowerRequest{Building: 1, RunningCars: 1})
+	if err != nil || !power.Active || power.RunningCars != 1 {
+		t.Fatalf("Building 1 should be on emergency power, got %v %v", power, err)
+	}
+	power, err = client.SetEmergencyPowerCars(withToken(testToken), &rpc.SetEmergencyPowerCarsRequest{Building: 1, Elevators: []int32{2}})
+	if err != nil || len(power.Selected) != 1 || power.Selected[0] != 2 {
+		t.Errorf("Elevator 2 should be the one to run, got %v %v", power, err)
+	}
+	if power, err := client.GetEmergencyPower(context.Background(), &rpc.GetEmergencyPowerRequest{Building: 1}); err != nil || !power.Active {
+		t.Errorf("Anyone should see the building is on emergency power, got %v %v", power, err)
+	}
+	if power, err := client.EndEmergencyPower(withToken(testToken), &rpc.BuildingCommand{Building: 1}); err != nil || power.Active {
+		t.Errorf("Emergency power should be over, got %v %v", power, err)
+	}
+}
+
+func TestGRPCPriorityCallsAndMaintenance(t *testing.T) {
+	first, _ := useBuildings(t)
+	first.SetPriorityCallKey("prioritykey")
+	client := grpcClient(t)
+	router := setupRouter()
+
+	priorityKey := metadata.AppendToOutgoingContext(context.Background(), "x-priority-key", "prioritykey")
+	record, err := client.PriorityCall(priorityKey, &rpc.PriorityCallRequest{Building: 1, Floor: 2, Destination: 9, RequestedBy: "er"})
+	if err != nil || !record.Authorized || record.Elevator < 0 {
+		t.Fatalf("Priority call should get a car, got %v %v", record, err)
+	}
+	if _, err := client.PriorityCall(context.Background(), &rpc.PriorityCallRequest{Building: 1, Floor: 2, Destination: 9, RequestedBy: "er"}); err == nil {
+		t.Errorf("Priority call without the key should be refused")
+	}
+	calls, err := client.GetPriorityCallLog(withToken(testToken), &rpc.GetPriorityCallLogRequest{Building: 1})
+	if err != nil || len(calls.Records) != 2 || calls.Records[1].Authorized {
+		t.Errorf("Both priority calls should be logged, got %v %v", calls, err)
+	}
+
+	// overnight needs no approval, an afternoon into the evening peak does
+	tomorrow := time.Now().AddDate(0, 0, 1)
+	start := time.Date(tomorrow.Year(), tomorrow.Month(), tomorrow.Day(), 1, 0, 0, 0, time.Local)
+	booking, err := client.ScheduleMaintenance(withToken(testToken), &rpc.ScheduleMaintenanceRequest{
+		Building: 1, Elevator: 1, Start: start.UnixNano(), End: start.Add(time.Hour).UnixNano(), Reason: "cable swap", Technician: "pat"})
+	if err != nil || booking.Window.Id == 0 || booking.ApprovalId != "" {
+		t.Fatalf("Overnight window should be booked, got %v %v", booking, err)
+	}
+	start = start.Add(14 * time.Hour)
+	booking, err = client.ScheduleMaintenance(withToken(testToken), &rpc.ScheduleMaintenanceRequest{
+		Building: 1, Elevator: 2, Start: start.UnixNano(), End: start.Add(2 * time.Hour).UnixNano(), Reason: "doors", Technician: "pat"})
+	if err != nil || booking.ApprovalId == "" {
+		t.Fatalf("Window into peak hours should wait on approval, got %v %v", booking, err)
+	}
+	if w := decideChange(router, booking.ApprovalId, "approve", testApproverToken); w.Code != http.StatusOK {
+		t.Errorf("Window should be booked once approved, got %d %s", w.Code, w.Body.String())
+	}
+	windows, err := client.GetMaintenanceWindows(withToken(testToken), &rpc.GetMaintenanceWindowsRequest{Building: 1})
+	if err != nil || len(windows.Windows) != 2 {
+		t.Errorf("Both windows should be booked, got %v %v", windows, err)
+	}
+
+	pending, err := client.SetAgingPolicy(withToken(testToken), &rpc.SetAgingPolicyRequest{Building: 1, Policy: &rpc.AgingPolicy{MaxWait: int64(90 * time.Second), Weight: 2}})
+	if err != nil || pending.ApprovalId == "" || pending.Action != "agingPolicy" {
+		t.Fatalf("Aging policy should wait on approval, got %v %v", pending, err)
+	}
+	if w := decideChange(router, pending.ApprovalId, "approve", testApproverToken); w.Code != http.StatusOK {
+		t.Errorf("Aging policy should be set once approved, got %d %s", w.Code, w.Body.String())
+	}
+	policy, err := client.GetAgingPolicy(withToken(testToken), &rpc.GetAgingPolicyRequest{Building: 1})
+	if err != nil || policy.MaxWait != int64(90*time.Second) || policy.Weight != 2 {
+		t.Errorf("Aging policy should be 90s weight 2, got %v %v", policy, err)
+	}
+}
+
+func TestGRPCCommissioning(t *testing.T) {
+	first, _ := useBuildings(t)
+	client := grpcClient(t)
+
+	e, err := client.CommissionElevator(withToken(testToken), &rpc.CommissionElevatorRequest{Building: 1, ServedFloors: []int32{1, 10}, Capacity: 20})
+	if err != nil || e.Id != 3 || len(e.ServedFloors) != 2 || first.GetElevator(3) == nil {
+		t.Fatalf("Elevator 3 should be commissioned, got %v %v", e, err)
+	}
+	id := int32(7)
+	if e, err := client.CommissionElevator(withToken(testToken), &rpc.CommissionElevatorRequest{Building: 1, Elevator: &id}); err != nil || e.Id != 7 {
+		t.Errorf("Elevator 7 should be commissioned, got %v %v", e, err)
+	}
+
+	_, err = client.DecommissionElevator(withToken(testToken), &rpc.ElevatorCommand{Building: 1, Elevator: 3})
+	if code, _ := errorInfo(t, err); code != codes.FailedPrecondition {
+		t.Errorf("Elevator 3 is in service and should not be decommissioned, got %s", code)
+	}
+	first.SetElevatorInServiceStatus(3, false)
+	if e, err := client.DecommissionElevator(withToken(testToken), &rpc.ElevatorCommand{Building: 1, Elevator: 3}); err != nil || e.Id != 3 || first.GetElevator(3) != nil {
+		t.Errorf("Elevator 3 should be decommissioned, got %v %v", e, err)
+	}
+
+	b, err := client.ExtendFloors(withToken(testToken), &rpc.ExtendFloorsRequest{Building: 1, Floors: 12})
+	if err != nil || b.Floors != 12 || len(b.Elevators) != 4 {
+		t.Errorf("Building 1 should have 12 floors and 4 cars, got %v %v", b, err)
+	}
+}
@@ -0,0 +1,44 @@
+package main
+
+import (
+	_ "embed"
+	"encoding/json"
+	"net/http"
+	"sync"
+
+	"github.com/gin-gonic/gin"
+	"gopkg.in/yaml.v3"
+)
+
+// the spec is kept as YAML so it can carry comments, and served as JSON
+//
+//go:embed openapi.yaml
+var openAPIYAML []byte
+
+var (
+	openAPIOnce sync.Once
+	openAPIJSON []byte
+	openAPIErr  error
+)
+
+// loadOpenAPI turns the embedded spec into JSON, once
+func loadOpenAPI() ([]byte, error) {
+	openAPIOnce.Do(func() {
+		var spec map[string]interface{}
+		if openAPIErr = yaml.Unmarshal(openAPIYAML, &spec); openAPIErr != nil {
+			return
+		}
+		openAPIJSON, openAPIErr = json.Marshal(spec)
+	})
+	return openAPIJSON, openAPIErr
+}
+
+// the OpenAPI document for every route the server answers
+func GetOpenAPI(c *gin.Context) {
+	b, err := loadOpenAPI()
+	if err != nil {
+		handleError(c, "openapi", err)
+		return
+	}
+	c.Data(http.StatusOK, "application/json", b)
+}
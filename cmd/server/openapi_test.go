@@ -0,0 +1,75 @@
+package main
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"strings"
+	"testing"
+)
+
+type openAPISpec struct {
+	OpenAPI    string                                `json:"openapi"`
+	Paths      map[string]map[string]json.RawMessage `json:"paths"`
+	Components struct {
+		Parameters map[string]json.RawMessage `json:"parameters"`
+		Responses  map[string]json.RawMessage `json:"responses"`
+		Schemas    map[string]json.RawMessage `json:"schemas"`
+	} `json:"components"`
+}
+
+func getOpenAPI(t *testing.T) (openAPISpec, []byte) {
+	t.Helper()
+	router := setupRouter()
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/openapi.json", nil)
+	router.ServeHTTP(w, req)
+	if w.Code != http.StatusOK {
+		t.Fatalf("Expected a 200 for the spec, got %d %s", w.Code, w.Body.String())
+	}
+	var spec openAPISpec
+	if err := json.Unmarshal(w.Body.Bytes(), &spec); err != nil {
+		t.Fatalf("Spec should be JSON, got %s", err.Error())
+	}
+	return spec, w.Body.Bytes()
+}
+
+var ginParam = regexp.MustCompile(`:([A-Za-z]+)`)
+
+// every route gin knows about has to be in the spec
+func TestOpenAPICoversRoutes(t *testing.T) {
+	spec, _ := getOpenAPI(t)
+	if !strings.HasPrefix(spec.OpenAPI, "3.") {
+		t.Errorf("Spec should be OpenAPI 3, got %s", spec.OpenAPI)
+	}
+	for _, route := range setupRouter().Routes() {
+		path := ginParam.ReplaceAllString(route.Path, "{$1}")
+		if len(path) > 1 {
+			path = strings.TrimSuffix(path, "/")
+		}
+		if _, ok := spec.Paths[path][strings.ToLower(route.Method)]; !ok {
+			t.Errorf("Spec should describe %s %s", route.Method, path)
+		}
+	}
+}
+
+// and every $ref in the spec has to point at something
+func TestOpenAPIRefs(t *testing.T) {
+	spec, raw := getOpenAPI(t)
+	refs := regexp.MustCompile(`"\$ref":"#/components/(parameters|responses|schemas)/([A-Za-z]+)"`)
+	for _, m := range refs.FindAllStringSubmatch(string(raw), -1) {
+		var ok bool
+		switch m[1] {
+		case "parameters":
+			_, ok = spec.Components.Parameters[m[2]]
+		case "responses":
+			_, ok = spec.Components.Responses[m[2]]
+		case "schemas":
+			_, ok = spec.Components.Schemas[m[2]]
+		}
+		if !ok {
+			t.Errorf("Spec should define %s %s", m[1], m[2])
+		}
+	}
+}
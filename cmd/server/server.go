@@ -3,43 +3,50 @@ package main
 import (
 	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
+	"os"
 	"strconv"
+	"strings"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	log "github.com/sirupsen/logrus"
+	"github.com/tcotav/elevatormgr/audit"
 	"github.com/tcotav/elevatormgr/building"
+	"github.com/tcotav/elevatormgr/config"
+	"github.com/tcotav/elevatormgr/elevator"
 )
 
 // mock this up for now
 var bld *building.Building = building.NewBuilding(1, 10, 3) 
 
-// specific error logging and sets the HTTP response + 400 code
-func handleBadRequest(c *gin.Context, source string, err error) {
-	log.Error(fmt.Sprintf("%s - %s", source, err.Error()))
-	c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
-}
-
 // in an elevator car, push the button to go to a floor
 func PushDestination(c *gin.Context) {
 	errloc := "pushdest"	
 	elevatorID, err := strconv.Atoi(c.Param("elevator"))
 	if err != nil {
-		handleBadRequest(c, errloc, err)
+		handleError(c, errloc, err)
 		return
 	}
 
 	floor, err := strconv.Atoi(c.Param("floor"))
 	if err != nil {
-		handleBadRequest(c, errloc, err)
+		handleError(c, errloc, err)
 		return
 	}
-	err = bld.PushDestinationButton(elevatorID, floor)
+	ticket, err := bld.RegisterCarCall(elevatorID, floor)
 	if err != nil {
-		handleBadRequest(c, errloc, err)
+		handleError(c, errloc, err)
 		return
 	}
 	log.Info(fmt.Sprintf("Elevator %d was called to floor %d.", elevatorID, floor))
+	b, err := json.Marshal(map[string]interface{}{"elevator": elevatorID, "call": ticket.ID})
+	if err != nil {
+		handleError(c, errloc, err)
+		return
+	}
+	c.Data(http.StatusOK, "application/json", b)
 }
 
 // push the call button, up or down, on a floor
@@ -47,53 +54,258 @@ func CallElevator(c *gin.Context) {
 	errloc := "callelev"
 	floor, err := strconv.Atoi(c.Param("floor"))
 	if err != nil {
-		handleBadRequest(c, errloc, err)
+		handleError(c, errloc, err)
 		return
 	}
 	direction, err := strconv.Atoi(c.Param("direction"))
 	if err != nil {
-		handleBadRequest(c, errloc, err)
+		handleError(c, errloc, err)
 		return
 	}
-	elevatorID, err := bld.CallElevator(floor, direction)
+	ticket, err := bld.RegisterHallCall(floor, direction)
 	if err != nil {
-		handleBadRequest(c, errloc, err)
+		handleError(c, errloc, err)
 		return
 	}
+	elevatorID := ticket.ElevatorID
 	log.Info(fmt.Sprintf("Elevator %d was called to floor %d in direction %d.", elevatorID, floor, direction))
-	b, err := json.Marshal(map[string]int{"elevator": elevatorID})
+	b, err := json.Marshal(map[string]interface{}{"elevator": elevatorID, "call": ticket.ID})
+	if err != nil {
+		handleError(c, "callelev", err)
+		return
+	}
+	c.Data(http.StatusOK, "application/json", b)
+}
+
+// look up a call by the ID handed out when it was made
+func GetCall(c *gin.Context) {
+	ticket, err := requestBuilding(c).GetTicket(c.Param("id"))
+	if err != nil {
+		handleError(c, "getcall", err)
+		return
+	}
+	b, err := json.Marshal(ticket)
+	if err != nil {
+		handleError(c, "getcall", err)
+		return
+	}
+	c.Data(http.StatusOK, "application/json", b)
+}
+
+// stream a call's status as server sent events until it's done
+func StreamCall(c *gin.Context) {
+	bd := requestBuilding(c)
+	ticketID := c.Param("id")
+	// subscribe first so nothing slips by between the lookup and the stream
+	events, unsubscribe := bd.Subscribe(20)
+	defer unsubscribe()
+	ticket, err := bd.GetTicket(ticketID)
+	if err != nil {
+		handleError(c, "streamcall", err)
+		return
+	}
+	c.SSEvent("call", ticket)
+	if ticket.Status.Done() {
+		return
+	}
+	c.Stream(func(w io.Writer) bool {
+		select {
+		case ev, ok := <-events:
+			if !ok {
+				return false
+			}
+			if ev.TicketID != ticketID {
+				return true
+			}
+			ticket, err := bd.GetTicket(ticketID)
+			if err != nil {
+				return false
+			}
+			c.SSEvent("call", ticket)
+			return !ticket.Status.Done()
+		case <-c.Request.Context().Done():
+			return false
+		}
+	})
+}
+
+// priority hall call -- needs the priority key in the X-Priority-Key header,
+// who placed it goes in X-Requested-By and why in the reason query param
+func PriorityCall(c *gin.Context) {
+	errloc := "prioritycall"
+	floor, err := strconv.Atoi(c.Param("floor"))
+	if err != nil {
+		handleError(c, errloc, err)
+		return
+	}
+	destination, err := strconv.Atoi(c.Param("destination"))
+	if err != nil {
+		handleError(c, errloc, err)
+		return
+	}
+	record, err := bld.PriorityCall(floor, destination, c.GetHeader("X-Priority-Key"), c.GetHeader("X-Requested-By"), c.Query("reason"))
+	if err != nil {
+		handleError(c, errloc, err)
+		return
+	}
+	log.Warn(fmt.Sprintf("Priority call: elevator %d sent from floor %d to floor %d for %s.", record.ElevatorID, floor, destination, record.RequestedBy))
+	b, err := json.Marshal(record)
+	if err != nil {
+		handleError(c, errloc, err)
+		return
+	}
+	c.Data(http.StatusOK, "application/json", b)
+}
+
+// get the priority call audit trail
+func GetPriorityCallLog(c *gin.Context) {
+	b, err := json.Marshal(requestBuilding(c).GetPriorityCallLog())
 	if err != nil {
-		handleBadRequest(c, "callelev", err)
+		handleError(c, "prioritycalllog", err)
 		return
 	}
 	c.Data(http.StatusOK, "application/json", b)
 }
 
+// cancel a hall call -- a double tap on the hall button or a maintenance cleanup
+func CancelHallCall(c *gin.Context) {
+	errloc := "cancelhallcall"
+	floor, err := strconv.Atoi(c.Param("floor"))
+	if err != nil {
+		handleError(c, errloc, err)
+		return
+	}
+	direction, err := strconv.Atoi(c.Param("direction"))
+	if err != nil {
+		handleError(c, errloc, err)
+		return
+	}
+	err = bld.CancelHallCall(floor, direction, c.Query("reason"))
+	if err != nil {
+		handleError(c, errloc, err)
+		return
+	}
+	log.Info(fmt.Sprintf("Hall call at floor %d direction %d was cancelled.", floor, direction))
+}
+
+// cancel a car call -- a double tap on the floor button in the car
+func CancelCarCall(c *gin.Context) {
+	errloc := "cancelcarcall"
+	elevatorID, err := strconv.Atoi(c.Param("elevator"))
+	if err != nil {
+		handleError(c, errloc, err)
+		return
+	}
+	floor, err := strconv.Atoi(c.Param("floor"))
+	if err != nil {
+		handleError(c, errloc, err)
+		return
+	}
+	err = bld.CancelCarCall(elevatorID, floor, c.Query("reason"))
+	if err != nil {
+		handleError(c, errloc, err)
+		return
+	}
+	log.Info(fmt.Sprintf("Elevator %d call to floor %d was cancelled.", elevatorID, floor))
+}
+
 // get all elevators' state
 func GetAllElevatorState(c *gin.Context) {
-	state, err := bld.GetAllElevatorState()
+	state, err := requestBuilding(c).GetAllElevatorState()
 	if err != nil {
-		handleBadRequest(c,"getallstate", err)
+		handleError(c,"getallstate", err)
 		return
 	}
 	c.Data(http.StatusOK, "application/json", state)
 }
 
+// get the outstanding hall calls -- the lit hall lanterns -- and which car has each
+func GetHallCalls(c *gin.Context) {
+	b, err := json.Marshal(requestBuilding(c).GetHallCalls())
+	if err != nil {
+		handleError(c, "gethallcalls", err)
+		return
+	}
+	c.Data(http.StatusOK, "application/json", b)
+}
+
+// move an outstanding hall call to a different elevator
+func ReassignHallCall(c *gin.Context) {
+	errloc := "reassignhallcall"
+	floor, err := strconv.Atoi(c.Param("floor"))
+	if err != nil {
+		handleError(c, errloc, err)
+		return
+	}
+	direction, err := strconv.Atoi(c.Param("direction"))
+	if err != nil {
+		handleError(c, errloc, err)
+		return
+	}
+	elevatorID, err := strconv.Atoi(c.Param("elevator"))
+	if err != nil {
+		handleError(c, errloc, err)
+		return
+	}
+	if dryRun(c) {
+		previewCommand(c, errloc, func(sim *building.Building) error {
+			return sim.ReassignHallCall(floor, direction, elevatorID)
+		})
+		return
+	}
+	before := auditState(bld, -1)
+	err = bld.ReassignHallCall(floor, direction, elevatorID)
+	if err != nil {
+		handleError(c, errloc, err)
+		return
+	}
+	log.Info(fmt.Sprintf("Hall call at floor %d direction %d was moved to elevator %d.", floor, direction, elevatorID))
+	recordAudit(c, "reassignHallCall", -1, before, c.Query("reason"), nil)
+}
+
 // reset the elevator -- i.e. call it down to floor 1 and clear its call list
 func ResetElevator(c *gin.Context) {
 	errloc := "resetelev"
 	elevatorID, err := strconv.Atoi(c.Param("elevator"))
 	if err != nil {
-		handleBadRequest(c, errloc, err)
+		handleError(c, errloc, err)
 		return
 	}
-	callsImpacted, err := bld.ResetElevator(elevatorID)
-	if err != nil {
-		handleBadRequest(c, errloc, err)
+	if dryRun(c) {
+		previewCommand(c, errloc, func(sim *building.Building) error {
+			// a reset goes ahead even when some calls can't be placed
+			if _, applied, err := sim.ResetElevator(elevatorID); !applied {
+				return err
+			}
+			return nil
+		})
+		return
+	}
+	before := auditState(bld, elevatorID)
+	reassigned, applied, err := bld.ResetElevator(elevatorID)
+	if !applied {
+		handleError(c, errloc, err)
 		return
 	}
-	log.Info(fmt.Sprintf("Elevator %d was reset. %d calls were impacted.", elevatorID, callsImpacted))
+	log.Info(fmt.Sprintf("Elevator %d was reset. %d hall calls were impacted.", elevatorID, len(reassigned)))
+	recordAudit(c, "resetElevator", elevatorID, before, c.Query("reason"), err)
+	respondReassigned(c, errloc, reassigned, err)
+}
 
+// respondReassigned sends back where a car's hall calls went.  The car change
+// has already happened, so calls that couldn't be placed are a 409 not a 400.
+func respondReassigned(c *gin.Context, source string, reassigned []building.Reassignment, err error) {
+	if err != nil {
+		log.Error(fmt.Sprintf("%s - %s", source, err.Error()))
+		c.JSON(http.StatusConflict, unplacedBody{newErrorBody(c, "calls_unplaced", err), reassigned})
+		return
+	}
+	b, err := json.Marshal(map[string]interface{}{"reassigned": reassigned})
+	if err != nil {
+		handleError(c, source, err)
+		return
+	}
+	c.Data(http.StatusOK, "application/json", b)
 }
 
 // have gin log in json format
@@ -119,32 +331,314 @@ func ElevatorOutOfService(c *gin.Context) {
 	errloc := "outofservice"
 	elevatorID, err := strconv.Atoi(c.Param("elevator"))
 	if err != nil {
-		handleBadRequest(c, errloc, err)
+		handleError(c, errloc, err)
 		return
 	}
-	err = bld.SetElevatorInServiceStatus(elevatorID, false)
-	if err != nil {
-		handleBadRequest(c, errloc, err)
+	if dryRun(c) {
+		previewCommand(c, errloc, func(sim *building.Building) error {
+			// calls that can't be placed don't stop the change
+			if _, applied, err := sim.SetElevatorInServiceStatus(elevatorID, false); !applied {
+				return err
+			}
+			return nil
+		})
 		return
 	}
-	log.Info(fmt.Sprintf("Elevator %d was taken out of service.", elevatorID))
+	before := auditState(bld, elevatorID)
+	reassigned, applied, err := bld.SetElevatorInServiceStatus(elevatorID, false)
+	if !applied {
+		handleError(c, errloc, err)
+		return
+	}
+	log.Info(fmt.Sprintf("Elevator %d was taken out of service. %d hall calls were impacted.", elevatorID, len(reassigned)))
+	recordAudit(c, "takeElevatorOutOfService", elevatorID, before, c.Query("reason"), err)
+	respondReassigned(c, errloc, reassigned, err)
 }
 
 
+// drain elevator -- hand off its hall calls, finish its car calls, then go out of service
+func DrainElevator(c *gin.Context) {
+	errloc := "drainelev"
+	elevatorID, err := strconv.Atoi(c.Param("elevator"))
+	if err != nil {
+		handleError(c, errloc, err)
+		return
+	}
+	if dryRun(c) {
+		previewCommand(c, errloc, func(sim *building.Building) error {
+			_, err := sim.DrainElevator(elevatorID)
+			return err
+		})
+		return
+	}
+	before := auditState(bld, elevatorID)
+	reassigned, err := bld.DrainElevator(elevatorID)
+	if err != nil {
+		handleError(c, errloc, err)
+		return
+	}
+	log.Info(fmt.Sprintf("Elevator %d is draining. %d hall calls were reassigned.", elevatorID, len(reassigned)))
+	recordAudit(c, "drainElevator", elevatorID, before, c.Query("reason"), nil)
+	respondReassigned(c, errloc, reassigned, nil)
+}
+
 // put elevator back in service
 func ElevatorBackInService(c *gin.Context) {
 	errloc := "backinservice"
 	elevatorID, err := strconv.Atoi(c.Param("elevator"))
 	if err != nil {
-		handleBadRequest(c, errloc, err)
+		handleError(c, errloc, err)
 		return
 	}
-	err = bld.SetElevatorInServiceStatus(elevatorID, true)
+	if dryRun(c) {
+		previewCommand(c, errloc, func(sim *building.Building) error {
+			_, _, err := sim.SetElevatorInServiceStatus(elevatorID, true)
+			return err
+		})
+		return
+	}
+	before := auditState(bld, elevatorID)
+	_, _, err = bld.SetElevatorInServiceStatus(elevatorID, true)
 	if err != nil {
-		handleBadRequest(c, errloc, err)
+		handleError(c, errloc, err)
 		return
 	}
 	log.Info(fmt.Sprintf("Elevator %d was put back in service.", elevatorID))
+	recordAudit(c, "elevatorBackInService", elevatorID, before, c.Query("reason"), nil)
+}
+
+// put an elevator on independent service or back in group service
+func IndependentService(c *gin.Context) {
+	errloc := "independentservice"
+	elevatorID, err := strconv.Atoi(c.Param("elevator"))
+	if err != nil {
+		handleError(c, errloc, err)
+		return
+	}
+	on, err := strconv.ParseBool(c.Param("on"))
+	if err != nil {
+		handleError(c, errloc, err)
+		return
+	}
+	if dryRun(c) {
+		previewCommand(c, errloc, func(sim *building.Building) error {
+			return sim.SetIndependentService(elevatorID, on)
+		})
+		return
+	}
+	before := auditState(bld, elevatorID)
+	err = bld.SetIndependentService(elevatorID, on)
+	if err != nil {
+		handleError(c, errloc, err)
+		return
+	}
+	log.Info(fmt.Sprintf("Elevator %d independent service set to %t.", elevatorID, on))
+	recordAudit(c, "independentService", elevatorID, before, c.Query("reason"), nil)
+}
+
+// get the floors and what they're called
+func GetFloors(c *gin.Context) {
+	c.JSON(http.StatusOK, requestBuilding(c).GetFloors())
+}
+
+// get how many cars in each bank are taking calls
+func GetCapacity(c *gin.Context) {
+	c.JSON(http.StatusOK, requestBuilding(c).GetCapacity())
+}
+
+// set how cars pick their next stop, maxwait is a duration like 90s
+func SetAgingPolicy(c *gin.Context) {
+	errloc := "agingpolicy"
+	maxWait, err := time.ParseDuration(c.Param("maxwait"))
+	if err != nil {
+		handleError(c, errloc, err)
+		return
+	}
+	weight, err := strconv.ParseFloat(c.Param("weight"), 64)
+	if err != nil {
+		handleError(c, errloc, err)
+		return
+	}
+	policy := elevator.AgingPolicy{MaxWait: maxWait, Weight: weight}
+	if dryRun(c) {
+		previewCommand(c, errloc, func(sim *building.Building) error {
+			return sim.SetAgingPolicy(policy)
+		})
+		return
+	}
+	before, _ := json.Marshal(bld.GetAgingPolicy())
+	err = bld.SetAgingPolicy(policy)
+	if err != nil {
+		handleError(c, errloc, err)
+		return
+	}
+	log.Info(fmt.Sprintf("Aging policy set to max wait %s weight %g.", maxWait, weight))
+	recordAudit(c, "agingPolicy", -1, before, c.Query("reason"), nil)
+}
+
+func GetAgingPolicy(c *gin.Context) {
+	b, err := json.Marshal(requestBuilding(c).GetAgingPolicy())
+	if err != nil {
+		handleError(c, "agingpolicy", err)
+		return
+	}
+	c.Data(http.StatusOK, "application/json", b)
+}
+
+// body for scheduling a maintenance window, times are RFC3339
+type maintenanceWindowRequest struct {
+	Elevator   int       `json:"elevator"`
+	Start      time.Time `json:"start" binding:"required"`
+	End        time.Time `json:"end" binding:"required"`
+	Reason     string    `json:"reason" binding:"required"`
+	Technician string    `json:"technician" binding:"required"`
+}
+
+// book a maintenance window for an elevator
+func ScheduleMaintenance(c *gin.Context) {
+	bd := requestBuilding(c)
+	errloc := "schedulemaint"
+	var body maintenanceWindowRequest
+	if err := c.ShouldBindJSON(&body); err != nil {
+		handleError(c, errloc, err)
+		return
+	}
+	window := building.MaintenanceWindow{
+		ElevatorID: body.Elevator,
+		Start:      body.Start,
+		End:        body.End,
+		Reason:     body.Reason,
+		Technician: body.Technician,
+	}
+	if dryRun(c) {
+		// the preview is of the booking, the window itself hasn't started
+		var w building.MaintenanceWindow
+		p, err := bd.Preview(func(sim *building.Building) error {
+			var err error
+			w, err = sim.ScheduleMaintenance(window)
+			return err
+		})
+		if err != nil {
+			handleError(c, errloc, err)
+			return
+		}
+		c.JSON(http.StatusOK, gin.H{"dryRun": true, "preview": p, "window": w})
+		return
+	}
+	w, err := bd.ScheduleMaintenance(window)
+	if err != nil {
+		handleError(c, errloc, err)
+		return
+	}
+	log.Info(fmt.Sprintf("Maintenance window %d scheduled for elevator %d from %s to %s by %s.", w.ID, w.ElevatorID, w.Start, w.End, w.Technician))
+	recordAudit(c, "scheduleMaintenance", w.ElevatorID, nil, w.Reason, nil)
+	b, err := json.Marshal(w)
+	if err != nil {
+		handleError(c, errloc, err)
+		return
+	}
+	c.Data(http.StatusOK, "application/json", b)
+}
+
+// list the current and upcoming maintenance windows
+func GetMaintenanceWindows(c *gin.Context) {
+	b, err := json.Marshal(requestBuilding(c).GetMaintenanceWindows(time.Now()))
+	if err != nil {
+		handleError(c, "maintwindows", err)
+		return
+	}
+	c.Data(http.StatusOK, "application/json", b)
+}
+
+// runMaintenanceSchedule starts and ends maintenance windows as they come due
+func runMaintenanceSchedule(interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for now := range ticker.C {
+		for _, b := range allBuildings() {
+			started, ended, err := b.ApplyMaintenanceSchedule(now)
+			if err != nil {
+				log.Error(fmt.Sprintf("maintschedule - %s", err.Error()))
+			}
+			for _, w := range ended {
+				recordScheduledAudit(b, "maintenanceWindowEnd", w)
+			}
+			for _, w := range started {
+				recordScheduledAudit(b, "maintenanceWindowStart", w)
+			}
+		}
+	}
+}
+
+// runHallCallOptimizer moves hall calls to cars that can answer them sooner
+func runHallCallOptimizer(interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for range ticker.C {
+		for _, b := range allBuildings() {
+			b.OptimizeHallCalls()
+		}
+	}
+}
+
+// runCallWatchdog escalates hall calls that have waited too long
+func runCallWatchdog(interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for range ticker.C {
+		for _, b := range allBuildings() {
+			b.CheckOverdueCalls()
+		}
+	}
+}
+
+// logEvents writes the building's events to the log, overdue calls are
+// logged as warnings so they get picked up by alerting
+func logEvents(b *building.Building) {
+	events, _ := b.Subscribe(100)
+	for ev := range events {
+		entry := log.WithFields(log.Fields{
+			"event":     ev.Type,
+			"building":  ev.BuildingID,
+			"floor":     ev.Floor,
+			"direction": ev.Direction,
+			"elevator":  ev.ElevatorID,
+			"from":      ev.FromElevatorID,
+			"call":      ev.TicketID,
+			"status":    ev.Status,
+		})
+		if ev.Type == building.EventCallOverdue {
+			entry.Warn(ev.Reason)
+		} else {
+			entry.Info(ev.Reason)
+		}
+	}
+}
+
+// get the hall calls that have waited too long
+func GetOverdueCalls(c *gin.Context) {
+	b, err := json.Marshal(requestBuilding(c).GetOverdueCalls())
+	if err != nil {
+		handleError(c, "overduecalls", err)
+		return
+	}
+	c.Data(http.StatusOK, "application/json", b)
+}
+
+// health check -- 200 when ok, 503 when degraded so load balancers and
+// monitoring can act on the status code alone
+func GetHealth(c *gin.Context) {
+	health := requestBuilding(c).GetHealth()
+	b, err := json.Marshal(health)
+	if err != nil {
+		handleError(c, "health", err)
+		return
+	}
+	status := http.StatusOK
+	if health.Status != "ok" {
+		status = http.StatusServiceUnavailable
+	}
+	c.Data(status, "application/json", b)
 }
 
 // call elevator to a specific floor and prioritize the call
@@ -152,25 +646,250 @@ func MaintenanceCallOverride(c *gin.Context) {
 	errloc := "maintoverride"
 	elevatorID, err := strconv.Atoi(c.Param("elevator"))
 	if err != nil {
-		handleBadRequest(c, errloc, err)
+		handleError(c, errloc, err)
 		return
 	}
 	floor, err := strconv.Atoi(c.Param("floor"))
 	if err != nil {
-		handleBadRequest(c, errloc, err)
+		handleError(c, errloc, err)
 		return
 	}
 	direction, err := strconv.Atoi(c.Param("direction"))
 	if err != nil {
-		handleBadRequest(c, errloc, err)
+		handleError(c, errloc, err)
+		return
+	}
+	if dryRun(c) {
+		previewCommand(c, errloc, func(sim *building.Building) error {
+			return sim.MaintenanceCallOverride(elevatorID, floor, direction)
+		})
 		return
 	}
+	before := auditState(bld, elevatorID)
 	err = bld.MaintenanceCallOverride(elevatorID, floor, direction)
 	if err != nil {
-		handleBadRequest(c, errloc, err)
+		handleError(c, errloc, err)
 		return
 	}
 	log.Info(fmt.Sprintf("Maintenance override: elevator %d was called to floor %d", elevatorID, floor))
+	recordAudit(c, "maintenanceCallOverride", elevatorID, before, c.Query("reason"), nil)
+}
+
+// set the state of the smoke detector on a floor
+func SetSmokeDetector(c *gin.Context) {
+	errloc := "smokedetector"
+	floor, err := strconv.Atoi(c.Param("floor"))
+	if err != nil {
+		handleError(c, errloc, err)
+		return
+	}
+	active, err := strconv.ParseBool(c.Param("active"))
+	if err != nil {
+		handleError(c, errloc, err)
+		return
+	}
+	if dryRun(c) {
+		previewCommand(c, errloc, func(sim *building.Building) error {
+			return sim.SetSmokeDetector(floor, active)
+		})
+		return
+	}
+	err = bld.SetSmokeDetector(floor, active)
+	if err != nil {
+		handleError(c, errloc, err)
+		return
+	}
+	log.Info(fmt.Sprintf("Smoke detector on floor %d set to %t.", floor, active))
+}
+
+// fire service phase I -- recall all cars to the recall floor
+func ActivateFireRecall(c *gin.Context) {
+	errloc := "firerecall"
+	if dryRun(c) {
+		previewCommand(c, errloc, func(sim *building.Building) error {
+			_, err := sim.ActivateFireRecall()
+			return err
+		})
+		return
+	}
+	before := auditState(bld, -1)
+	recallFloor, err := bld.ActivateFireRecall()
+	if err != nil {
+		handleError(c, errloc, err)
+		return
+	}
+	log.Warn(fmt.Sprintf("Fire recall activated, cars recalled to floor %d.", recallFloor))
+	recordAudit(c, "fireRecall", -1, before, c.Query("reason"), nil)
+	b, err := json.Marshal(map[string]int{"recallFloor": recallFloor})
+	if err != nil {
+		handleError(c, errloc, err)
+		return
+	}
+	c.Data(http.StatusOK, "application/json", b)
+}
+
+// reset fire recall
+func ResetFireRecall(c *gin.Context) {
+	errloc := "resetfirerecall"
+	if dryRun(c) {
+		previewCommand(c, errloc, func(sim *building.Building) error {
+			return sim.ResetFireRecall()
+		})
+		return
+	}
+	before := auditState(bld, -1)
+	err := bld.ResetFireRecall()
+	if err != nil {
+		handleError(c, errloc, err)
+		return
+	}
+	log.Warn("Fire recall was reset.")
+	recordAudit(c, "resetFireRecall", -1, before, c.Query("reason"), nil)
+}
+
+// fire service phase II -- switch a recalled car in or out of firefighter service
+func FirefighterService(c *gin.Context) {
+	errloc := "firefighterservice"
+	elevatorID, err := strconv.Atoi(c.Param("elevator"))
+	if err != nil {
+		handleError(c, errloc, err)
+		return
+	}
+	on, err := strconv.ParseBool(c.Param("on"))
+	if err != nil {
+		handleError(c, errloc, err)
+		return
+	}
+	before := auditState(bld, elevatorID)
+	err = bld.SetFirefighterService(elevatorID, on, c.GetHeader("X-Fire-Service-Key"))
+	if err != nil {
+		handleError(c, errloc, err)
+		return
+	}
+	log.Warn(fmt.Sprintf("Elevator %d firefighter service set to %t.", elevatorID, on))
+	recordAudit(c, "firefighterService", elevatorID, before, c.Query("reason"), nil)
+}
+
+// fire service phase II -- car call from the firefighter key
+func FirefighterCarCall(c *gin.Context) {
+	errloc := "firefightercall"
+	elevatorID, err := strconv.Atoi(c.Param("elevator"))
+	if err != nil {
+		handleError(c, errloc, err)
+		return
+	}
+	floor, err := strconv.Atoi(c.Param("floor"))
+	if err != nil {
+		handleError(c, errloc, err)
+		return
+	}
+	err = bld.FirefighterCarCall(elevatorID, floor, c.GetHeader("X-Fire-Service-Key"))
+	if err != nil {
+		handleError(c, errloc, err)
+		return
+	}
+	log.Info(fmt.Sprintf("Firefighter call: elevator %d was called to floor %d.", elevatorID, floor))
+}
+
+// fire service phase II -- constant pressure door open button, pressed or released
+func FirefighterDoorButton(c *gin.Context) {
+	errloc := "firefighterdoor"
+	elevatorID, err := strconv.Atoi(c.Param("elevator"))
+	if err != nil {
+		handleError(c, errloc, err)
+		return
+	}
+	pressed, err := strconv.ParseBool(c.Param("pressed"))
+	if err != nil {
+		handleError(c, errloc, err)
+		return
+	}
+	err = bld.FirefighterDoorButton(elevatorID, pressed, c.GetHeader("X-Fire-Service-Key"))
+	if err != nil {
+		handleError(c, errloc, err)
+		return
+	}
+}
+
+// put the building on emergency power with a limited number of running cars
+func ActivateEmergencyPower(c *gin.Context) {
+	errloc := "emergencypower"
+	runningCars, err := strconv.Atoi(c.Param("cars"))
+	if err != nil {
+		handleError(c, errloc, err)
+		return
+	}
+	if dryRun(c) {
+		previewCommand(c, errloc, func(sim *building.Building) error {
+			return sim.ActivateEmergencyPower(runningCars)
+		})
+		return
+	}
+	before := auditState(bld, -1)
+	err = bld.ActivateEmergencyPower(runningCars)
+	if err != nil {
+		handleError(c, errloc, err)
+		return
+	}
+	log.Warn(fmt.Sprintf("Emergency power activated, %d cars will run.", runningCars))
+	recordAudit(c, "emergencyPower", -1, before, c.Query("reason"), nil)
+}
+
+// pick which cars run on emergency power -- comma separated elevator IDs
+func SetEmergencyPowerCars(c *gin.Context) {
+	errloc := "emergencypowercars"
+	elevatorIDs := make([]int, 0)
+	for _, s := range strings.Split(c.Param("elevators"), ",") {
+		elevatorID, err := strconv.Atoi(s)
+		if err != nil {
+			handleError(c, errloc, err)
+			return
+		}
+		elevatorIDs = append(elevatorIDs, elevatorID)
+	}
+	if dryRun(c) {
+		previewCommand(c, errloc, func(sim *building.Building) error {
+			return sim.SetEmergencyPowerCars(elevatorIDs)
+		})
+		return
+	}
+	before := auditState(bld, -1)
+	err := bld.SetEmergencyPowerCars(elevatorIDs)
+	if err != nil {
+		handleError(c, errloc, err)
+		return
+	}
+	log.Info(fmt.Sprintf("Emergency power cars set to %v.", elevatorIDs))
+	recordAudit(c, "emergencyPowerCars", -1, before, c.Query("reason"), nil)
+}
+
+// take the building off emergency power
+func EndEmergencyPower(c *gin.Context) {
+	errloc := "endemergencypower"
+	if dryRun(c) {
+		previewCommand(c, errloc, func(sim *building.Building) error {
+			return sim.EndEmergencyPower()
+		})
+		return
+	}
+	before := auditState(bld, -1)
+	err := bld.EndEmergencyPower()
+	if err != nil {
+		handleError(c, errloc, err)
+		return
+	}
+	log.Warn("Emergency power ended.")
+	recordAudit(c, "endEmergencyPower", -1, before, c.Query("reason"), nil)
+}
+
+// get the emergency power state of the building
+func GetEmergencyPowerStatus(c *gin.Context) {
+	b, err := json.Marshal(requestBuilding(c).GetEmergencyPowerStatus())
+	if err != nil {
+		handleError(c, "emergencypowerstatus", err)
+		return
+	}
+	c.Data(http.StatusOK, "application/json", b)
 }
 
 // set up the web routes
@@ -180,25 +899,131 @@ func setupRouter() *gin.Engine {
 
 	router.Use(jsonLogger())
 
+	// the building-scoped v1 API, see v1.go.  The routes below are the older
+	// verb-style ones, they run on the first building and are deprecated
+	setupV1(router)
+
+	// the API description, see openapi.yaml -- add new routes there too
+	router.GET("/openapi.json", GetOpenAPI)
+
 	// the two user-facing routes
-	router.POST("/pushDestination/:elevator/:floor", PushDestination)
-	router.POST("/callElevator/:floor/:direction", CallElevator)
+	router.POST("/pushDestination/:elevator/:floor", deprecated("/v1/buildings/{building}/elevators/{elevator}/car-calls"), PushDestination)
+	router.POST("/callElevator/:floor/:direction", deprecated("/v1/buildings/{building}/hall-calls"), CallElevator)
+	router.POST("/priorityCall/:floor/:destination", deprecated("/v1/buildings/{building}/priority-calls"), PriorityCall)
+	router.POST("/cancelHallCall/:floor/:direction", deprecated("/v1/buildings/{building}/hall-calls/{floor}/{direction}"), CancelHallCall)
+	router.POST("/cancelCarCall/:elevator/:floor", deprecated("/v1/buildings/{building}/elevators/{elevator}/car-calls/{floor}"), CancelCarCall)
+	router.GET("/calls/:id", deprecated("/v1/buildings/{building}/calls/{id}"), GetCall)
+	router.GET("/calls/:id/stream", deprecated("/v1/buildings/{building}/calls/{id}/stream"), StreamCall)
 
 	// this one is used by both maint and users to see the state
 	// I'd tidy it up to share it with users
-	router.GET("/getAllElevatorState", GetAllElevatorState)
+	router.GET("/getAllElevatorState", deprecated("/v1/buildings/{building}/elevators"), GetAllElevatorState)
+	router.GET("/hallCalls", deprecated("/v1/buildings/{building}/hall-calls"), GetHallCalls)
+	router.GET("/overdueCalls", deprecated("/v1/buildings/{building}/hall-calls/overdue"), GetOverdueCalls)
+	router.GET("/health", GetHealth)
+	router.GET("/floors", deprecated("/v1/buildings/{building}/floors"), GetFloors)
+	router.GET("/capacity", deprecated("/v1/buildings/{building}/capacity"), GetCapacity)
+
+	// maintenance routes, technicians and up -- see auth.go
+	maint := router.Group("/", requireRole(RoleTechnician), checkDryRun())
+	maint.POST("/maintenanceCallOverride/:elevator/:floor/:direction", deprecated("/v1/buildings/{building}/elevators/{elevator}/override-calls"), MaintenanceCallOverride)
+	maint.POST("/resetElevator/:elevator", deprecated("/v1/buildings/{building}/elevators/{elevator}/reset"), requireRole(RoleSupervisor), requireApproval("resetElevator", RoleSupervisor, nil), ResetElevator)
+	maint.POST("/reassignHallCall/:floor/:direction/:elevator", deprecated("/v1/buildings/{building}/hall-calls/{floor}/{direction}"), ReassignHallCall)
+	maint.POST("/takeElevatorOutOfService/:elevator", deprecated("/v1/buildings/{building}/elevators/{elevator}"), requireApproval("takeElevatorOutOfService", RoleTechnician, duringPeak), ElevatorOutOfService)
+	maint.POST("/drainElevator/:elevator", deprecated("/v1/buildings/{building}/elevators/{elevator}/drain"), requireApproval("takeElevatorOutOfService", RoleTechnician, duringPeak), DrainElevator)
+	maint.POST("/elevatorBackInService/:elevator", deprecated("/v1/buildings/{building}/elevators/{elevator}"), ElevatorBackInService)
+	maint.POST("/independentService/:elevator/:on", deprecated("/v1/buildings/{building}/elevators/{elevator}"), IndependentService)
+	maint.GET("/priorityCalls", deprecated("/v1/buildings/{building}/priority-calls"), GetPriorityCallLog)
+	maint.POST("/agingPolicy/:maxwait/:weight", deprecated("/v1/buildings/{building}/aging-policy"), requireRole(RoleSupervisor), requireApproval("agingPolicy", RoleSupervisor, nil), SetAgingPolicy)
+	maint.GET("/agingPolicy", deprecated("/v1/buildings/{building}/aging-policy"), GetAgingPolicy)
+	maint.POST("/maintenanceWindows", deprecated("/v1/buildings/{building}/maintenance-windows"), requireApproval("scheduleMaintenance", RoleTechnician, windowDuringPeak), ScheduleMaintenance)
+	maint.GET("/maintenanceWindows", deprecated("/v1/buildings/{building}/maintenance-windows"), GetMaintenanceWindows)
+	maint.GET("/approvals", deprecated("/v1/approvals"), GetApprovals)
+	maint.POST("/approvals/:id/approve", deprecated("/v1/approvals/{id}/approve"), ApproveChange(router))
+	maint.POST("/approvals/:id/reject", deprecated("/v1/approvals/{id}/reject"), RejectChange)
+	maint.GET("/auditLog", deprecated("/v1/audit-log"), requireRole(RoleSupervisor), GetAuditLog)
+	maint.GET("/auditLog/verify", deprecated("/v1/audit-log/verify"), requireRole(RoleSupervisor), VerifyAuditLog)
+	maint.POST("/reloadConfig", deprecated("/v1/config/reload"), requireRole(RoleSupervisor), ReloadConfig)
+	maint.POST("/commissionElevator", deprecated("/v1/buildings/{building}/elevators"), requireRole(RoleSupervisor), CommissionElevator)
+	maint.POST("/decommissionElevator/:elevator", deprecated("/v1/buildings/{building}/elevators/{elevator}"), requireRole(RoleSupervisor), DecommissionElevator)
+	maint.POST("/extendFloors/:floors", deprecated("/v1/buildings/{building}"), requireRole(RoleSupervisor), ExtendFloors)
+
+	// fire service and emergency power take the whole building over, technicians and up.
+	// Phase II is on the firefighters' key instead, they won't have an API key.
+	safety := router.Group("/", requireRole(RoleTechnician), checkDryRun())
+	safety.POST("/smokeDetector/:floor/:active", deprecated("/v1/buildings/{building}/floors/{floor}/smoke-detector"), SetSmokeDetector)
+	safety.POST("/fireRecall", deprecated("/v1/buildings/{building}/fire-recall"), ActivateFireRecall)
+	safety.POST("/resetFireRecall", deprecated("/v1/buildings/{building}/fire-recall"), ResetFireRecall)
+	safety.POST("/emergencyPower/:cars", deprecated("/v1/buildings/{building}/emergency-power"), ActivateEmergencyPower)
+	safety.POST("/emergencyPowerCars/:elevators", deprecated("/v1/buildings/{building}/emergency-power"), SetEmergencyPowerCars)
+	safety.POST("/endEmergencyPower", deprecated("/v1/buildings/{building}/emergency-power"), EndEmergencyPower)
 
-	// maintenance routes
-	router.POST("/maintenanceCallOverride/:elevator/:floor/:direction", MaintenanceCallOverride)
-	router.POST("/resetElevator/:elevator", ResetElevator)
-	router.POST("/takeElevatorOutOfService/:elevator", ElevatorOutOfService)
-	router.POST("/elevatorBackInService/:elevator", ElevatorBackInService)
+	// fire service phase II
+	router.POST("/firefighterService/:elevator/:on", deprecated("/v1/buildings/{building}/elevators/{elevator}/firefighter-service"), FirefighterService)
+	router.POST("/firefighterCarCall/:elevator/:floor", deprecated("/v1/buildings/{building}/elevators/{elevator}/firefighter-calls"), FirefighterCarCall)
+	router.POST("/firefighterDoor/:elevator/:pressed", deprecated("/v1/buildings/{building}/elevators/{elevator}/firefighter-door"), FirefighterDoorButton)
+
+	router.GET("/emergencyPower", deprecated("/v1/buildings/{building}/emergency-power"), GetEmergencyPowerStatus)
 
 	return router
 }
 
 func main() {
+	listen := ":8077"
+	grpcListen := ":8078"
+	// without a config we run the one building we always have
+	if path := os.Getenv("CONFIG"); path != "" {
+		cfg, err := config.Load(path)
+		if err != nil {
+			log.Fatal(err.Error())
+		}
+		if err := loadBuildings(cfg); err != nil {
+			log.Fatal(fmt.Sprintf("config %s - %s", path, err.Error()))
+		}
+		if cfg.Listen != "" {
+			listen = cfg.Listen
+		}
+		if cfg.GRPCListen != "" {
+			grpcListen = cfg.GRPCListen
+		}
+		configPath = path
+	} else {
+		log.Warn(fmt.Sprintf("CONFIG not set, running the default building %d", bld.ID))
+	}
+	// without an auth config nobody gets onto the maintenance routes
+	if path := os.Getenv("AUTH_CONFIG"); path != "" {
+		keys, err := loadAuthConfig(path)
+		if err != nil {
+			log.Fatal(fmt.Sprintf("auth config - %s", err.Error()))
+		}
+		apiKeys.set(keys)
+	} else {
+		log.Warn("AUTH_CONFIG not set, maintenance routes are locked")
+	}
+	if path := os.Getenv("AUDIT_LOG"); path != "" {
+		l, err := audit.Open(path)
+		if err != nil {
+			log.Fatal(fmt.Sprintf("audit log - %s", err.Error()))
+		}
+		auditLog = l
+		auditLogPath = path
+	} else {
+		log.Warn("AUDIT_LOG not set, the audit log is kept in memory only")
+	}
+	go runMaintenanceSchedule(10 * time.Second)
+	go runApprovalExpiry(time.Minute)
+	go runHallCallOptimizer(5 * time.Second)
+	go runCallWatchdog(10 * time.Second)
+	for _, b := range allBuildings() {
+		startBuilding(b)
+	}
+	// kill -HUP picks up config changes, so does POST /reloadConfig
+	if configPath != "" {
+		go watchReload()
+	}
+	// the gRPC API runs alongside on the same buildings, see grpc.go
+	go serveGRPC(grpcListen)
 	r := setupRouter()
-	log.Info("Starting server on port 8077")
-	r.Run(":8077")
+	log.Info(fmt.Sprintf("Starting server on %s for building %d", listen, bld.ID))
+	r.Run(listen)
 }
@@ -4,12 +4,71 @@ import (
 	"encoding/json"
 	"net/http"
 	"net/http/httptest"
+	"os"
 	"testing"
 	"fmt"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/tcotav/elevatormgr/building"
+	"github.com/tcotav/elevatormgr/elevator"
 )
 
 // ref - https://gin-gonic.com/docs/testing/
 
+const (
+	testToken         = "test-supervisor-token"
+	testApproverToken = "test-approver-token"
+)
+
+// testKeys are a supervisor to make changes and a second one to approve them
+func testKeys() []APIKey {
+	return []APIKey{
+		{Name: "test", Token: testToken, Role: RoleSupervisor, Buildings: []int{1}},
+		{Name: "approver", Token: testApproverToken, Role: RoleSupervisor, Buildings: []int{1}},
+	}
+}
+
+func TestMain(m *testing.M) {
+	apiKeys.set(testKeys())
+	// keep out of peak hours whenever the tests run, see duringPeak
+	approvals.clock = func() time.Time {
+		now := time.Now()
+		return time.Date(now.Year(), now.Month(), now.Day(), 12, now.Minute(), now.Second(), now.Nanosecond(), time.Local)
+	}
+	os.Exit(m.Run())
+}
+
+// authorize signs a request onto the maintenance routes
+func authorize(req *http.Request) {
+	req.Header.Set("Authorization", "Bearer "+testToken)
+}
+
+// approve has the second supervisor sign off on the pending change in w,
+// it returns the response of the command itself
+func approve(t *testing.T, router *gin.Engine, w *httptest.ResponseRecorder) *httptest.ResponseRecorder {
+	t.Helper()
+	if w.Code != http.StatusAccepted {
+		t.Fatalf("Expected status code 202, got %d", w.Code)
+	}
+	var body struct {
+		Approval PendingChange `json:"approval"`
+	}
+	json.Unmarshal(w.Body.Bytes(), &body)
+	w = httptest.NewRecorder()
+	req, _ := http.NewRequest("POST", "/approvals/"+body.Approval.ID+"/approve", nil)
+	req.Header.Set("Authorization", "Bearer "+testApproverToken)
+	router.ServeHTTP(w, req)
+	return w
+}
+
+// what callElevator and pushDestination hand back
+type callResponse struct {
+	Elevator int    `json:"elevator"`
+	Call     string `json:"call"`
+}
+
 func TestPushDestination(t *testing.T) {
 	router := setupRouter()
 
@@ -21,12 +80,12 @@ func TestPushDestination(t *testing.T) {
 	if w.Code != http.StatusOK {
 		t.Errorf("Expected status code 200, got %d", w.Code)
 	}
-	retMap := make(map[string]int)
-	err := json.Unmarshal(w.Body.Bytes(), &retMap)
+	var retCall callResponse
+	err := json.Unmarshal(w.Body.Bytes(), &retCall)
 	if err != nil {
 		t.Errorf("Expected no error on json unmarshal of callelevator, got %s", err.Error())
 	}
-	elevatorID := retMap["elevatorID"]
+	elevatorID := retCall.Elevator
 
 	// then push a destination button
 	w = httptest.NewRecorder()
@@ -42,8 +101,8 @@ func TestPushDestination(t *testing.T) {
 	req, _ = http.NewRequest("POST", "/pushDestination/10/2", nil)
 	router.ServeHTTP(w, req)
 
-	if w.Code != http.StatusBadRequest {
-		t.Errorf("Expected status code 400, got %d", w.Code)
+	if w.Code != http.StatusNotFound {
+		t.Errorf("Expected status code 404, got %d", w.Code)
 	}
 
 	// test out of bounds floor
@@ -90,19 +149,31 @@ func TestResetElevator(t *testing.T) {
 
 	w := httptest.NewRecorder()
 	req, _ := http.NewRequest("POST", "/resetElevator/0", nil)
+	authorize(req)
 	router.ServeHTTP(w, req)
+	w = approve(t, router, w)
 
 	if w.Code != http.StatusOK {
 		t.Errorf("Expected status code 200, got %d", w.Code)
 	}
+	retMap := make(map[string][]interface{})
+	err := json.Unmarshal(w.Body.Bytes(), &retMap)
+	if err != nil {
+		t.Errorf("Expected no error on json unmarshal of resetElevator, got %s", err.Error())
+	}
+	if _, ok := retMap["reassigned"]; !ok {
+		t.Errorf("Expected reassigned calls in the response")
+	}
 
 	// test out of bounds elevator
 	w = httptest.NewRecorder()
 	req, _ = http.NewRequest("POST", "/resetElevator/10", nil)
+	authorize(req)
 	router.ServeHTTP(w, req)
+	w = approve(t, router, w)
 
-	if w.Code != http.StatusBadRequest {
-		t.Errorf("Expected status code 400, got %d", w.Code)
+	if w.Code != http.StatusNotFound {
+		t.Errorf("Expected status code 404, got %d", w.Code)
 	}
 }
 
@@ -118,6 +189,28 @@ func TestGetAllElevatorState(t *testing.T) {
 	}
 }
 
+func TestLayout(t *testing.T) {
+	router := setupRouter()
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/floors", nil)
+	router.ServeHTTP(w, req)
+	var floors []building.Floor
+	json.Unmarshal(w.Body.Bytes(), &floors)
+	if w.Code != http.StatusOK || len(floors) != bld.NumFloors {
+		t.Errorf("Expected %d floors, got %d %s", bld.NumFloors, w.Code, w.Body.String())
+	}
+
+	w = httptest.NewRecorder()
+	req, _ = http.NewRequest("GET", "/capacity", nil)
+	router.ServeHTTP(w, req)
+	var capacity []building.BankCapacity
+	json.Unmarshal(w.Body.Bytes(), &capacity)
+	if w.Code != http.StatusOK || len(capacity) == 0 || capacity[0].Cars != len(bld.ElevatorList) {
+		t.Errorf("Expected capacity for %d cars, got %d %s", len(bld.ElevatorList), w.Code, w.Body.String())
+	}
+}
+
 func TestMaintenanceCallOverride(t *testing.T) {
 	router := setupRouter()
 
@@ -130,15 +223,16 @@ func TestMaintenanceCallOverride(t *testing.T) {
 	if w.Code != http.StatusOK {
 		t.Errorf("Expected status code 200, got %d", w.Code)
 	}
-	retMap := make(map[string]int)
-	err := json.Unmarshal(w.Body.Bytes(), &retMap)
+	var retCall callResponse
+	err := json.Unmarshal(w.Body.Bytes(), &retCall)
 	if err != nil {
 		t.Errorf("Expected no error on json unmarshal of callelevator, got %s", err.Error())
 	}
-	elevatorID := retMap["elevatorID"]
+	elevatorID := retCall.Elevator
 
 	w = httptest.NewRecorder()
 	req, _ = http.NewRequest("POST", fmt.Sprintf("/maintenanceCallOverride/%d/6/1", elevatorID), nil)
+	authorize(req)
 	router.ServeHTTP(w, req)
 
 	if w.Code != http.StatusOK {
@@ -148,9 +242,602 @@ func TestMaintenanceCallOverride(t *testing.T) {
 	// test out of bounds elevator
 	w = httptest.NewRecorder()
 	req, _ = http.NewRequest("POST", "/maintenanceCallOverride/100/2/1", nil)
+	authorize(req)
 	router.ServeHTTP(w, req)
 
 	if w.Code == http.StatusOK {
 		t.Errorf("Expected status code 400, got %d", w.Code)
 	}
-}
\ No newline at end of file
+}
+
+func TestFireRecall(t *testing.T) {
+	router := setupRouter()
+	bld.SetFireServiceKey("firekey")
+
+	// only technicians and up can pull the whole building
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("POST", "/fireRecall", nil)
+	router.ServeHTTP(w, req)
+
+	if w.Code != http.StatusUnauthorized {
+		t.Errorf("Expected status code 401, got %d", w.Code)
+	}
+
+	w = httptest.NewRecorder()
+	req, _ = http.NewRequest("POST", "/fireRecall", nil)
+	authorize(req)
+	router.ServeHTTP(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Errorf("Expected status code 200, got %d", w.Code)
+	}
+	retMap := make(map[string]int)
+	err := json.Unmarshal(w.Body.Bytes(), &retMap)
+	if err != nil {
+		t.Errorf("Expected no error on json unmarshal of fireRecall, got %s", err.Error())
+	}
+	if retMap["recallFloor"] != 1 {
+		t.Errorf("Expected recall floor 1, got %d", retMap["recallFloor"])
+	}
+
+	// hall calls are refused during recall
+	w = httptest.NewRecorder()
+	req, _ = http.NewRequest("POST", "/callElevator/4/1", nil)
+	router.ServeHTTP(w, req)
+
+	if w.Code != http.StatusConflict {
+		t.Errorf("Expected status code 409, got %d", w.Code)
+	}
+
+	// firefighter takes a car
+	w = httptest.NewRecorder()
+	req, _ = http.NewRequest("POST", "/firefighterService/0/true", nil)
+	req.Header.Set("X-Fire-Service-Key", "firekey")
+	router.ServeHTTP(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Errorf("Expected status code 200, got %d", w.Code)
+	}
+
+	w = httptest.NewRecorder()
+	req, _ = http.NewRequest("POST", "/firefighterDoor/0/false", nil)
+	req.Header.Set("X-Fire-Service-Key", "firekey")
+	router.ServeHTTP(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Errorf("Expected status code 200, got %d", w.Code)
+	}
+
+	w = httptest.NewRecorder()
+	req, _ = http.NewRequest("POST", "/firefighterCarCall/0/5", nil)
+	req.Header.Set("X-Fire-Service-Key", "firekey")
+	router.ServeHTTP(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Errorf("Expected status code 200, got %d", w.Code)
+	}
+
+	// normal car calls are refused
+	w = httptest.NewRecorder()
+	req, _ = http.NewRequest("POST", "/pushDestination/0/6", nil)
+	router.ServeHTTP(w, req)
+
+	if w.Code != http.StatusConflict {
+		t.Errorf("Expected status code 409, got %d", w.Code)
+	}
+
+	w = httptest.NewRecorder()
+	req, _ = http.NewRequest("POST", "/firefighterService/0/false", nil)
+	req.Header.Set("X-Fire-Service-Key", "firekey")
+	router.ServeHTTP(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Errorf("Expected status code 200, got %d", w.Code)
+	}
+
+	// the fire key is for Phase II, not the recall
+	w = httptest.NewRecorder()
+	req, _ = http.NewRequest("POST", "/resetFireRecall", nil)
+	req.Header.Set("X-Fire-Service-Key", "firekey")
+	router.ServeHTTP(w, req)
+
+	if w.Code != http.StatusUnauthorized {
+		t.Errorf("Expected status code 401, got %d", w.Code)
+	}
+
+	w = httptest.NewRecorder()
+	req, _ = http.NewRequest("POST", "/resetFireRecall", nil)
+	authorize(req)
+	router.ServeHTTP(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Errorf("Expected status code 200, got %d", w.Code)
+	}
+}
+
+func TestEmergencyPower(t *testing.T) {
+	router := setupRouter()
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("POST", "/emergencyPower/1", nil)
+	router.ServeHTTP(w, req)
+
+	if w.Code != http.StatusUnauthorized {
+		t.Errorf("Expected status code 401, got %d", w.Code)
+	}
+
+	w = httptest.NewRecorder()
+	req, _ = http.NewRequest("POST", "/emergencyPower/1", nil)
+	authorize(req)
+	router.ServeHTTP(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Errorf("Expected status code 200, got %d", w.Code)
+	}
+
+	w = httptest.NewRecorder()
+	req, _ = http.NewRequest("POST", "/emergencyPowerCars/0,1", nil)
+	authorize(req)
+	router.ServeHTTP(w, req)
+
+	if w.Code != http.StatusBadRequest {
+		t.Errorf("Expected status code 400, got %d", w.Code)
+	}
+
+	w = httptest.NewRecorder()
+	req, _ = http.NewRequest("GET", "/emergencyPower", nil)
+	router.ServeHTTP(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Errorf("Expected status code 200, got %d", w.Code)
+	}
+	var status map[string]interface{}
+	err := json.Unmarshal(w.Body.Bytes(), &status)
+	if err != nil {
+		t.Errorf("Expected no error on json unmarshal of emergencyPower, got %s", err.Error())
+	}
+	if status["Active"] != true {
+		t.Errorf("Expected emergency power to be active")
+	}
+
+	w = httptest.NewRecorder()
+	req, _ = http.NewRequest("POST", "/endEmergencyPower", nil)
+	authorize(req)
+	router.ServeHTTP(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Errorf("Expected status code 200, got %d", w.Code)
+	}
+}
+
+func TestIndependentService(t *testing.T) {
+	router := setupRouter()
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("POST", "/independentService/1/true", nil)
+	authorize(req)
+	router.ServeHTTP(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Errorf("Expected status code 200, got %d", w.Code)
+	}
+
+	// still takes car calls
+	w = httptest.NewRecorder()
+	req, _ = http.NewRequest("POST", "/pushDestination/1/9", nil)
+	router.ServeHTTP(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Errorf("Expected status code 200, got %d", w.Code)
+	}
+
+	w = httptest.NewRecorder()
+	req, _ = http.NewRequest("POST", "/independentService/1/false", nil)
+	authorize(req)
+	router.ServeHTTP(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Errorf("Expected status code 200, got %d", w.Code)
+	}
+
+	// test out of bounds elevator
+	w = httptest.NewRecorder()
+	req, _ = http.NewRequest("POST", "/independentService/10/true", nil)
+	authorize(req)
+	router.ServeHTTP(w, req)
+
+	if w.Code != http.StatusNotFound {
+		t.Errorf("Expected status code 404, got %d", w.Code)
+	}
+}
+
+func TestPriorityCall(t *testing.T) {
+	router := setupRouter()
+	bld.SetPriorityCallKey("codeblue")
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("POST", "/priorityCall/4/1?reason=code+blue", nil)
+	router.ServeHTTP(w, req)
+
+	if w.Code != http.StatusForbidden {
+		t.Errorf("Expected status code 403, got %d", w.Code)
+	}
+
+	w = httptest.NewRecorder()
+	req, _ = http.NewRequest("POST", "/priorityCall/4/1?reason=code+blue", nil)
+	req.Header.Set("X-Priority-Key", "codeblue")
+	req.Header.Set("X-Requested-By", "ward4")
+	router.ServeHTTP(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Errorf("Expected status code 200, got %d", w.Code)
+	}
+
+	w = httptest.NewRecorder()
+	req, _ = http.NewRequest("GET", "/priorityCalls", nil)
+	authorize(req)
+	router.ServeHTTP(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Errorf("Expected status code 200, got %d", w.Code)
+	}
+	var records []map[string]interface{}
+	err := json.Unmarshal(w.Body.Bytes(), &records)
+	if err != nil {
+		t.Errorf("Expected no error on json unmarshal of priorityCalls, got %s", err.Error())
+	}
+	if len(records) != 2 {
+		t.Errorf("Expected 2 priority call records, got %d", len(records))
+	}
+}
+
+func TestScheduleMaintenance(t *testing.T) {
+	router := setupRouter()
+
+	// overnight, so it doesn't need approving
+	day := time.Now().AddDate(0, 0, 1)
+	start := time.Date(day.Year(), day.Month(), day.Day(), 1, 0, 0, 0, time.Local).Format(time.RFC3339)
+	end := time.Date(day.Year(), day.Month(), day.Day(), 2, 0, 0, 0, time.Local).Format(time.RFC3339)
+	body := fmt.Sprintf(`{"elevator": 2, "start": "%s", "end": "%s", "reason": "annual inspection", "technician": "pat"}`, start, end)
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("POST", "/maintenanceWindows", strings.NewReader(body))
+	authorize(req)
+	router.ServeHTTP(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Errorf("Expected status code 200, got %d", w.Code)
+	}
+
+	// missing technician
+	w = httptest.NewRecorder()
+	req, _ = http.NewRequest("POST", "/maintenanceWindows", strings.NewReader(`{"elevator": 1}`))
+	authorize(req)
+	router.ServeHTTP(w, req)
+
+	if w.Code != http.StatusBadRequest {
+		t.Errorf("Expected status code 400, got %d", w.Code)
+	}
+
+	w = httptest.NewRecorder()
+	req, _ = http.NewRequest("GET", "/maintenanceWindows", nil)
+	authorize(req)
+	router.ServeHTTP(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Errorf("Expected status code 200, got %d", w.Code)
+	}
+	var windows []map[string]interface{}
+	err := json.Unmarshal(w.Body.Bytes(), &windows)
+	if err != nil {
+		t.Errorf("Expected no error on json unmarshal of maintenanceWindows, got %s", err.Error())
+	}
+	if len(windows) != 1 {
+		t.Errorf("Expected 1 maintenance window, got %d", len(windows))
+	}
+}
+
+func TestDrainDuringPeak(t *testing.T) {
+	first, _ := useBuildings(t)
+	router := setupRouter()
+	clock := approvals.clock
+	approvals.clock = func() time.Time { return time.Date(2024, 3, 1, 17, 30, 0, 0, time.Local) }
+	defer func() { approvals.clock = clock }()
+
+	w := serveV1(router, "POST", "/v1/buildings/1/elevators/1/drain", "")
+	if w.Code != http.StatusAccepted || first.GetElevator(1).Mode == elevator.ModeDraining || !first.GetElevator(1).InService {
+		t.Fatalf("Drain should wait on approval during peak, got %d %s", w.Code, w.Body.String())
+	}
+	if w = approve(t, router, w); w.Code != http.StatusOK || first.GetElevator(1).InService {
+		t.Errorf("Elevator 1 should be drained once approved, got %d %s", w.Code, w.Body.String())
+	}
+
+	w = httptest.NewRecorder()
+	req, _ := http.NewRequest("POST", "/drainElevator/2", nil)
+	authorize(req)
+	router.ServeHTTP(w, req)
+	if w.Code != http.StatusAccepted || !first.GetElevator(2).InService {
+		t.Errorf("Old drain route should wait on approval during peak too, got %d %s", w.Code, w.Body.String())
+	}
+}
+
+func TestScheduleMaintenanceDuringPeak(t *testing.T) {
+	first, _ := useBuildings(t)
+	router := setupRouter()
+
+	// over the evening rush, whenever the test runs
+	day := time.Now().AddDate(0, 0, 1)
+	start := time.Date(day.Year(), day.Month(), day.Day(), 15, 0, 0, 0, time.Local).Format(time.RFC3339)
+	end := time.Date(day.Year(), day.Month(), day.Day(), 17, 0, 0, 0, time.Local).Format(time.RFC3339)
+	body := fmt.Sprintf(`{"elevator": 2, "start": "%s", "end": "%s", "reason": "annual inspection", "technician": "pat"}`, start, end)
+
+	w := serveV1(router, "POST", "/v1/buildings/1/maintenance-windows", body)
+	if w.Code != http.StatusAccepted || len(first.GetMaintenanceWindows(time.Now())) != 0 {
+		t.Fatalf("Window in peak hours should wait on approval, got %d %s", w.Code, w.Body.String())
+	}
+	if w = approve(t, router, w); w.Code != http.StatusOK || len(first.GetMaintenanceWindows(time.Now())) != 1 {
+		t.Errorf("Window should be booked once approved, got %d %s", w.Code, w.Body.String())
+	}
+}
+
+func TestDrainElevator(t *testing.T) {
+	router := setupRouter()
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("POST", "/drainElevator/2", nil)
+	authorize(req)
+	router.ServeHTTP(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Errorf("Expected status code 200, got %d", w.Code)
+	}
+	retMap := make(map[string][]interface{})
+	err := json.Unmarshal(w.Body.Bytes(), &retMap)
+	if err != nil {
+		t.Errorf("Expected no error on json unmarshal of drainElevator, got %s", err.Error())
+	}
+	if _, ok := retMap["reassigned"]; !ok {
+		t.Errorf("Expected reassigned calls in the response")
+	}
+
+	w = httptest.NewRecorder()
+	req, _ = http.NewRequest("POST", "/elevatorBackInService/2", nil)
+	authorize(req)
+	router.ServeHTTP(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Errorf("Expected status code 200, got %d", w.Code)
+	}
+
+	// test out of bounds elevator
+	w = httptest.NewRecorder()
+	req, _ = http.NewRequest("POST", "/drainElevator/10", nil)
+	authorize(req)
+	router.ServeHTTP(w, req)
+
+	if w.Code != http.StatusNotFound {
+		t.Errorf("Expected status code 404, got %d", w.Code)
+	}
+}
+
+func TestHallCalls(t *testing.T) {
+	router := setupRouter()
+
+	// same button twice goes to the same car
+	calls := make([]callResponse, 0)
+	for i := 0; i < 2; i++ {
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("POST", "/callElevator/8/-1", nil)
+		router.ServeHTTP(w, req)
+
+		if w.Code != http.StatusOK {
+			t.Errorf("Expected status code 200, got %d", w.Code)
+		}
+		var retCall callResponse
+		json.Unmarshal(w.Body.Bytes(), &retCall)
+		calls = append(calls, retCall)
+	}
+	if calls[0] != calls[1] {
+		t.Errorf("Expected both pushes to be the same call %v, got %v", calls[0], calls[1])
+	}
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/hallCalls", nil)
+	router.ServeHTTP(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Errorf("Expected status code 200, got %d", w.Code)
+	}
+	var hallCalls []map[string]interface{}
+	err := json.Unmarshal(w.Body.Bytes(), &hallCalls)
+	if err != nil {
+		t.Errorf("Expected no error on json unmarshal of hallCalls, got %s", err.Error())
+	}
+	found := false
+	for _, hc := range hallCalls {
+		if hc["Floor"] == float64(8) && hc["Direction"] == float64(-1) {
+			found = true
+		}
+	}
+	if !found {
+		t.Errorf("Expected a hall call at floor 8 going down")
+	}
+
+	// test hall call that doesn't exist
+	w = httptest.NewRecorder()
+	req, _ = http.NewRequest("POST", "/reassignHallCall/9/1/0", nil)
+	authorize(req)
+	router.ServeHTTP(w, req)
+
+	if w.Code != http.StatusNotFound {
+		t.Errorf("Expected status code 404, got %d", w.Code)
+	}
+}
+
+func TestCancelCalls(t *testing.T) {
+	router := setupRouter()
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("POST", "/callElevator/9/-1", nil)
+	router.ServeHTTP(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Errorf("Expected status code 200, got %d", w.Code)
+	}
+	var retCall callResponse
+	json.Unmarshal(w.Body.Bytes(), &retCall)
+	elevatorID := retCall.Elevator
+
+	w = httptest.NewRecorder()
+	req, _ = http.NewRequest("POST", "/cancelHallCall/9/-1?reason=doubletap", nil)
+	router.ServeHTTP(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Errorf("Expected status code 200, got %d", w.Code)
+	}
+
+	w = httptest.NewRecorder()
+	req, _ = http.NewRequest("POST", fmt.Sprintf("/pushDestination/%d/10", elevatorID), nil)
+	router.ServeHTTP(w, req)
+
+	w = httptest.NewRecorder()
+	req, _ = http.NewRequest("POST", fmt.Sprintf("/cancelCarCall/%d/10", elevatorID), nil)
+	router.ServeHTTP(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Errorf("Expected status code 200, got %d", w.Code)
+	}
+
+	// already gone
+	w = httptest.NewRecorder()
+	req, _ = http.NewRequest("POST", fmt.Sprintf("/cancelCarCall/%d/10", elevatorID), nil)
+	router.ServeHTTP(w, req)
+
+	if w.Code != http.StatusNotFound {
+		t.Errorf("Expected status code 404, got %d", w.Code)
+	}
+}
+
+func TestGetCall(t *testing.T) {
+	router := setupRouter()
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("POST", "/callElevator/6/1", nil)
+	router.ServeHTTP(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Errorf("Expected status code 200, got %d", w.Code)
+	}
+	var retCall callResponse
+	err := json.Unmarshal(w.Body.Bytes(), &retCall)
+	if err != nil {
+		t.Errorf("Expected no error on json unmarshal of callelevator, got %s", err.Error())
+	}
+	if retCall.Call == "" {
+		t.Errorf("Expected a call ID")
+	}
+
+	w = httptest.NewRecorder()
+	req, _ = http.NewRequest("GET", "/calls/"+retCall.Call, nil)
+	router.ServeHTTP(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Errorf("Expected status code 200, got %d", w.Code)
+	}
+	var ticket map[string]interface{}
+	json.Unmarshal(w.Body.Bytes(), &ticket)
+	if ticket["Status"] != "assigned" {
+		t.Errorf("Expected an assigned call, got %v", ticket["Status"])
+	}
+
+	// cancel it so the stream ends straight away
+	w = httptest.NewRecorder()
+	req, _ = http.NewRequest("POST", "/cancelHallCall/6/1", nil)
+	router.ServeHTTP(w, req)
+
+	w = httptest.NewRecorder()
+	req, _ = http.NewRequest("GET", "/calls/"+retCall.Call+"/stream", nil)
+	router.ServeHTTP(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Errorf("Expected status code 200, got %d", w.Code)
+	}
+	if !strings.Contains(w.Body.String(), "cancelled") {
+		t.Errorf("Expected the stream to report the call cancelled, got %s", w.Body.String())
+	}
+
+	// test call that doesn't exist
+	w = httptest.NewRecorder()
+	req, _ = http.NewRequest("GET", "/calls/nope", nil)
+	router.ServeHTTP(w, req)
+
+	if w.Code != http.StatusNotFound {
+		t.Errorf("Expected status code 404, got %d", w.Code)
+	}
+}
+
+func TestHealth(t *testing.T) {
+	router := setupRouter()
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/health", nil)
+	router.ServeHTTP(w, req)
+
+	if w.Code != http.StatusOK && w.Code != http.StatusServiceUnavailable {
+		t.Errorf("Expected status code 200 or 503, got %d", w.Code)
+	}
+	var health map[string]interface{}
+	err := json.Unmarshal(w.Body.Bytes(), &health)
+	if err != nil {
+		t.Errorf("Expected no error on json unmarshal of health, got %s", err.Error())
+	}
+	if _, ok := health["OverdueCalls"]; !ok {
+		t.Errorf("Expected overdue calls in the health output")
+	}
+
+	w = httptest.NewRecorder()
+	req, _ = http.NewRequest("GET", "/overdueCalls", nil)
+	router.ServeHTTP(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Errorf("Expected status code 200, got %d", w.Code)
+	}
+}
+
+func TestAgingPolicy(t *testing.T) {
+	router := setupRouter()
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("POST", "/agingPolicy/90s/0.1", nil)
+	authorize(req)
+	router.ServeHTTP(w, req)
+	w = approve(t, router, w)
+	if w.Code != http.StatusOK {
+		t.Errorf("Expected status code 200, got %d", w.Code)
+	}
+
+	w = httptest.NewRecorder()
+	req, _ = http.NewRequest("GET", "/agingPolicy", nil)
+	authorize(req)
+	router.ServeHTTP(w, req)
+	var policy elevator.AgingPolicy
+	json.Unmarshal(w.Body.Bytes(), &policy)
+	if policy.MaxWait != 90*time.Second || policy.Weight != 0.1 {
+		t.Errorf("Aging policy should be 90s and 0.1, got %v", policy)
+	}
+
+	// bad duration and negative weight
+	for _, path := range []string{"/agingPolicy/soon/0.1", "/agingPolicy/90s/-1"} {
+		w = httptest.NewRecorder()
+		req, _ = http.NewRequest("POST", path, nil)
+		authorize(req)
+		router.ServeHTTP(w, req)
+		w = approve(t, router, w)
+		if w.Code != http.StatusBadRequest {
+			t.Errorf("Expected status code 400 for %s, got %d", path, w.Code)
+		}
+	}
+
+	// back to serving calls in order for anyone after us
+	bld.SetAgingPolicy(elevator.AgingPolicy{})
+}
@@ -0,0 +1,902 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	log "github.com/sirupsen/logrus"
+	"github.com/tcotav/elevatormgr/building"
+	"github.com/tcotav/elevatormgr/config"
+	"github.com/tcotav/elevatormgr/elevator"
+)
+
+// The v1 API.  Everything hangs off /v1/buildings/:building, commands take a
+// JSON body and answer with the resource as it stands afterwards.  The older
+// verb-style routes only know the first building and are kept for the panels
+// already out there, see deprecated.
+
+// when the old routes were superseded by v1
+var deprecatedSince = time.Date(2026, time.October, 19, 0, 0, 0, 0, time.UTC)
+
+// deprecated marks an old route.  successor is its v1 path, {building} and
+// the old route's own path params in braces are filled in.
+func deprecated(successor string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		link := strings.ReplaceAll(successor, "{building}", strconv.Itoa(bld.ID))
+		for _, p := range c.Params {
+			link = strings.ReplaceAll(link, "{"+p.Key+"}", p.Value)
+		}
+		c.Header("Deprecation", fmt.Sprintf("@%d", deprecatedSince.Unix()))
+		c.Header("Link", fmt.Sprintf(`<%s>; rel="successor-version"`, link))
+		c.Next()
+	}
+}
+
+// bindBody reads a JSON body into v, answering 400 when it can't
+func bindBody(c *gin.Context, source string, v interface{}) bool {
+	if err := c.ShouldBindJSON(v); err != nil {
+		handleError(c, source, err)
+		return false
+	}
+	return true
+}
+
+// pathInt reads a number from the path, answering 400 when it isn't one
+func pathInt(c *gin.Context, source string, name string) (int, bool) {
+	n, err := strconv.Atoi(c.Param(name))
+	if err != nil {
+		handleError(c, source, fmt.Errorf("invalid %s: %s", name, c.Param(name)))
+		return 0, false
+	}
+	return n, true
+}
+
+// respondElevator answers with a car as it is now
+func respondElevator(c *gin.Context, source string, code int, elevatorID int) {
+	state, err := requestBuilding(c).GetElevatorState(elevatorID)
+	if err != nil {
+		handleError(c, source, err)
+		return
+	}
+	c.Data(code, "application/json", state)
+}
+
+// respondCarChange answers a command that moved a car's hall calls with the
+// car and where they went, 409 when some couldn't be placed
+func respondCarChange(c *gin.Context, source string, elevatorID int, reassigned []building.Reassignment, err error) {
+	if err != nil {
+		log.Error(fmt.Sprintf("%s - %s", source, err.Error()))
+		c.JSON(http.StatusConflict, unplacedBody{newErrorBody(c, "calls_unplaced", err), reassigned})
+		return
+	}
+	state, err := requestBuilding(c).GetElevatorState(elevatorID)
+	if err != nil {
+		handleError(c, source, err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"elevator": json.RawMessage(state), "reassigned": reassigned})
+}
+
+// a building in the list of buildings
+type buildingSummary struct {
+	ID        int   `json:"id"`
+	Floors    int   `json:"floors"`
+	Elevators []int `json:"elevators"`
+}
+
+// a building and how it's set up
+type buildingResource struct {
+	ID          int                       `json:"id"`
+	Floors      []building.Floor          `json:"floors"`
+	Elevators   []int                     `json:"elevators"`
+	Groups      []building.Group          `json:"groups"`
+	Dispatch    building.DispatchStrategy `json:"dispatch"`
+	Policies    building.Policies         `json:"policies"`
+	AgingPolicy elevator.AgingPolicy      `json:"agingPolicy"`
+}
+
+func newBuildingResource(b *building.Building) buildingResource {
+	return buildingResource{
+		ID:          b.ID,
+		Floors:      b.GetFloors(),
+		Elevators:   b.GetElevatorIDs(),
+		Groups:      b.GetGroups(),
+		Dispatch:    b.GetDispatchStrategy(),
+		Policies:    b.GetPolicies(),
+		AgingPolicy: b.GetAgingPolicy(),
+	}
+}
+
+// list the buildings the server runs
+func ListBuildings(c *gin.Context) {
+	all := allBuildings()
+	summaries := make([]buildingSummary, 0, len(all))
+	for _, b := range all {
+		summaries = append(summaries, buildingSummary{ID: b.ID, Floors: len(b.GetFloors()), Elevators: b.GetElevatorIDs()})
+	}
+	c.JSON(http.StatusOK, summaries)
+}
+
+// get a building and how it's set up
+func ShowBuilding(c *gin.Context) {
+	c.JSON(http.StatusOK, newBuildingResource(requestBuilding(c)))
+}
+
+// body for changing a building, floors is the new total and can only go up
+type buildingPatch struct {
+	Floors   *int    `json:"floors"`
+	Dispatch *string `json:"dispatch"`
+}
+
+// add floors or change how hall calls are dispatched
+func PatchBuilding(c *gin.Context) {
+	errloc := "patchbuilding"
+	var body buildingPatch
+	if !bindBody(c, errloc, &body) {
+		return
+	}
+	if body.Floors == nil && body.Dispatch == nil {
+		handleError(c, errloc, fmt.Errorf("nothing to change, set floors or dispatch"))
+		return
+	}
+	apply := func(b *building.Building) error {
+		if body.Floors != nil {
+			if err := b.ExtendFloors(*body.Floors); err != nil {
+				return err
+			}
+		}
+		if body.Dispatch != nil {
+			return b.SetDispatchStrategy(building.DispatchStrategy(*body.Dispatch))
+		}
+		return nil
+	}
+	if dryRun(c) {
+		previewCommand(c, errloc, apply)
+		return
+	}
+	b := requestBuilding(c)
+	before := auditState(b, -1)
+	if err := apply(b); err != nil {
+		handleError(c, errloc, err)
+		return
+	}
+	if body.Floors != nil {
+		log.Info(fmt.Sprintf("Building %d was extended to %d floors.", b.ID, *body.Floors))
+		recordAudit(c, "extendFloors", -1, before, c.Query("reason"), nil)
+	}
+	if body.Dispatch != nil {
+		log.Info(fmt.Sprintf("Building %d dispatch set to %s.", b.ID, *body.Dispatch))
+		recordAudit(c, "dispatchStrategy", -1, before, c.Query("reason"), nil)
+	}
+	c.JSON(http.StatusOK, newBuildingResource(b))
+}
+
+// get one car
+func ShowElevator(c *gin.Context) {
+	elevatorID, ok := pathInt(c, "showelev", "elevator")
+	if !ok {
+		return
+	}
+	respondElevator(c, "showelev", http.StatusOK, elevatorID)
+}
+
+// put a new car into service, the body is a commissionRequest
+func CreateElevator(c *gin.Context) {
+	errloc := "createelev"
+	var body commissionRequest
+	if !bindBody(c, errloc, &body) {
+		return
+	}
+	elevatorID := -1
+	if body.Elevator != nil {
+		elevatorID = *body.Elevator
+	}
+	spec := building.ElevatorSpec{ServedFloors: body.ServedFloors, Capacity: body.Capacity, Speed: body.Speed}
+	if dryRun(c) {
+		previewCommand(c, errloc, func(sim *building.Building) error {
+			_, err := sim.AddElevator(elevatorID, spec)
+			return err
+		})
+		return
+	}
+	elevatorID, err := requestBuilding(c).AddElevator(elevatorID, spec)
+	if err != nil {
+		handleError(c, errloc, err)
+		return
+	}
+	log.Info(fmt.Sprintf("Elevator %d was commissioned.", elevatorID))
+	recordAudit(c, "commissionElevator", elevatorID, nil, c.Query("reason"), nil)
+	respondElevator(c, errloc, http.StatusCreated, elevatorID)
+}
+
+// take a drained car out of the building for good, answers with the car as it was
+func DeleteElevator(c *gin.Context) {
+	errloc := "deleteelev"
+	elevatorID, ok := pathInt(c, errloc, "elevator")
+	if !ok {
+		return
+	}
+	if dryRun(c) {
+		previewCommand(c, errloc, func(sim *building.Building) error {
+			return sim.RemoveElevator(elevatorID)
+		})
+		return
+	}
+	b := requestBuilding(c)
+	before := auditState(b, elevatorID)
+	if err := b.RemoveElevator(elevatorID); err != nil {
+		handleError(c, errloc, err)
+		return
+	}
+	log.Info(fmt.Sprintf("Elevator %d was decommissioned.", elevatorID))
+	recordAudit(c, "decommissionElevator", elevatorID, before, c.Query("reason"), nil)
+	c.Data(http.StatusOK, "application/json", before)
+}
+
+// body for changing a car, leave out what isn't changing
+type elevatorPatch struct {
+	InService          *bool `json:"inService"`
+	IndependentService *bool `json:"independentService"`
+}
+
+// takingOutOfService is for approving out of service changes during peak hours
+func takingOutOfService(c *gin.Context) bool {
+	raw, err := peekBody(c)
+	if err != nil {
+		return false
+	}
+	var body elevatorPatch
+	json.Unmarshal(raw, &body)
+	return body.InService != nil && !*body.InService && duringPeak(c)
+}
+
+// take a car out of service, put it back, or switch independent service
+func PatchElevator(c *gin.Context) {
+	errloc := "patchelev"
+	elevatorID, ok := pathInt(c, errloc, "elevator")
+	if !ok {
+		return
+	}
+	var body elevatorPatch
+	if !bindBody(c, errloc, &body) {
+		return
+	}
+	if body.InService == nil && body.IndependentService == nil {
+		handleError(c, errloc, fmt.Errorf("nothing to change, set inService or independentService"))
+		return
+	}
+	// independent service needs the car in service, so it's switched before
+	// the car goes out and after it comes back
+	independentFirst := body.InService != nil && !*body.InService
+	if dryRun(c) {
+		previewCommand(c, errloc, func(sim *building.Building) error {
+			inService := func() error {
+				if body.InService == nil {
+					return nil
+				}
+				// calls that can't be placed don't stop the change
+				if _, applied, err := sim.SetElevatorInServiceStatus(elevatorID, *body.InService); !applied {
+					return err
+				}
+				return nil
+			}
+			independent := func() error {
+				if body.IndependentService == nil {
+					return nil
+				}
+				return sim.SetIndependentService(elevatorID, *body.IndependentService)
+			}
+			steps := []func() error{inService, independent}
+			if independentFirst {
+				steps = []func() error{independent, inService}
+			}
+			for _, step := range steps {
+				if err := step(); err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+		return
+	}
+	b := requestBuilding(c)
+	var reassigned []building.Reassignment
+	var unplaced error
+	inService := func() error {
+		if body.InService == nil {
+			return nil
+		}
+		before := auditState(b, elevatorID)
+		var applied bool
+		var err error
+		reassigned, applied, err = b.SetElevatorInServiceStatus(elevatorID, *body.InService)
+		if !applied {
+			return err
+		}
+		unplaced = err
+		action := "elevatorBackInService"
+		if !*body.InService {
+			action = "takeElevatorOutOfService"
+		}
+		log.Info(fmt.Sprintf("Elevator %d in service set to %t. %d hall calls were impacted.", elevatorID, *body.InService, len(reassigned)))
+		recordAudit(c, action, elevatorID, before, c.Query("reason"), err)
+		return nil
+	}
+	independent := func() error {
+		if body.IndependentService == nil {
+			return nil
+		}
+		before := auditState(b, elevatorID)
+		if err := b.SetIndependentService(elevatorID, *body.IndependentService); err != nil {
+			return err
+		}
+		log.Info(fmt.Sprintf("Elevator %d independent service set to %t.", elevatorID, *body.IndependentService))
+		recordAudit(c, "independentService", elevatorID, before, c.Query("reason"), nil)
+		return nil
+	}
+	steps := []func() error{inService, independent}
+	if independentFirst {
+		steps = []func() error{independent, inService}
+	}
+	for _, step := range steps {
+		if err := step(); err != nil {
+			handleError(c, errloc, err)
+			return
+		}
+	}
+	if unplaced != nil {
+		respondCarChange(c, errloc, elevatorID, reassigned, unplaced)
+		return
+	}
+	respondElevator(c, errloc, http.StatusOK, elevatorID)
+}
+
+// reset a car -- back to the ground floor with its calls cleared
+func CreateElevatorReset(c *gin.Context) {
+	errloc := "resetelev"
+	elevatorID, ok := pathInt(c, errloc, "elevator")
+	if !ok {
+		return
+	}
+	if dryRun(c) {
+		previewCommand(c, errloc, func(sim *building.Building) error {
+			// a reset goes ahead even when some calls can't be placed
+			if _, applied, err := sim.ResetElevator(elevatorID); !applied {
+				return err
+			}
+			return nil
+		})
+		return
+	}
+	b := requestBuilding(c)
+	before := auditState(b, elevatorID)
+	reassigned, applied, err := b.ResetElevator(elevatorID)
+	if !applied {
+		handleError(c, errloc, err)
+		return
+	}
+	log.Info(fmt.Sprintf("Elevator %d was reset. %d hall calls were impacted.", elevatorID, len(reassigned)))
+	recordAudit(c, "resetElevator", elevatorID, before, c.Query("reason"), err)
+	respondCarChange(c, errloc, elevatorID, reassigned, err)
+}
+
+// drain a car -- hand off its hall calls, finish its car calls, then go out of service
+func CreateElevatorDrain(c *gin.Context) {
+	errloc := "drainelev"
+	elevatorID, ok := pathInt(c, errloc, "elevator")
+	if !ok {
+		return
+	}
+	if dryRun(c) {
+		previewCommand(c, errloc, func(sim *building.Building) error {
+			_, err := sim.DrainElevator(elevatorID)
+			return err
+		})
+		return
+	}
+	b := requestBuilding(c)
+	before := auditState(b, elevatorID)
+	reassigned, err := b.DrainElevator(elevatorID)
+	if err != nil {
+		handleError(c, errloc, err)
+		return
+	}
+	log.Info(fmt.Sprintf("Elevator %d is draining. %d hall calls were reassigned.", elevatorID, len(reassigned)))
+	recordAudit(c, "drainElevator", elevatorID, before, c.Query("reason"), nil)
+	respondCarChange(c, errloc, elevatorID, reassigned, nil)
+}
+
+// body for a call to a floor, direction is 1 for up and -1 for down
+type callRequest struct {
+	Floor     int `json:"floor" binding:"required"`
+	Direction int `json:"direction"`
+}
+
+// send a car to a floor ahead of everything else, direction is required
+func CreateOverrideCall(c *gin.Context) {
+	errloc := "maintoverride"
+	elevatorID, ok := pathInt(c, errloc, "elevator")
+	if !ok {
+		return
+	}
+	var body callRequest
+	if !bindBody(c, errloc, &body) {
+		return
+	}
+	if dryRun(c) {
+		previewCommand(c, errloc, func(sim *building.Building) error {
+			return sim.MaintenanceCallOverride(elevatorID, body.Floor, body.Direction)
+		})
+		return
+	}
+	b := requestBuilding(c)
+	before := auditState(b, elevatorID)
+	if err := b.MaintenanceCallOverride(elevatorID, body.Floor, body.Direction); err != nil {
+		handleError(c, errloc, err)
+		return
+	}
+	log.Info(fmt.Sprintf("Maintenance override: elevator %d was called to floor %d", elevatorID, body.Floor))
+	recordAudit(c, "maintenanceCallOverride", elevatorID, before, c.Query("reason"), nil)
+	respondElevator(c, errloc, http.StatusOK, elevatorID)
+}
+
+// push a floor button in a car, answers with the call ticket
+func CreateCarCall(c *gin.Context) {
+	errloc := "pushdest"
+	elevatorID, ok := pathInt(c, errloc, "elevator")
+	if !ok {
+		return
+	}
+	var body callRequest
+	if !bindBody(c, errloc, &body) {
+		return
+	}
+	ticket, err := requestBuilding(c).RegisterCarCall(elevatorID, body.Floor)
+	if err != nil {
+		handleError(c, errloc, err)
+		return
+	}
+	log.Info(fmt.Sprintf("Elevator %d was called to floor %d.", elevatorID, body.Floor))
+	c.JSON(http.StatusCreated, ticket)
+}
+
+// cancel a car call, answers with the car
+func DeleteCarCall(c *gin.Context) {
+	errloc := "cancelcarcall"
+	elevatorID, ok := pathInt(c, errloc, "elevator")
+	if !ok {
+		return
+	}
+	floor, ok := pathInt(c, errloc, "floor")
+	if !ok {
+		return
+	}
+	if err := requestBuilding(c).CancelCarCall(elevatorID, floor, c.Query("reason")); err != nil {
+		handleError(c, errloc, err)
+		return
+	}
+	log.Info(fmt.Sprintf("Elevator %d call to floor %d was cancelled.", elevatorID, floor))
+	respondElevator(c, errloc, http.StatusOK, elevatorID)
+}
+
+// push a hall call button, answers with the call ticket
+func CreateHallCall(c *gin.Context) {
+	errloc := "callelev"
+	var body callRequest
+	if !bindBody(c, errloc, &body) {
+		return
+	}
+	ticket, err := requestBuilding(c).RegisterHallCall(body.Floor, body.Direction)
+	if err != nil {
+		handleError(c, errloc, err)
+		return
+	}
+	log.Info(fmt.Sprintf("Elevator %d was called to floor %d in direction %d.", ticket.ElevatorID, body.Floor, body.Direction))
+	c.JSON(http.StatusCreated, ticket)
+}
+
+// body for moving a hall call to another car
+type hallCallPatch struct {
+	Elevator *int `json:"elevator" binding:"required"`
+}
+
+// move an outstanding hall call to a different car, answers with the hall call
+func PatchHallCall(c *gin.Context) {
+	errloc := "reassignhallcall"
+	floor, ok := pathInt(c, errloc, "floor")
+	if !ok {
+		return
+	}
+	direction, ok := pathInt(c, errloc, "direction")
+	if !ok {
+		return
+	}
+	var body hallCallPatch
+	if !bindBody(c, errloc, &body) {
+		return
+	}
+	elevatorID := *body.Elevator
+	if dryRun(c) {
+		previewCommand(c, errloc, func(sim *building.Building) error {
+			return sim.ReassignHallCall(floor, direction, elevatorID)
+		})
+		return
+	}
+	b := requestBuilding(c)
+	before := auditState(b, -1)
+	if err := b.ReassignHallCall(floor, direction, elevatorID); err != nil {
+		handleError(c, errloc, err)
+		return
+	}
+	log.Info(fmt.Sprintf("Hall call at floor %d direction %d was moved to elevator %d.", floor, direction, elevatorID))
+	recordAudit(c, "reassignHallCall", -1, before, c.Query("reason"), nil)
+	for _, hc := range b.GetHallCalls() {
+		if hc.Floor == floor && hc.Direction == direction {
+			c.JSON(http.StatusOK, hc)
+			return
+		}
+	}
+	// answered in the meantime
+	c.Status(http.StatusNoContent)
+}
+
+// cancel a hall call
+func DeleteHallCall(c *gin.Context) {
+	errloc := "cancelhallcall"
+	floor, ok := pathInt(c, errloc, "floor")
+	if !ok {
+		return
+	}
+	direction, ok := pathInt(c, errloc, "direction")
+	if !ok {
+		return
+	}
+	if err := requestBuilding(c).CancelHallCall(floor, direction, c.Query("reason")); err != nil {
+		handleError(c, errloc, err)
+		return
+	}
+	log.Info(fmt.Sprintf("Hall call at floor %d direction %d was cancelled.", floor, direction))
+	c.Status(http.StatusNoContent)
+}
+
+// body for a priority call, the key goes in the X-Priority-Key header
+type priorityCallRequest struct {
+	Floor       int    `json:"floor" binding:"required"`
+	Destination int    `json:"destination" binding:"required"`
+	RequestedBy string `json:"requestedBy" binding:"required"`
+	Reason      string `json:"reason"`
+}
+
+// send a car straight from one floor to another, answers with the log record
+func CreatePriorityCall(c *gin.Context) {
+	errloc := "prioritycall"
+	var body priorityCallRequest
+	if !bindBody(c, errloc, &body) {
+		return
+	}
+	record, err := requestBuilding(c).PriorityCall(body.Floor, body.Destination, c.GetHeader("X-Priority-Key"), body.RequestedBy, body.Reason)
+	if err != nil {
+		handleError(c, errloc, err)
+		return
+	}
+	log.Warn(fmt.Sprintf("Priority call: elevator %d sent from floor %d to floor %d for %s.", record.ElevatorID, body.Floor, body.Destination, record.RequestedBy))
+	c.JSON(http.StatusCreated, record)
+}
+
+// body for the aging policy, maxWait is a duration like 90s
+type agingPolicyRequest struct {
+	MaxWait config.Duration `json:"maxWait"`
+	Weight  float64         `json:"weight"`
+}
+
+// set how cars pick their next stop, answers with the policy
+func PutAgingPolicy(c *gin.Context) {
+	errloc := "agingpolicy"
+	var body agingPolicyRequest
+	if !bindBody(c, errloc, &body) {
+		return
+	}
+	policy := elevator.AgingPolicy{MaxWait: time.Duration(body.MaxWait), Weight: body.Weight}
+	if dryRun(c) {
+		previewCommand(c, errloc, func(sim *building.Building) error {
+			return sim.SetAgingPolicy(policy)
+		})
+		return
+	}
+	b := requestBuilding(c)
+	before, _ := json.Marshal(b.GetAgingPolicy())
+	if err := b.SetAgingPolicy(policy); err != nil {
+		handleError(c, errloc, err)
+		return
+	}
+	log.Info(fmt.Sprintf("Aging policy set to max wait %s weight %g.", policy.MaxWait, policy.Weight))
+	recordAudit(c, "agingPolicy", -1, before, c.Query("reason"), nil)
+	c.JSON(http.StatusOK, b.GetAgingPolicy())
+}
+
+// body for a smoke detector
+type smokeDetectorRequest struct {
+	Active *bool `json:"active" binding:"required"`
+}
+
+// set the state of the smoke detector on a floor
+func PutSmokeDetector(c *gin.Context) {
+	errloc := "smokedetector"
+	floor, ok := pathInt(c, errloc, "floor")
+	if !ok {
+		return
+	}
+	var body smokeDetectorRequest
+	if !bindBody(c, errloc, &body) {
+		return
+	}
+	if dryRun(c) {
+		previewCommand(c, errloc, func(sim *building.Building) error {
+			return sim.SetSmokeDetector(floor, *body.Active)
+		})
+		return
+	}
+	if err := requestBuilding(c).SetSmokeDetector(floor, *body.Active); err != nil {
+		handleError(c, errloc, err)
+		return
+	}
+	log.Info(fmt.Sprintf("Smoke detector on floor %d set to %t.", floor, *body.Active))
+	c.JSON(http.StatusOK, gin.H{"floor": floor, "active": *body.Active})
+}
+
+// fire service phase I -- recall all cars to the recall floor
+func CreateFireRecall(c *gin.Context) {
+	errloc := "firerecall"
+	if dryRun(c) {
+		previewCommand(c, errloc, func(sim *building.Building) error {
+			_, err := sim.ActivateFireRecall()
+			return err
+		})
+		return
+	}
+	b := requestBuilding(c)
+	before := auditState(b, -1)
+	recallFloor, err := b.ActivateFireRecall()
+	if err != nil {
+		handleError(c, errloc, err)
+		return
+	}
+	log.Warn(fmt.Sprintf("Fire recall activated, cars recalled to floor %d.", recallFloor))
+	recordAudit(c, "fireRecall", -1, before, c.Query("reason"), nil)
+	c.JSON(http.StatusCreated, gin.H{"recallFloor": recallFloor})
+}
+
+// reset fire recall
+func DeleteFireRecall(c *gin.Context) {
+	errloc := "resetfirerecall"
+	if dryRun(c) {
+		previewCommand(c, errloc, func(sim *building.Building) error {
+			return sim.ResetFireRecall()
+		})
+		return
+	}
+	b := requestBuilding(c)
+	before := auditState(b, -1)
+	if err := b.ResetFireRecall(); err != nil {
+		handleError(c, errloc, err)
+		return
+	}
+	log.Warn("Fire recall was reset.")
+	recordAudit(c, "resetFireRecall", -1, before, c.Query("reason"), nil)
+	c.Status(http.StatusNoContent)
+}
+
+// body for switching something on or off
+type switchRequest struct {
+	On *bool `json:"on" binding:"required"`
+}
+
+// fire service phase II -- switch a recalled car in or out of firefighter service
+func PutFirefighterService(c *gin.Context) {
+	errloc := "firefighterservice"
+	elevatorID, ok := pathInt(c, errloc, "elevator")
+	if !ok {
+		return
+	}
+	var body switchRequest
+	if !bindBody(c, errloc, &body) {
+		return
+	}
+	b := requestBuilding(c)
+	before := auditState(b, elevatorID)
+	if err := b.SetFirefighterService(elevatorID, *body.On, c.GetHeader("X-Fire-Service-Key")); err != nil {
+		handleError(c, errloc, err)
+		return
+	}
+	log.Warn(fmt.Sprintf("Elevator %d firefighter service set to %t.", elevatorID, *body.On))
+	recordAudit(c, "firefighterService", elevatorID, before, c.Query("reason"), nil)
+	respondElevator(c, errloc, http.StatusOK, elevatorID)
+}
+
+// fire service phase II -- car call from the firefighter key
+func CreateFirefighterCall(c *gin.Context) {
+	errloc := "firefightercall"
+	elevatorID, ok := pathInt(c, errloc, "elevator")
+	if !ok {
+		return
+	}
+	var body callRequest
+	if !bindBody(c, errloc, &body) {
+		return
+	}
+	if err := requestBuilding(c).FirefighterCarCall(elevatorID, body.Floor, c.GetHeader("X-Fire-Service-Key")); err != nil {
+		handleError(c, errloc, err)
+		return
+	}
+	log.Info(fmt.Sprintf("Firefighter call: elevator %d was called to floor %d.", elevatorID, body.Floor))
+	respondElevator(c, errloc, http.StatusOK, elevatorID)
+}
+
+// fire service phase II -- constant pressure door open button, on while pressed
+func PutFirefighterDoor(c *gin.Context) {
+	errloc := "firefighterdoor"
+	elevatorID, ok := pathInt(c, errloc, "elevator")
+	if !ok {
+		return
+	}
+	var body switchRequest
+	if !bindBody(c, errloc, &body) {
+		return
+	}
+	if err := requestBuilding(c).FirefighterDoorButton(elevatorID, *body.On, c.GetHeader("X-Fire-Service-Key")); err != nil {
+		handleError(c, errloc, err)
+		return
+	}
+	respondElevator(c, errloc, http.StatusOK, elevatorID)
+}
+
+// body for emergency power, runningCars to start it and elevators to pick the cars
+type emergencyPowerRequest struct {
+	RunningCars *int  `json:"runningCars"`
+	Elevators   []int `json:"elevators"`
+}
+
+// put the building on emergency power with a limited number of running cars
+func CreateEmergencyPower(c *gin.Context) {
+	errloc := "emergencypower"
+	var body emergencyPowerRequest
+	if !bindBody(c, errloc, &body) {
+		return
+	}
+	if body.RunningCars == nil {
+		handleError(c, errloc, fmt.Errorf("runningCars is required"))
+		return
+	}
+	if dryRun(c) {
+		previewCommand(c, errloc, func(sim *building.Building) error {
+			return sim.ActivateEmergencyPower(*body.RunningCars)
+		})
+		return
+	}
+	b := requestBuilding(c)
+	before := auditState(b, -1)
+	if err := b.ActivateEmergencyPower(*body.RunningCars); err != nil {
+		handleError(c, errloc, err)
+		return
+	}
+	log.Warn(fmt.Sprintf("Emergency power activated, %d cars will run.", *body.RunningCars))
+	recordAudit(c, "emergencyPower", -1, before, c.Query("reason"), nil)
+	c.JSON(http.StatusCreated, b.GetEmergencyPowerStatus())
+}
+
+// pick which cars run on emergency power
+func PatchEmergencyPower(c *gin.Context) {
+	errloc := "emergencypowercars"
+	var body emergencyPowerRequest
+	if !bindBody(c, errloc, &body) {
+		return
+	}
+	if body.Elevators == nil {
+		handleError(c, errloc, fmt.Errorf("elevators is required"))
+		return
+	}
+	if dryRun(c) {
+		previewCommand(c, errloc, func(sim *building.Building) error {
+			return sim.SetEmergencyPowerCars(body.Elevators)
+		})
+		return
+	}
+	b := requestBuilding(c)
+	before := auditState(b, -1)
+	if err := b.SetEmergencyPowerCars(body.Elevators); err != nil {
+		handleError(c, errloc, err)
+		return
+	}
+	log.Info(fmt.Sprintf("Emergency power cars set to %v.", body.Elevators))
+	recordAudit(c, "emergencyPowerCars", -1, before, c.Query("reason"), nil)
+	c.JSON(http.StatusOK, b.GetEmergencyPowerStatus())
+}
+
+// take the building off emergency power
+func DeleteEmergencyPower(c *gin.Context) {
+	errloc := "endemergencypower"
+	if dryRun(c) {
+		previewCommand(c, errloc, func(sim *building.Building) error {
+			return sim.EndEmergencyPower()
+		})
+		return
+	}
+	b := requestBuilding(c)
+	before := auditState(b, -1)
+	if err := b.EndEmergencyPower(); err != nil {
+		handleError(c, errloc, err)
+		return
+	}
+	log.Warn("Emergency power ended.")
+	recordAudit(c, "endEmergencyPower", -1, before, c.Query("reason"), nil)
+	c.JSON(http.StatusOK, b.GetEmergencyPowerStatus())
+}
+
+// setupV1 adds the v1 routes
+func setupV1(router *gin.Engine) {
+	v1 := router.Group("/v1")
+	v1.GET("/buildings", ListBuildings)
+
+	// server-wide maintenance, each handler keeps to the caller's buildings
+	admin := v1.Group("/", requireServerRole(RoleTechnician), checkDryRun())
+	admin.GET("/approvals", GetApprovals)
+	admin.POST("/approvals/:id/approve", ApproveChange(router))
+	admin.POST("/approvals/:id/reject", RejectChange)
+	admin.GET("/audit-log", requireServerRole(RoleSupervisor), GetAuditLog)
+	admin.GET("/audit-log/verify", requireServerRole(RoleSupervisor), VerifyAuditLog)
+	admin.POST("/config/reload", requireServerRole(RoleSupervisor), ReloadConfig)
+
+	// passengers, the hall and car panels and anything that only looks
+	b := v1.Group("/buildings/:building", withBuilding())
+	b.GET("", ShowBuilding)
+	b.GET("/floors", GetFloors)
+	b.GET("/capacity", GetCapacity)
+	b.GET("/health", GetHealth)
+	b.GET("/elevators", GetAllElevatorState)
+	b.GET("/elevators/:elevator", ShowElevator)
+	b.POST("/elevators/:elevator/car-calls", CreateCarCall)
+	b.DELETE("/elevators/:elevator/car-calls/:floor", DeleteCarCall)
+	b.GET("/hall-calls", GetHallCalls)
+	b.GET("/hall-calls/overdue", GetOverdueCalls)
+	b.POST("/hall-calls", CreateHallCall)
+	b.DELETE("/hall-calls/:floor/:direction", DeleteHallCall)
+	b.GET("/calls/:id", GetCall)
+	b.GET("/calls/:id/stream", StreamCall)
+	b.POST("/priority-calls", CreatePriorityCall)
+
+	// fire service Phase II, on the firefighters' key rather than an API key
+	b.PUT("/elevators/:elevator/firefighter-service", PutFirefighterService)
+	b.POST("/elevators/:elevator/firefighter-calls", CreateFirefighterCall)
+	b.PUT("/elevators/:elevator/firefighter-door", PutFirefighterDoor)
+	b.GET("/emergency-power", GetEmergencyPowerStatus)
+
+	// fire recall and emergency power take the whole building over, technicians and up
+	safety := b.Group("", requireRole(RoleTechnician), checkDryRun())
+	safety.PUT("/floors/:floor/smoke-detector", PutSmokeDetector)
+	safety.POST("/fire-recall", CreateFireRecall)
+	safety.DELETE("/fire-recall", DeleteFireRecall)
+	safety.POST("/emergency-power", CreateEmergencyPower)
+	safety.PATCH("/emergency-power", PatchEmergencyPower)
+	safety.DELETE("/emergency-power", DeleteEmergencyPower)
+
+	// maintenance, technicians and up
+	maint := b.Group("", requireRole(RoleTechnician), checkDryRun())
+	maint.PATCH("", requireRole(RoleSupervisor), PatchBuilding)
+	maint.POST("/elevators", requireRole(RoleSupervisor), CreateElevator)
+	maint.DELETE("/elevators/:elevator", requireRole(RoleSupervisor), DeleteElevator)
+	maint.PATCH("/elevators/:elevator", requireApproval("takeElevatorOutOfService", RoleTechnician, takingOutOfService), PatchElevator)
+	maint.POST("/elevators/:elevator/reset", requireRole(RoleSupervisor), requireApproval("resetElevator", RoleSupervisor, nil), CreateElevatorReset)
+	maint.POST("/elevators/:elevator/drain", requireApproval("takeElevatorOutOfService", RoleTechnician, duringPeak), CreateElevatorDrain)
+	maint.POST("/elevators/:elevator/override-calls", CreateOverrideCall)
+	maint.PATCH("/hall-calls/:floor/:direction", PatchHallCall)
+	maint.GET("/priority-calls", GetPriorityCallLog)
+	maint.GET("/aging-policy", GetAgingPolicy)
+	maint.PUT("/aging-policy", requireRole(RoleSupervisor), requireApproval("agingPolicy", RoleSupervisor, nil), PutAgingPolicy)
+	maint.GET("/maintenance-windows", GetMaintenanceWindows)
+	maint.POST("/maintenance-windows", requireApproval("scheduleMaintenance", RoleTechnician, windowDuringPeak), ScheduleMaintenance)
+}
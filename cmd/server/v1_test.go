@@ -0,0 +1,234 @@
+package main
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/tcotav/elevatormgr/building"
+	"github.com/tcotav/elevatormgr/elevator"
+)
+
+// useBuildings runs the server on fresh buildings 1 and 2 until the test is done
+func useBuildings(t *testing.T) (*building.Building, *building.Building) {
+	savedBld, saved := bld, buildings
+	first, second := building.NewBuilding(1, 10, 3), building.NewBuilding(2, 5, 1)
+	bld = first
+	buildingsMu.Lock()
+	buildings = map[int]*building.Building{1: first, 2: second}
+	buildingsMu.Unlock()
+	t.Cleanup(func() {
+		bld = savedBld
+		buildingsMu.Lock()
+		buildings = saved
+		buildingsMu.Unlock()
+	})
+	return first, second
+}
+
+func serveV1(router *gin.Engine, method string, path string, body string) *httptest.ResponseRecorder {
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest(method, path, strings.NewReader(body))
+	authorize(req)
+	router.ServeHTTP(w, req)
+	return w
+}
+
+func TestV1Buildings(t *testing.T) {
+	_, second := useBuildings(t)
+	router := setupRouter()
+
+	w := serveV1(router, "GET", "/v1/buildings", "")
+	var summaries []buildingSummary
+	json.Unmarshal(w.Body.Bytes(), &summaries)
+	if w.Code != http.StatusOK || len(summaries) != 2 || summaries[1].Floors != 5 {
+		t.Errorf("Expected both buildings listed, got %d %s", w.Code, w.Body.String())
+	}
+
+	w = serveV1(router, "GET", "/v1/buildings/3/elevators", "")
+	if w.Code != http.StatusNotFound {
+		t.Errorf("Building 3 does not exist, expected status code 404, got %d", w.Code)
+	}
+	w = serveV1(router, "GET", "/v1/buildings/x", "")
+	if w.Code != http.StatusBadRequest {
+		t.Errorf("Expected status code 400 for a bad building, got %d", w.Code)
+	}
+
+	// calls go to the building in the path
+	w = serveV1(router, "POST", "/v1/buildings/2/hall-calls", `{"floor": 3, "direction": 1}`)
+	if w.Code != http.StatusCreated || len(second.GetHallCalls()) != 1 || len(bld.GetHallCalls()) != 0 {
+		t.Errorf("Hall call should be made in building 2, got %d %s", w.Code, w.Body.String())
+	}
+	w = serveV1(router, "POST", "/v1/buildings/2/hall-calls", `{"direction": 1}`)
+	if w.Code != http.StatusBadRequest {
+		t.Errorf("A hall call needs a floor, expected status code 400, got %d", w.Code)
+	}
+
+	// the test keys only look after building 1
+	w = serveV1(router, "PATCH", "/v1/buildings/2", `{"floors": 8}`)
+	if w.Code != http.StatusForbidden {
+		t.Errorf("Expected status code 403 for another building, got %d", w.Code)
+	}
+	w = serveV1(router, "PATCH", "/v1/buildings/1", `{"floors": 12, "dispatch": "shortest-wait"}`)
+	var resource buildingResource
+	json.Unmarshal(w.Body.Bytes(), &resource)
+	if w.Code != http.StatusOK || len(resource.Floors) != 12 || resource.Dispatch != building.DispatchShortestWait {
+		t.Errorf("Building 1 should have 12 floors and shortest-wait dispatch, got %d %s", w.Code, w.Body.String())
+	}
+	w = serveV1(router, "PATCH", "/v1/buildings/1", `{}`)
+	if w.Code != http.StatusBadRequest {
+		t.Errorf("Expected status code 400 with nothing to change, got %d", w.Code)
+	}
+}
+
+func TestV1Elevators(t *testing.T) {
+	first, _ := useBuildings(t)
+	router := setupRouter()
+
+	w := serveV1(router, "POST", "/v1/buildings/1/elevators/0/car-calls", `{"floor": 7}`)
+	var ticket building.Ticket
+	json.Unmarshal(w.Body.Bytes(), &ticket)
+	if w.Code != http.StatusCreated || ticket.ElevatorID != 0 || ticket.ID == "" {
+		t.Errorf("Expected a ticket for elevator 0, got %d %s", w.Code, w.Body.String())
+	}
+	w = serveV1(router, "DELETE", "/v1/buildings/1/elevators/0/car-calls/7", "")
+	var state elevator.Elevator
+	json.Unmarshal(w.Body.Bytes(), &state)
+	if w.Code != http.StatusOK || state.ElevatorID != 0 {
+		t.Errorf("Expected elevator 0 back after cancelling, got %d %s", w.Code, w.Body.String())
+	}
+
+	w = serveV1(router, "PATCH", "/v1/buildings/1/elevators/2", `{"inService": false, "independentService": false}`)
+	json.Unmarshal(w.Body.Bytes(), &state)
+	if w.Code != http.StatusOK || state.InService || first.GetElevator(2).InService {
+		t.Errorf("Elevator 2 should be out of service, got %d %s", w.Code, w.Body.String())
+	}
+	w = serveV1(router, "PATCH", "/v1/buildings/1/elevators/2", `{"inService": "no"}`)
+	if w.Code != http.StatusBadRequest {
+		t.Errorf("Expected status code 400 for a bad body, got %d", w.Code)
+	}
+
+	w = serveV1(router, "DELETE", "/v1/buildings/1/elevators/2", "")
+	if w.Code != http.StatusOK || first.GetElevator(2) != nil {
+		t.Errorf("Elevator 2 should be decommissioned, got %d %s", w.Code, w.Body.String())
+	}
+	w = serveV1(router, "POST", "/v1/buildings/1/elevators", `{"servedFloors": [1, 10]}`)
+	json.Unmarshal(w.Body.Bytes(), &state)
+	if w.Code != http.StatusCreated || state.ElevatorID != 2 || len(state.ServedFloors) != 2 {
+		t.Errorf("Expected elevator 2 commissioned for floors 1 and 10, got %d %s", w.Code, w.Body.String())
+	}
+	w = serveV1(router, "GET", "/v1/buildings/1/elevators/9", "")
+	if w.Code != http.StatusNotFound {
+		t.Errorf("Elevator 9 does not exist, expected status code 404, got %d", w.Code)
+	}
+}
+
+func TestV1Approval(t *testing.T) {
+	first, _ := useBuildings(t)
+	router := setupRouter()
+	clock := approvals.clock
+	approvals.clock = func() time.Time { return time.Date(2024, 3, 1, 8, 30, 0, 0, time.Local) }
+	defer func() { approvals.clock = clock }()
+
+	// putting a car back never needs approving
+	w := serveV1(router, "PATCH", "/v1/buildings/1/elevators/1", `{"inService": true}`)
+	if w.Code != http.StatusOK {
+		t.Errorf("Expected status code 200 putting a car in service, got %d", w.Code)
+	}
+
+	// the parked change keeps its body and runs with it
+	w = serveV1(router, "PATCH", "/v1/buildings/1/elevators/1", `{"inService": false}`)
+	p := pendingChange(t, w)
+	if string(p.Body) != `{"inService":false}` {
+		t.Errorf("Pending change should keep the body, got %s", p.Body)
+	}
+	if !first.GetElevator(1).InService {
+		t.Errorf("Elevator 1 should stay in service until approved")
+	}
+	w = httptest.NewRecorder()
+	req, _ := http.NewRequest("POST", "/v1/approvals/"+p.ID+"/approve", nil)
+	req.Header.Set("Authorization", "Bearer "+testApproverToken)
+	router.ServeHTTP(w, req)
+	if w.Code != http.StatusOK || first.GetElevator(1).InService {
+		t.Errorf("Elevator 1 should be out of service once approved, got %d %s", w.Code, w.Body.String())
+	}
+
+	w = serveV1(router, "PUT", "/v1/buildings/1/aging-policy", `{"maxWait": "45s", "weight": 0.5}`)
+	p = pendingChange(t, w)
+	if w = decideChange(router, p.ID, "approve", testApproverToken); w.Code != http.StatusOK {
+		t.Errorf("Expected status code 200 from the approved change, got %d %s", w.Code, w.Body.String())
+	}
+	if policy := first.GetAgingPolicy(); policy.MaxWait != 45*time.Second || policy.Weight != 0.5 {
+		t.Errorf("Aging policy should be 45s and 0.5, got %+v", policy)
+	}
+}
+
+func TestV1FireServiceAndEmergencyPower(t *testing.T) {
+	first, second := useBuildings(t)
+	first.SetFireServiceKey("firekey")
+	router := setupRouter()
+
+	// pulling the whole building needs a technician key for that building
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("POST", "/v1/buildings/1/fire-recall", nil)
+	req.Header.Set("X-Fire-Service-Key", "firekey")
+	router.ServeHTTP(w, req)
+	if w.Code != http.StatusUnauthorized || first.FireRecall {
+		t.Errorf("Fire recall without an API key should be refused, got %d", w.Code)
+	}
+	w = serveV1(router, "POST", "/v1/buildings/2/emergency-power", `{"runningCars": 1}`)
+	if w.Code != http.StatusForbidden || second.EmergencyPower {
+		t.Errorf("Emergency power in another building should be refused, got %d", w.Code)
+	}
+	w = serveV1(router, "PUT", "/v1/buildings/2/floors/1/smoke-detector", `{"active": true}`)
+	if w.Code != http.StatusForbidden {
+		t.Errorf("Smoke detector in another building should be refused, got %d", w.Code)
+	}
+	w = serveV1(router, "POST", "/v1/buildings/1/fire-recall", "")
+	if w.Code != http.StatusCreated || !first.FireRecall {
+		t.Errorf("Fire recall should be activated, got %d %s", w.Code, w.Body.String())
+	}
+
+	// Phase II is still on the fire key alone
+	w = httptest.NewRecorder()
+	req, _ = http.NewRequest("PUT", "/v1/buildings/1/elevators/0/firefighter-service", strings.NewReader(`{"on": true}`))
+	req.Header.Set("X-Fire-Service-Key", "firekey")
+	router.ServeHTTP(w, req)
+	if w.Code != http.StatusOK {
+		t.Errorf("Firefighter service should start on the fire key, got %d %s", w.Code, w.Body.String())
+	}
+	w = httptest.NewRecorder()
+	req, _ = http.NewRequest("PUT", "/v1/buildings/1/elevators/0/firefighter-service", strings.NewReader(`{"on": false}`))
+	req.Header.Set("X-Fire-Service-Key", "firekey")
+	router.ServeHTTP(w, req)
+
+	w = serveV1(router, "DELETE", "/v1/buildings/1/fire-recall", "")
+	if w.Code != http.StatusNoContent || first.FireRecall {
+		t.Errorf("Fire recall should be reset, got %d %s", w.Code, w.Body.String())
+	}
+}
+
+func TestDeprecatedRoutes(t *testing.T) {
+	router := setupRouter()
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("POST", "/cancelCarCall/1/7", nil)
+	router.ServeHTTP(w, req)
+	if w.Header().Get("Deprecation") == "" {
+		t.Errorf("Old routes should carry a Deprecation header")
+	}
+	if link := w.Header().Get("Link"); link != `</v1/buildings/1/elevators/1/car-calls/7>; rel="successor-version"` {
+		t.Errorf("Link should point at the v1 route, got %s", link)
+	}
+
+	w = httptest.NewRecorder()
+	req, _ = http.NewRequest("GET", "/v1/buildings/1/floors", nil)
+	router.ServeHTTP(w, req)
+	if w.Header().Get("Deprecation") != "" {
+		t.Errorf("v1 routes should not be deprecated")
+	}
+}
@@ -0,0 +1,371 @@
+// Package config loads the building layout the server runs from a YAML or
+// JSON file.  Loading is strict -- unknown keys are an error and every problem
+// found is reported with where it is in the file, so a bad config stops the
+// server at startup rather than half working.
+package config
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/tcotav/elevatormgr/building"
+	"github.com/tcotav/elevatormgr/elevator"
+	"gopkg.in/yaml.v3"
+)
+
+// Config is the whole file
+type Config struct {
+	// address the server listens on, ":8077" when empty
+	Listen string `yaml:"listen" json:"listen"`
+	// address the gRPC API listens on, ":8078" when empty
+	GRPCListen string           `yaml:"grpcListen" json:"grpcListen"`
+	Buildings  []BuildingConfig `yaml:"buildings" json:"buildings"`
+}
+
+// BuildingConfig describes one building
+type BuildingConfig struct {
+	ID        int              `yaml:"id" json:"id"`
+	Floors    []FloorConfig    `yaml:"floors" json:"floors"`
+	Elevators []ElevatorConfig `yaml:"elevators" json:"elevators"`
+	Groups    []GroupConfig    `yaml:"groups" json:"groups"`
+	// closest or shortest-wait, closest when empty
+	Dispatch string       `yaml:"dispatch" json:"dispatch"`
+	Policies PolicyConfig `yaml:"policies" json:"policies"`
+}
+
+// FloorConfig is one floor, floors are numbered from 1
+type FloorConfig struct {
+	Number int    `yaml:"number" json:"number"`
+	Label  string `yaml:"label" json:"label"`
+}
+
+// ElevatorConfig is one car
+type ElevatorConfig struct {
+	ID int `yaml:"id" json:"id"`
+	// every floor when empty
+	ServedFloors []int   `yaml:"servedFloors" json:"servedFloors"`
+	Capacity     int     `yaml:"capacity" json:"capacity"` // passengers
+	Speed        float64 `yaml:"speed" json:"speed"`       // metres per second
+}
+
+// GroupConfig is a bank of cars
+type GroupConfig struct {
+	Name      string `yaml:"name" json:"name"`
+	Elevators []int  `yaml:"elevators" json:"elevators"`
+}
+
+// PolicyConfig holds the building's tunables, anything left out keeps the
+// building's default
+type PolicyConfig struct {
+	RecallFloor          int      `yaml:"recallFloor" json:"recallFloor"`
+	AlternateRecallFloor int      `yaml:"alternateRecallFloor" json:"alternateRecallFloor"`
+	LobbyFloor           int      `yaml:"lobbyFloor" json:"lobbyFloor"`
+	MinInService         int      `yaml:"minInService" json:"minInService"`
+	MaxWait              Duration `yaml:"maxWait" json:"maxWait"`
+	ReassignThreshold    Duration `yaml:"reassignThreshold" json:"reassignThreshold"`
+	ReassignHoldTime     Duration `yaml:"reassignHoldTime" json:"reassignHoldTime"`
+	Aging                struct {
+		MaxWait Duration `yaml:"maxWait" json:"maxWait"`
+		Weight  float64  `yaml:"weight" json:"weight"`
+	} `yaml:"aging" json:"aging"`
+}
+
+// Duration is a time.Duration written like "90s" or "2m"
+type Duration time.Duration
+
+func (d *Duration) UnmarshalYAML(node *yaml.Node) error {
+	var s string
+	if err := node.Decode(&s); err != nil {
+		return fmt.Errorf("line %d: duration should be a string like \"90s\"", node.Line)
+	}
+	v, err := time.ParseDuration(s)
+	if err != nil {
+		return fmt.Errorf("line %d: %w", node.Line, err)
+	}
+	*d = Duration(v)
+	return nil
+}
+
+func (d *Duration) UnmarshalJSON(b []byte) error {
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return fmt.Errorf("duration should be a string like \"90s\", got %s", b)
+	}
+	v, err := time.ParseDuration(s)
+	if err != nil {
+		return err
+	}
+	*d = Duration(v)
+	return nil
+}
+
+func (d Duration) MarshalJSON() ([]byte, error) {
+	return json.Marshal(time.Duration(d).String())
+}
+
+// Load reads the config at path, .yaml, .yml or .json, and validates it
+func Load(path string) (*Config, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	cfg, err := Parse(data, filepath.Ext(path))
+	if err != nil {
+		return nil, fmt.Errorf("config %s: %w", path, err)
+	}
+	return cfg, nil
+}
+
+// Parse decodes and validates a config, format is the file extension
+func Parse(data []byte, format string) (*Config, error) {
+	var cfg Config
+	switch strings.ToLower(format) {
+	case ".yaml", ".yml":
+		dec := yaml.NewDecoder(bytes.NewReader(data))
+		dec.KnownFields(true)
+		if err := dec.Decode(&cfg); err != nil {
+			return nil, err
+		}
+	case ".json":
+		dec := json.NewDecoder(bytes.NewReader(data))
+		dec.DisallowUnknownFields()
+		if err := dec.Decode(&cfg); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, fmt.Errorf("unknown config format: %q, use .yaml, .yml or .json", format)
+	}
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+	return &cfg, nil
+}
+
+// ValidationError lists everything wrong with a config
+type ValidationError struct {
+	Problems []string
+}
+
+func (v *ValidationError) Error() string {
+	return fmt.Sprintf("%d problem(s):\n  %s", len(v.Problems), strings.Join(v.Problems, "\n  "))
+}
+
+type problems []string
+
+func (p *problems) add(path string, format string, args ...interface{}) {
+	*p = append(*p, path+": "+fmt.Sprintf(format, args...))
+}
+
+// Validate checks the config hangs together, the error is a *ValidationError
+func (c *Config) Validate() error {
+	var p problems
+	if len(c.Buildings) == 0 {
+		p.add("buildings", "at least one building is needed")
+	}
+	ids := make(map[int]int)
+	for i, b := range c.Buildings {
+		path := fmt.Sprintf("buildings[%d]", i)
+		if first, ok := ids[b.ID]; ok {
+			p.add(path+".id", "building %d is already defined at buildings[%d]", b.ID, first)
+		}
+		ids[b.ID] = i
+		b.validate(path, &p)
+	}
+	if len(p) > 0 {
+		return &ValidationError{Problems: p}
+	}
+	return nil
+}
+
+func (b BuildingConfig) validate(path string, p *problems) {
+	numFloors := len(b.Floors)
+	if numFloors == 0 {
+		p.add(path+".floors", "at least one floor is needed")
+	}
+	labels := make(map[string]int)
+	for i, f := range b.Floors {
+		fpath := fmt.Sprintf("%s.floors[%d]", path, i)
+		if f.Number != i+1 {
+			p.add(fpath+".number", "floors are numbered 1, 2, 3... in order, expected %d got %d", i+1, f.Number)
+		}
+		if f.Label != "" {
+			if other, ok := labels[f.Label]; ok {
+				p.add(fpath+".label", "label %q is already used by floor %d", f.Label, other)
+			}
+			labels[f.Label] = f.Number
+		}
+	}
+	validFloor := func(fpath string, floor int) {
+		if floor < 1 || floor > numFloors {
+			p.add(fpath, "floor %d does not exist, floors are 1 to %d", floor, numFloors)
+		}
+	}
+
+	if len(b.Elevators) == 0 {
+		p.add(path+".elevators", "at least one elevator is needed")
+	}
+	// IDs needn't run in order, cars come and go during a modernization
+	ids := make(map[int]bool)
+	for i, e := range b.Elevators {
+		epath := fmt.Sprintf("%s.elevators[%d]", path, i)
+		if e.ID < 0 {
+			p.add(epath+".id", "elevator IDs can't be negative, got %d", e.ID)
+		} else if ids[e.ID] {
+			p.add(epath+".id", "elevator %d is already defined", e.ID)
+		}
+		ids[e.ID] = true
+		served := make(map[int]bool)
+		for j, floor := range e.ServedFloors {
+			validFloor(fmt.Sprintf("%s.servedFloors[%d]", epath, j), floor)
+			if served[floor] {
+				p.add(fmt.Sprintf("%s.servedFloors[%d]", epath, j), "floor %d is listed twice", floor)
+			}
+			served[floor] = true
+		}
+		if e.Capacity < 0 {
+			p.add(epath+".capacity", "capacity can't be negative, got %d", e.Capacity)
+		}
+		if e.Speed < 0 {
+			p.add(epath+".speed", "speed can't be negative, got %g", e.Speed)
+		}
+	}
+
+	names := make(map[string]bool)
+	grouped := make(map[int]string)
+	for i, g := range b.Groups {
+		gpath := fmt.Sprintf("%s.groups[%d]", path, i)
+		if g.Name == "" {
+			p.add(gpath+".name", "groups need a name")
+		} else if names[g.Name] {
+			p.add(gpath+".name", "group %q is already defined", g.Name)
+		}
+		names[g.Name] = true
+		if len(g.Elevators) == 0 {
+			p.add(gpath+".elevators", "group %q has no elevators", g.Name)
+		}
+		for j, id := range g.Elevators {
+			if !ids[id] {
+				p.add(fmt.Sprintf("%s.elevators[%d]", gpath, j), "elevator %d is not defined", id)
+				continue
+			}
+			if other, ok := grouped[id]; ok {
+				p.add(fmt.Sprintf("%s.elevators[%d]", gpath, j), "elevator %d is already in group %q", id, other)
+			}
+			grouped[id] = g.Name
+		}
+	}
+
+	switch building.DispatchStrategy(b.Dispatch) {
+	case "", building.DispatchClosest, building.DispatchShortestWait:
+	default:
+		p.add(path+".dispatch", "unknown dispatch strategy %q, use %q or %q", b.Dispatch, building.DispatchClosest, building.DispatchShortestWait)
+	}
+
+	pol := b.Policies
+	ppath := path + ".policies"
+	floors := []struct {
+		name  string
+		floor int
+	}{{"recallFloor", pol.RecallFloor}, {"alternateRecallFloor", pol.AlternateRecallFloor}, {"lobbyFloor", pol.LobbyFloor}}
+	for _, f := range floors {
+		if f.floor != 0 {
+			validFloor(ppath+"."+f.name, f.floor)
+		}
+	}
+	if pol.MinInService < 0 || pol.MinInService > len(b.Elevators) {
+		p.add(ppath+".minInService", "must be between 0 and the %d elevators, got %d", len(b.Elevators), pol.MinInService)
+	}
+	durations := []struct {
+		name string
+		d    Duration
+	}{{"maxWait", pol.MaxWait}, {"reassignThreshold", pol.ReassignThreshold}, {"reassignHoldTime", pol.ReassignHoldTime}, {"aging.maxWait", pol.Aging.MaxWait}}
+	for _, d := range durations {
+		if d.d < 0 {
+			p.add(ppath+"."+d.name, "can't be negative, got %s", time.Duration(d.d))
+		}
+	}
+	if pol.Aging.Weight < 0 {
+		p.add(ppath+".aging.weight", "can't be negative, got %g", pol.Aging.Weight)
+	}
+}
+
+// NewBuilding builds the building the config describes
+func (b BuildingConfig) NewBuilding() (*building.Building, error) {
+	// cars first, the groups and policies refer to them
+	bld := building.NewBuilding(b.ID, len(b.Floors), 0)
+	for _, e := range b.Elevators {
+		if _, err := bld.AddElevator(e.ID, e.spec()); err != nil {
+			return nil, err
+		}
+	}
+	if err := b.Apply(bld); err != nil {
+		return nil, err
+	}
+	return bld, nil
+}
+
+// Apply sets the floor labels, groups, dispatch strategy and policies on bld
+func (b BuildingConfig) Apply(bld *building.Building) error {
+	err := errors.Join(
+		bld.SetFloorLabels(b.labels()),
+		bld.SetGroups(b.groups()),
+		bld.SetDispatchStrategy(b.dispatch()),
+		bld.SetAgingPolicy(b.Policies.aging()),
+		bld.SetPolicies(b.Policies.policies()),
+	)
+	if err != nil {
+		return fmt.Errorf("building %d: %w", b.ID, err)
+	}
+	return nil
+}
+
+func (b BuildingConfig) labels() map[int]string {
+	labels := make(map[int]string)
+	for _, f := range b.Floors {
+		if f.Label != "" {
+			labels[f.Number] = f.Label
+		}
+	}
+	return labels
+}
+
+func (b BuildingConfig) groups() []building.Group {
+	groups := make([]building.Group, 0, len(b.Groups))
+	for _, g := range b.Groups {
+		groups = append(groups, building.Group{Name: g.Name, Elevators: g.Elevators})
+	}
+	return groups
+}
+
+func (b BuildingConfig) dispatch() building.DispatchStrategy {
+	if b.Dispatch == "" {
+		return building.DispatchClosest
+	}
+	return building.DispatchStrategy(b.Dispatch)
+}
+
+func (e ElevatorConfig) spec() building.ElevatorSpec {
+	return building.ElevatorSpec{ServedFloors: e.ServedFloors, Capacity: e.Capacity, Speed: e.Speed}
+}
+
+func (pol PolicyConfig) aging() elevator.AgingPolicy {
+	return elevator.AgingPolicy{MaxWait: time.Duration(pol.Aging.MaxWait), Weight: pol.Aging.Weight}
+}
+
+func (pol PolicyConfig) policies() building.Policies {
+	return building.Policies{
+		RecallFloor:          pol.RecallFloor,
+		AlternateRecallFloor: pol.AlternateRecallFloor,
+		LobbyFloor:           pol.LobbyFloor,
+		MinInService:         pol.MinInService,
+		MaxWait:              time.Duration(pol.MaxWait),
+		ReassignThreshold:    time.Duration(pol.ReassignThreshold),
+		ReassignHoldTime:     time.Duration(pol.ReassignHoldTime),
+	}
+}
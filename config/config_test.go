@@ -0,0 +1,123 @@
+package config
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/tcotav/elevatormgr/building"
+)
+
+func TestLoadExample(t *testing.T) {
+	cfg, err := Load("example.yaml")
+	if err != nil {
+		t.Fatalf("Example config should load, got %s", err.Error())
+	}
+	if cfg.Listen != ":8077" || cfg.GRPCListen != ":8078" || len(cfg.Buildings) != 1 {
+		t.Errorf("Example config should have 1 building on :8077 and :8078, got %v", cfg)
+	}
+	b, err := cfg.Buildings[0].NewBuilding()
+	if err != nil {
+		t.Fatalf("Example building should build, got %s", err.Error())
+	}
+	if b.NumFloors != 10 || len(b.ElevatorList) != 3 {
+		t.Errorf("Building should have 10 floors and 3 elevators, got %d and %d", b.NumFloors, len(b.ElevatorList))
+	}
+	floors := b.GetFloors()
+	if floors[0].Label != "L" || floors[2].Label != "3" || floors[9].Label != "PH" {
+		t.Errorf("Floors should be labelled L, 3 and PH, got %v", floors)
+	}
+	if b.GetDispatchStrategy() != building.DispatchShortestWait {
+		t.Errorf("Dispatch should be shortest-wait, got %s", b.GetDispatchStrategy())
+	}
+	if len(b.GetGroups()) != 2 {
+		t.Errorf("Building should have 2 groups, got %v", b.GetGroups())
+	}
+	if b.GetElevator(2).Serves(5) || !b.GetElevator(2).Serves(10) {
+		t.Errorf("Service car should stop at 10 but not 5")
+	}
+	if p := b.GetPolicies(); p.MaxWait != 2*time.Minute || p.MinInService != 1 {
+		t.Errorf("Policies should be loaded, got %v", p)
+	}
+	if a := b.GetAgingPolicy(); a.MaxWait != 90*time.Second || a.Weight != 0.1 {
+		t.Errorf("Aging policy should be loaded, got %v", a)
+	}
+}
+
+func TestParseJSON(t *testing.T) {
+	cfg, err := Parse([]byte(`{"buildings": [{"id": 7, "floors": [{"number": 1}, {"number": 2}],
+		"elevators": [{"id": 0}], "policies": {"maxWait": "45s"}}]}`), ".json")
+	if err != nil {
+		t.Fatalf("JSON config should parse, got %s", err.Error())
+	}
+	if cfg.Buildings[0].ID != 7 || time.Duration(cfg.Buildings[0].Policies.MaxWait) != 45*time.Second {
+		t.Errorf("JSON config should have building 7 with 45s max wait, got %v", cfg.Buildings[0])
+	}
+}
+
+func TestParseStrict(t *testing.T) {
+	if _, err := Parse([]byte("buildings:\n  - id: 1\n    flors: []\n"), ".yaml"); err == nil || !strings.Contains(err.Error(), "flors") {
+		t.Errorf("Unknown yaml key should be named in the error, got %v", err)
+	}
+	if _, err := Parse([]byte(`{"buildings": [{"id": 1, "lifts": []}]}`), ".json"); err == nil || !strings.Contains(err.Error(), "lifts") {
+		t.Errorf("Unknown json key should be named in the error, got %v", err)
+	}
+	if _, err := Parse([]byte("buildings:\n  - id: 1\n    policies: {maxWait: soon}\n"), ".yaml"); err == nil || !strings.Contains(err.Error(), "line 3") {
+		t.Errorf("Bad duration should give its line, got %v", err)
+	}
+	if _, err := Parse([]byte("{}"), ".toml"); err == nil {
+		t.Errorf("Unknown format should be refused")
+	}
+}
+
+func TestValidate(t *testing.T) {
+	_, err := Parse([]byte(`
+buildings:
+  - id: 1
+    floors: [{number: 1, label: L}, {number: 3, label: L}]
+    elevators:
+      - {id: 0, servedFloors: [1, 9]}
+      - {id: 0, speed: -1}
+    groups:
+      - {name: low, elevators: [0, 5]}
+      - {name: low, elevators: [0]}
+    dispatch: random
+    policies: {lobbyFloor: 12, minInService: 3}
+  - id: 1
+`), ".yaml")
+	var verr *ValidationError
+	if !errors.As(err, &verr) {
+		t.Fatalf("Expected a validation error, got %v", err)
+	}
+	want := []string{
+		"buildings[0].floors[1].number",
+		"buildings[0].floors[1].label",
+		"buildings[0].elevators[0].servedFloors[1]",
+		"buildings[0].elevators[1].id",
+		"buildings[0].elevators[1].speed",
+		"buildings[0].groups[0].elevators[1]",
+		"buildings[0].groups[1].name",
+		"buildings[0].groups[1].elevators[0]",
+		"buildings[0].dispatch",
+		"buildings[0].policies.lobbyFloor",
+		"buildings[0].policies.minInService",
+		"buildings[1].id",
+		"buildings[1].floors",
+		"buildings[1].elevators",
+	}
+	for _, path := range want {
+		found := false
+		for _, problem := range verr.Problems {
+			if strings.HasPrefix(problem, path+":") {
+				found = true
+			}
+		}
+		if !found {
+			t.Errorf("Expected a problem at %s, got %s", path, verr.Error())
+		}
+	}
+	if len(verr.Problems) != len(want) {
+		t.Errorf("Expected %d problems, got %d: %s", len(want), len(verr.Problems), verr.Error())
+	}
+}
@@ -0,0 +1,229 @@
+package config
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/tcotav/elevatormgr/building"
+)
+
+// Hot reload.  Swapping a running building for a fresh one would drop every
+// call in it, so a reload works out how the file differs from what's running
+// and makes the changes it safely can in place.  The rest are refused with the
+// reason and stay pending -- deal with the cause (drain the car, say) and
+// reload again.
+
+// Change is one way the config differs from a running building
+type Change struct {
+	BuildingID int
+	// building, floors, floorLabels, groups, dispatch, aging, policies or
+	// elevators[id]
+	Setting string
+	Detail  string
+	// refused changes wait for a later reload or a restart
+	Refused bool
+	Reason  string `json:",omitempty"`
+}
+
+func (c Change) String() string {
+	s := fmt.Sprintf("building %d %s: %s", c.BuildingID, c.Setting, c.Detail)
+	if c.Refused {
+		s += " -- refused, " + c.Reason
+	}
+	return s
+}
+
+// Reload brings the running buildings in line with cfg.  It returns the
+// buildings to run from now on, the running ones updated in place plus any
+// new ones, and every change it found.
+func Reload(cfg *Config, running map[int]*building.Building) (map[int]*building.Building, []Change) {
+	return reload(cfg, running, BuildingConfig.Update)
+}
+
+// PreviewReload works out what Reload would do without changing anything,
+// with the effect it would have on each running building
+func PreviewReload(cfg *Config, running map[int]*building.Building) ([]Change, map[int]building.Preview) {
+	previews := make(map[int]building.Preview)
+	_, changes := reload(cfg, running, func(bc BuildingConfig, b *building.Building) []Change {
+		var changes []Change
+		p, _ := b.Preview(func(sim *building.Building) error {
+			changes = bc.Update(sim)
+			return nil
+		})
+		previews[b.ID] = p
+		return changes
+	})
+	return changes, previews
+}
+
+func reload(cfg *Config, running map[int]*building.Building, update func(BuildingConfig, *building.Building) []Change) (map[int]*building.Building, []Change) {
+	next := make(map[int]*building.Building)
+	changes := make([]Change, 0)
+	for _, bc := range cfg.Buildings {
+		if b, ok := running[bc.ID]; ok {
+			next[bc.ID] = b
+			changes = append(changes, update(bc, b)...)
+			continue
+		}
+		change := Change{BuildingID: bc.ID, Setting: "building", Detail: "added"}
+		b, err := bc.NewBuilding()
+		if err != nil {
+			change.Refused = true
+			change.Reason = err.Error()
+		} else {
+			next[bc.ID] = b
+		}
+		changes = append(changes, change)
+	}
+
+	// dropping a building would strand its calls and whoever is in its cars
+	ids := make([]int, 0, len(running))
+	for id := range running {
+		ids = append(ids, id)
+	}
+	sort.Ints(ids)
+	for _, id := range ids {
+		if _, ok := next[id]; !ok {
+			next[id] = running[id]
+			changes = append(changes, Change{BuildingID: id, Setting: "building", Detail: "removed",
+				Refused: true, Reason: "removing a building needs a restart"})
+		}
+	}
+	return next, changes
+}
+
+// Update makes the differences between b and a running building that are
+// safe to make live, and returns every difference it found
+func (b BuildingConfig) Update(bld *building.Building) []Change {
+	changes := make([]Change, 0)
+	record := func(setting string, detail string, err error) {
+		change := Change{BuildingID: b.ID, Setting: setting, Detail: detail}
+		if err != nil {
+			change.Refused = true
+			change.Reason = err.Error()
+		}
+		changes = append(changes, change)
+	}
+
+	// floors can be added live, taking them away needs a restart
+	floors := bld.GetFloors()
+	if len(floors) != len(b.Floors) {
+		record("floors", fmt.Sprintf("%d to %d", len(floors), len(b.Floors)), bld.ExtendFloors(len(b.Floors)))
+		floors = bld.GetFloors()
+	}
+
+	// new cars go in first, the groups and policies may count on them
+	existing := make(map[int]bool)
+	for _, id := range bld.GetElevatorIDs() {
+		existing[id] = true
+	}
+	for _, e := range b.Elevators {
+		if existing[e.ID] {
+			continue
+		}
+		_, err := bld.AddElevator(e.ID, e.spec())
+		record(fmt.Sprintf("elevators[%d]", e.ID), "added", err)
+	}
+
+	if detail := relabelled(floors, b.labels()); detail != "" {
+		record("floorLabels", detail, bld.SetFloorLabels(b.labels()))
+	}
+	if current, groups := bld.GetGroups(), b.groups(); !sameGroups(current, groups) {
+		record("groups", fmt.Sprintf("%v to %v", current, groups), bld.SetGroups(groups))
+	}
+	if current, dispatch := bld.GetDispatchStrategy(), b.dispatch(); current != dispatch {
+		record("dispatch", fmt.Sprintf("%s to %s", current, dispatch), bld.SetDispatchStrategy(dispatch))
+	}
+	if current, aging := bld.GetAgingPolicy(), b.Policies.aging(); current != aging {
+		record("aging", fmt.Sprintf("%+v to %+v", current, aging), bld.SetAgingPolicy(aging))
+	}
+	// zero values leave a policy as it is, so the change is whatever SetPolicies left behind
+	current, policies := bld.GetPolicies(), b.Policies.policies()
+	if err := bld.SetPolicies(policies); err != nil {
+		record("policies", fmt.Sprintf("%+v to %+v", current, policies), err)
+	} else if next := bld.GetPolicies(); next != current {
+		record("policies", fmt.Sprintf("%+v to %+v", current, next), nil)
+	}
+
+	for _, e := range b.Elevators {
+		if !existing[e.ID] {
+			continue
+		}
+		current, err := bld.GetElevatorSpec(e.ID)
+		if err != nil {
+			continue
+		}
+		if spec := e.spec(); !sameSpec(current, spec) {
+			setting := fmt.Sprintf("elevators[%d]", e.ID)
+			record(setting, fmt.Sprintf("%+v to %+v", current, spec), bld.ConfigureElevator(e.ID, spec))
+		}
+	}
+
+	// cars that have gone from the file, they have to be drained first
+	wanted := make(map[int]bool)
+	for _, e := range b.Elevators {
+		wanted[e.ID] = true
+	}
+	for _, id := range bld.GetElevatorIDs() {
+		if !wanted[id] {
+			record(fmt.Sprintf("elevators[%d]", id), "removed", bld.RemoveElevator(id))
+		}
+	}
+	return changes
+}
+
+// relabelled describes the floors whose label would change, empty when none would
+func relabelled(floors []building.Floor, labels map[int]string) string {
+	current := make(map[int]string)
+	for _, f := range floors {
+		current[f.Number] = f.Label
+	}
+	numbers := make([]int, 0)
+	for n := range current {
+		numbers = append(numbers, n)
+	}
+	for n := range labels {
+		if _, ok := current[n]; !ok {
+			numbers = append(numbers, n)
+		}
+	}
+	sort.Ints(numbers)
+	diffs := make([]string, 0)
+	for _, n := range numbers {
+		want, ok := labels[n]
+		if !ok {
+			want = fmt.Sprint(n)
+		}
+		if have, ok := current[n]; ok && have != want {
+			diffs = append(diffs, fmt.Sprintf("floor %d %q to %q", n, have, want))
+		} else if !ok {
+			diffs = append(diffs, fmt.Sprintf("floor %d %q", n, want))
+		}
+	}
+	return strings.Join(diffs, ", ")
+}
+
+func sameGroups(a []building.Group, b []building.Group) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i].Name != b[i].Name || !sameInts(a[i].Elevators, b[i].Elevators) {
+			return false
+		}
+	}
+	return true
+}
+
+func sameSpec(a building.ElevatorSpec, b building.ElevatorSpec) bool {
+	floors := append([]int{}, b.ServedFloors...)
+	sort.Ints(floors)
+	return a.Capacity == b.Capacity && a.Speed == b.Speed && sameInts(a.ServedFloors, floors)
+}
+
+// sameInts treats nil and empty as the same
+func sameInts(a []int, b []int) bool {
+	return len(a) == 0 && len(b) == 0 || reflect.DeepEqual(a, b)
+}
@@ -0,0 +1,130 @@
+package config
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/tcotav/elevatormgr/building"
+)
+
+// changed finds the change to setting in building id
+func changed(changes []Change, id int, setting string) (Change, bool) {
+	for _, c := range changes {
+		if c.BuildingID == id && c.Setting == setting {
+			return c, true
+		}
+	}
+	return Change{}, false
+}
+
+func TestReload(t *testing.T) {
+	cfg, _ := Load("example.yaml")
+	b, _ := cfg.Buildings[0].NewBuilding()
+	running := map[int]*building.Building{b.ID: b}
+
+	// nothing changed, nothing to do
+	_, changes := Reload(cfg, running)
+	if len(changes) != 0 {
+		t.Errorf("Reloading the same config should change nothing, got %v", changes)
+	}
+
+	cfg, _ = Load("example.yaml")
+	bc := &cfg.Buildings[0]
+	bc.Dispatch = "closest"
+	bc.Floors[1].Label = "Mezz"
+	bc.Policies.MaxWait = Duration(time.Minute)
+	bc.Elevators = append(bc.Elevators, ElevatorConfig{ID: 3, ServedFloors: []int{1, 10}})
+	bc.Groups[1].Elevators = []int{2, 3}
+	bc.Floors = append(bc.Floors, FloorConfig{Number: 11, Label: "R"})
+	cfg.Buildings = append(cfg.Buildings, BuildingConfig{ID: 2, Floors: bc.Floors[:4], Elevators: bc.Elevators[:1]})
+
+	// a dry run changes nothing
+	preview, previews := PreviewReload(cfg, running)
+	if len(preview) != 7 || b.GetDispatchStrategy() != building.DispatchShortestWait || len(b.GetElevatorIDs()) != 3 {
+		t.Errorf("Dry run should list 7 changes and make none, got %v", preview)
+	}
+	if p := previews[1]; len(p.Cars) != 4 {
+		t.Errorf("Dry run should show elevator 3 added, got %v", p.Cars)
+	}
+
+	next, changes := Reload(cfg, running)
+	if len(changes) != 7 {
+		t.Errorf("Expected 7 changes, got %v", changes)
+	}
+	for _, c := range changes {
+		if c.Refused {
+			t.Errorf("No change should be refused, got %s", c.String())
+		}
+	}
+	if next[1] != b || next[2] == nil {
+		t.Errorf("Building 1 should be kept and building 2 added, got %v", next)
+	}
+	if b.GetDispatchStrategy() != building.DispatchClosest || b.GetPolicies().MaxWait != time.Minute {
+		t.Errorf("Dispatch and max wait should be updated")
+	}
+	if c, _ := changed(changes, 1, "policies"); !strings.Contains(c.Detail, "MaxWait:1m0s") {
+		t.Errorf("Policy change should describe the new max wait, got %s", c.Detail)
+	}
+	if b.GetFloors()[1].Label != "Mezz" {
+		t.Errorf("Floor 2 should be Mezz, got %s", b.GetFloors()[1].Label)
+	}
+	if e := b.GetElevator(3); e == nil || e.Serves(5) {
+		t.Errorf("Elevator 3 should be added and skip floor 5")
+	}
+	if floors := b.GetFloors(); len(floors) != 11 || floors[10].Label != "R" || !b.GetElevator(0).Serves(11) {
+		t.Errorf("Floor R should be added and served by elevator 0, got %v", floors)
+	}
+	if c, _ := changed(changes, 1, "floorLabels"); c.Detail != `floor 2 "M" to "Mezz", floor 11 "11" to "R"` {
+		t.Errorf("Label change should be described, got %s", c.Detail)
+	}
+}
+
+func TestReloadRefused(t *testing.T) {
+	cfg, _ := Load("example.yaml")
+	b, _ := cfg.Buildings[0].NewBuilding()
+	other, _ := cfg.Buildings[0].NewBuilding()
+	running := map[int]*building.Building{1: b, 9: other}
+	b.PushDestinationButton(2, 10)
+	b.PushDestinationButton(1, 7)
+
+	cfg, _ = Load("example.yaml")
+	bc := &cfg.Buildings[0]
+	bc.Elevators = bc.Elevators[:2]
+	bc.Groups = bc.Groups[:1]
+	bc.Elevators[1].ServedFloors = []int{1, 2, 3}
+	// more cars than the building has
+	bc.Policies.MinInService = 4
+	// the building has been extended since, floors can't go
+	b.ExtendFloors(11)
+
+	next, changes := Reload(cfg, running)
+	want := map[string]string{
+		"elevators[2]": "pending calls",
+		"elevators[1]": "pending call to floor: 7",
+		"floors":       "only be added",
+		"policies":     "minimum in service",
+	}
+	for setting, reason := range want {
+		c, ok := changed(changes, 1, setting)
+		if !ok || !c.Refused || !strings.Contains(c.Reason, reason) {
+			t.Errorf("Change to %s should be refused for %s, got %v", setting, reason, c)
+		}
+	}
+	if c, ok := changed(changes, 9, "building"); !ok || !c.Refused || next[9] != other {
+		t.Errorf("Building 9 should be kept running, got %v", c)
+	}
+	if c, ok := changed(changes, 1, "groups"); !ok || c.Refused {
+		t.Errorf("Dropping the service group should go through, got %v", c)
+	}
+	if len(b.GetElevatorIDs()) != 3 {
+		t.Errorf("Elevator 2 should still be there, got %v", b.GetElevatorIDs())
+	}
+
+	// once the car is out of service the removal goes through on the next reload
+	b.SetElevatorInServiceStatus(2, false)
+	_, changes = Reload(cfg, running)
+	if c, ok := changed(changes, 1, "elevators[2]"); !ok || c.Refused {
+		t.Errorf("Idle elevator 2 should be removed, got %v", c)
+	}
+}
@@ -2,7 +2,27 @@ package elevator
 
 import (
 	"encoding/json"
-	"fmt"
+	"time"
+)
+
+// Mode is the operating mode of an elevator car.  Normal cars take hall calls
+// from the building, the other modes restrict what the car will accept.
+type Mode string
+
+const (
+	ModeNormal      Mode = "normal"
+	ModeFireRecall  Mode = "fireRecall"
+	ModeFirefighter Mode = "firefighter"
+	// emergency power -- cars wait their turn to return to the lobby, then park
+	// there unless they're picked to run on the generator
+	ModeEmergencyReturn Mode = "emergencyReturn"
+	ModeEmergencyParked Mode = "emergencyParked"
+	// independent service -- out of group dispatch, car calls only
+	ModeIndependent Mode = "independent"
+	// priority call -- running express to a priority hall call and its destination
+	ModePriority Mode = "priority"
+	// draining -- finishing its car calls before going out of service
+	ModeDraining Mode = "draining"
 )
 
 type Elevator struct {
@@ -12,7 +32,19 @@ type Elevator struct {
 	Direction    int
 	MaxFloor     int
 	InService   bool
+	Mode         Mode
+	DoorsOpen    bool
 	CallList	 *ElevatorCallList	
+	// calls put aside while the car runs a priority call
+	DeferredCalls []Call
+	// how the next stop is picked, see AgingPolicy
+	Aging AgingPolicy
+	// floors the car stops at, all of them when empty
+	ServedFloors []int   `json:",omitempty"`
+	Capacity     int     `json:",omitempty"` // passengers
+	Speed        float64 `json:",omitempty"` // metres per second
+	// where call times come from, swapped out in simulations
+	Clock func() time.Time `json:"-"`
 }
 
 func NewElevator(buildingID int, elevatorID int, maxfloor int) *Elevator {
@@ -25,9 +57,40 @@ func NewElevator(buildingID int, elevatorID int, maxfloor int) *Elevator {
 		CallList: callList,
 		BuildingID: buildingID,
 		InService: true,
+		Mode: ModeNormal,
+		Clock: time.Now,
 	}
 }
 
+// Copy makes an independent copy of the car, calls and all
+func (e *Elevator) Copy() *Elevator {
+	c := *e
+	c.CallList = e.CallList.Copy()
+	if e.DeferredCalls != nil {
+		c.DeferredCalls = append([]Call{}, e.DeferredCalls...)
+	}
+	if e.ServedFloors != nil {
+		c.ServedFloors = append([]int{}, e.ServedFloors...)
+	}
+	return &c
+}
+
+// Serves checks whether the car stops at floor
+func (e *Elevator) Serves(floor int) bool {
+	if floor < 1 || floor > e.MaxFloor {
+		return false
+	}
+	if len(e.ServedFloors) == 0 {
+		return true
+	}
+	for _, f := range e.ServedFloors {
+		if f == floor {
+			return true
+		}
+	}
+	return false
+}
+
 // these two do the same thing -- move the elevator to a specific floor -- but have different input sources
 // which isn't visible at this level
 // one is a pull for the elevator car
@@ -36,24 +99,47 @@ func NewElevator(buildingID int, elevatorID int, maxfloor int) *Elevator {
 // PushDestinationButton is called when a user pushes a floor button in the elevator car
 func (e *Elevator) PushDestinationButton(floor int) error {
 	var direction int
+	if e.Mode == ModeFirefighter {
+		return e.errorf(ErrWrongMode, "elevator: %d in building: %d only takes calls from the firefighter key", e.ElevatorID, e.BuildingID)
+	}
+	if e.Mode != ModeNormal && e.Mode != ModeIndependent {
+		return e.errorf(ErrWrongMode, "elevator: %d in building: %d is in %s mode", e.ElevatorID, e.BuildingID, e.Mode)
+	}
 	if floor == e.CurrentFloor || floor > e.MaxFloor{
-		return fmt.Errorf("invalid floor: %d for elevator: %d in building: %d", floor, e.ElevatorID, e.BuildingID)
+		return e.errorf(ErrInvalidFloor, "invalid floor: %d for elevator: %d in building: %d", floor, e.ElevatorID, e.BuildingID)
 	} 
+	if !e.Serves(floor) {
+		return e.errorf(ErrFloorNotServed, "floor: %d is not served by elevator: %d in building: %d", floor, e.ElevatorID, e.BuildingID)
+	}
 	
 	if floor > e.CurrentFloor {
 		direction = 1
 	} else {
 		direction = -1
 	}
-	return e.addCall(floor, direction)
+	err := e.addCall(floor, direction, CarCall)
+	if err != nil {
+		return err
+	}
+	// an independent service car holds its doors until it gets somewhere to go
+	if e.Mode == ModeIndependent {
+		e.DoorsOpen = false
+	}
+	return nil
 }
 
 // CallElevator is called when a user calls an elevator from a floor
 // equivalent to pushing the up or down arrow at your floor to summon the elevator
 func (e *Elevator) CallElevator(floor int, direction int) error {
+	if e.Mode != ModeNormal {
+		return e.errorf(ErrWrongMode, "elevator: %d in building: %d is in %s mode", e.ElevatorID, e.BuildingID, e.Mode)
+	}
 	// if at same floor -- we open the door but don't move elevator
 	if floor > e.MaxFloor{
-		return fmt.Errorf("invalid floor: %d for elevator: %d in building: %d", floor, e.ElevatorID, e.BuildingID)
+		return e.errorf(ErrInvalidFloor, "invalid floor: %d for elevator: %d in building: %d", floor, e.ElevatorID, e.BuildingID)
+	}
+	if !e.Serves(floor) {
+		return e.errorf(ErrFloorNotServed, "floor: %d is not served by elevator: %d in building: %d", floor, e.ElevatorID, e.BuildingID)
 	}
 
 	// this is a hack -- instead we'd track our direction by which way the car is going for the current call
@@ -61,26 +147,202 @@ func (e *Elevator) CallElevator(floor int, direction int) error {
 	if e.CallList.Len() == 0 {
 		e.Direction = direction
 	}
-	return e.addCall(floor, direction)
+	return e.addCall(floor, direction, HallCall)
 }
 
 // ForceCallElevator is called when a user overrides the existing call stack
 // and causes the elevator to go to a specific floor immediately
 func (e *Elevator) ForceCallElevator(floor int, direction int) error {
 	if floor == e.CurrentFloor || floor > e.MaxFloor{
-		return fmt.Errorf("invalid floor: %d for elevator: %d in building: %d on floor: %d", floor, e.ElevatorID, e.BuildingID, e.CurrentFloor)
+		return e.errorf(ErrInvalidFloor, "invalid floor: %d for elevator: %d in building: %d on floor: %d", floor, e.ElevatorID, e.BuildingID, e.CurrentFloor)
 	}
 	if e.CallList.Len() == 0 {
 		e.Direction = direction
 	}
 	call := Call{
-		Floor:     floor,
-		Direction: direction,
+		Floor:        floor,
+		Direction:    direction,
+		Type:         CarCall,
+		RegisteredAt: e.Clock(),
+		Express:      true,
 	}
 	e.CallList.Prepend(call)
 	return nil
 }
 
+// Recall drops every call on the car and sends it express to the recall floor
+// where it parks with the doors open.  Fire service Phase I.
+func (e *Elevator) Recall(floor int) error {
+	if floor < 1 || floor > e.MaxFloor {
+		return e.errorf(ErrInvalidFloor, "invalid recall floor: %d for elevator: %d in building: %d", floor, e.ElevatorID, e.BuildingID)
+	}
+	return e.sendTo(floor, ModeFireRecall)
+}
+
+// EmergencyReturn drops every call on the car and sends it to the lobby while
+// the building is on emergency power
+func (e *Elevator) EmergencyReturn(lobby int) error {
+	if lobby < 1 || lobby > e.MaxFloor {
+		return e.errorf(ErrInvalidFloor, "invalid lobby floor: %d for elevator: %d in building: %d", lobby, e.ElevatorID, e.BuildingID)
+	}
+	return e.sendTo(lobby, ModeEmergencyReturn)
+}
+
+// EmergencyHold drops every call on the car and holds it where it is until it
+// gets its turn to return to the lobby
+func (e *Elevator) EmergencyHold() {
+	e.CallList.Clear()
+	e.Mode = ModeEmergencyReturn
+	e.DoorsOpen = false
+}
+
+// sendTo clears the call list and sends the car express to a floor in the given mode.
+// Fire recalled cars wait at the floor with their doors open.
+func (e *Elevator) sendTo(floor int, mode Mode) error {
+	e.CallList.Clear()
+	e.Mode = mode
+	if floor == e.CurrentFloor {
+		e.Direction = 0
+		e.DoorsOpen = mode == ModeFireRecall
+		return nil
+	}
+	e.DoorsOpen = false
+	if floor > e.CurrentFloor {
+		e.Direction = 1
+	} else {
+		e.Direction = -1
+	}
+	return e.addCall(floor, e.Direction, CarCall)
+}
+
+// ClearRecall puts a recalled car back into normal service where it is parked
+func (e *Elevator) ClearRecall() {
+	e.CallList.Clear()
+	e.Mode = ModeNormal
+	e.DoorsOpen = false
+}
+
+// StartDrain stops the car taking hall calls and lets it finish its car calls.
+// The hall calls it was holding are handed back for another car to take.
+func (e *Elevator) StartDrain() ([]Call, error) {
+	if e.Mode == ModeDraining {
+		// NOOP
+		return []Call{}, nil
+	}
+	if e.Mode != ModeNormal {
+		return nil, e.errorf(ErrWrongMode, "elevator: %d in building: %d is in %s mode", e.ElevatorID, e.BuildingID, e.Mode)
+	}
+	e.Mode = ModeDraining
+	return e.CallList.RemoveType(HallCall), nil
+}
+
+// StartIndependentService takes the car out of group dispatch.  It keeps taking
+// car calls and holds its doors open until one is registered.
+func (e *Elevator) StartIndependentService() error {
+	if e.Mode == ModeIndependent {
+		// NOOP
+		return nil
+	}
+	if e.Mode != ModeNormal {
+		return e.errorf(ErrWrongMode, "elevator: %d in building: %d is in %s mode", e.ElevatorID, e.BuildingID, e.Mode)
+	}
+	e.Mode = ModeIndependent
+	e.DoorsOpen = e.CallList.Len() == 0
+	return nil
+}
+
+// EndIndependentService returns the car to group dispatch
+func (e *Elevator) EndIndependentService() {
+	if e.Mode != ModeIndependent {
+		// NOOP
+		return
+	}
+	e.Mode = ModeNormal
+	e.DoorsOpen = false
+}
+
+// StartPriorityRun puts the car's calls aside and runs it express to the
+// calling floor and then on to the destination
+func (e *Elevator) StartPriorityRun(floor int, destination int) error {
+	if e.Mode != ModeNormal {
+		return e.errorf(ErrWrongMode, "elevator: %d in building: %d is in %s mode", e.ElevatorID, e.BuildingID, e.Mode)
+	}
+	if floor < 1 || floor > e.MaxFloor || destination < 1 || destination > e.MaxFloor || floor == destination {
+		return e.errorf(ErrInvalidFloor, "invalid priority call from floor: %d to floor: %d for elevator: %d in building: %d", floor, destination, e.ElevatorID, e.BuildingID)
+	}
+	if !e.Serves(floor) || !e.Serves(destination) {
+		return e.errorf(ErrFloorNotServed, "priority call from floor: %d to floor: %d is not served by elevator: %d in building: %d", floor, destination, e.ElevatorID, e.BuildingID)
+	}
+	e.DeferredCalls = e.CallList.Clear()
+	e.Mode = ModePriority
+	e.DoorsOpen = false
+	if floor != e.CurrentFloor {
+		e.addCall(floor, direction(e.CurrentFloor, floor), CarCall)
+	}
+	return e.addCall(destination, direction(floor, destination), CarCall)
+}
+
+// EndPriorityRun puts the car back into normal service and picks its deferred calls back up
+func (e *Elevator) EndPriorityRun() {
+	if e.Mode != ModePriority {
+		// NOOP
+		return
+	}
+	e.Mode = ModeNormal
+	for _, call := range e.DeferredCalls {
+		// the priority run may have taken care of some of these already
+		if call.Floor != e.CurrentFloor {
+			e.CallList.Push(call)
+		}
+	}
+	e.DeferredCalls = nil
+}
+
+// StartFirefighterService hands a recalled car over to the firefighters.
+// Fire service Phase II -- the car has to be parked at the recall floor first.
+func (e *Elevator) StartFirefighterService() error {
+	if e.Mode != ModeFireRecall {
+		return e.errorf(ErrWrongMode, "elevator: %d in building: %d is not in fire recall", e.ElevatorID, e.BuildingID)
+	}
+	if e.CallList.Len() != 0 {
+		return e.errorf(ErrNotReady, "elevator: %d in building: %d has not reached the recall floor", e.ElevatorID, e.BuildingID)
+	}
+	e.Mode = ModeFirefighter
+	return nil
+}
+
+// FirefighterCarCall registers a car call from the firefighter key.  Only one
+// call at a time and the doors have to be closed before the car will go.
+func (e *Elevator) FirefighterCarCall(floor int) error {
+	if e.Mode != ModeFirefighter {
+		return e.errorf(ErrWrongMode, "elevator: %d in building: %d is not in firefighter service", e.ElevatorID, e.BuildingID)
+	}
+	if floor < 1 || floor == e.CurrentFloor || floor > e.MaxFloor {
+		return e.errorf(ErrInvalidFloor, "invalid floor: %d for elevator: %d in building: %d", floor, e.ElevatorID, e.BuildingID)
+	}
+	if e.CallList.Len() != 0 {
+		return e.errorf(ErrNotReady, "elevator: %d in building: %d already has a firefighter call", e.ElevatorID, e.BuildingID)
+	}
+	if e.DoorsOpen {
+		return e.errorf(ErrNotReady, "elevator: %d in building: %d has its doors open", e.ElevatorID, e.BuildingID)
+	}
+	if floor > e.CurrentFloor {
+		e.Direction = 1
+	} else {
+		e.Direction = -1
+	}
+	return e.addCall(floor, e.Direction, CarCall)
+}
+
+// FirefighterDoorButton is the constant pressure door open button -- the doors
+// stay open only while it is held, letting go closes them.
+func (e *Elevator) FirefighterDoorButton(pressed bool) error {
+	if e.Mode != ModeFirefighter {
+		return e.errorf(ErrWrongMode, "elevator: %d in building: %d is not in firefighter service", e.ElevatorID, e.BuildingID)
+	}
+	e.DoorsOpen = pressed
+	return nil
+}
 
 // GetState returns the current state of the elevator including the call list
 func (e Elevator) GetState() ([]byte, error) {
@@ -98,26 +360,48 @@ func (e Elevator) GetCallList() *ElevatorCallList {
 // this is kind of silly -- it just gets the next item in the call list
 func (e *Elevator) NextStop() (*Call, error) {
 	if e.CallList.Len() == 0 {
-		return nil, fmt.Errorf("no calls in call list for elevator: %d in building: %d", e.ElevatorID, e.BuildingID)
+		return nil, e.errorf(ErrNoCalls, "no calls in call list for elevator: %d in building: %d", e.ElevatorID, e.BuildingID)
 	}
-	call := e.CallList.PopLeft()
+	call := e.CallList.PopNext(e.Clock(), e.CurrentFloor, e.StopPolicy())
 	if call == nil {
-		return nil, fmt.Errorf("no calls in call list for elevator: %d in building: %d", e.ElevatorID, e.BuildingID)
+		return nil, e.errorf(ErrNoCalls, "no calls in call list for elevator: %d in building: %d", e.ElevatorID, e.BuildingID)
 	}
 	e.CurrentFloor = call.Floor
 	e.Direction = call.Direction
+	// recalled cars park with the doors open once they reach the recall floor,
+	// independent service cars hold them open at every stop
+	e.DoorsOpen = (e.Mode == ModeFireRecall && e.CallList.Len() == 0) || e.Mode == ModeIndependent
 	return call, nil
 }
 
+// StopPolicy is the aging policy NextStop goes by.  A priority run ignores it,
+// the car has to get to the calling floor before it heads to the destination.
+func (e *Elevator) StopPolicy() AgingPolicy {
+	if e.Mode == ModePriority {
+		return AgingPolicy{}
+	}
+	return e.Aging
+}
+
 // addCall adds a call to the elevator call list - utility method
-func (e *Elevator) addCall(floor int, direction int) error {
+func (e *Elevator) addCall(floor int, direction int, callType CallType) error {
 	call := Call{
-		Floor:     floor,
-		Direction: direction,
+		Floor:        floor,
+		Direction:    direction,
+		Type:         callType,
+		RegisteredAt: e.Clock(),
 	}
     return e.CallList.Push(call)
 }
 
+// direction of travel from one floor to another
+func direction(from int, to int) int {
+	if to > from {
+		return 1
+	}
+	return -1
+}
+
 func abs(x int) int {
 	if x < 0 {
 		return -x
@@ -2,6 +2,7 @@ package elevator
 
 import (
 	"testing"
+	"time"
 )
 
 func TestElevator(t *testing.T) {
@@ -57,4 +58,91 @@ func TestElevatorTrip(t *testing.T) {
 	if err == nil {
 		t.Errorf("Elevator should not have a next stop, got %d", call.Floor)
 	}
-}
\ No newline at end of file
+}
+
+func TestElevatorRecall(t *testing.T) {
+	elevator := NewElevator(1,1,10)
+	elevator.CallElevator(4, 1)
+	elevator.NextStop()
+	elevator.PushDestinationButton(9)
+
+	err := elevator.Recall(1)
+	if err != nil {
+		t.Errorf("Elevator should be recalled, got %s", err.Error())
+	}
+	if elevator.CallList.Len() != 1 {
+		t.Errorf("Elevator should only have the recall call, got %d", elevator.CallList.Len())
+	}
+	if err := elevator.PushDestinationButton(6); err == nil {
+		t.Errorf("Elevator should refuse car calls in fire recall")
+	}
+	call, _ := elevator.NextStop()
+	if call.Floor != 1 {
+		t.Errorf("Elevator should be going to floor 1, got %d", call.Floor)
+	}
+	if !elevator.DoorsOpen {
+		t.Errorf("Elevator should park with doors open")
+	}
+
+	elevator.ClearRecall()
+	if elevator.Mode != ModeNormal {
+		t.Errorf("Elevator should be back in normal mode, got %s", elevator.Mode)
+	}
+}
+
+func TestElevatorCopy(t *testing.T) {
+	elevator := NewElevator(1, 1, 10)
+	elevator.PushDestinationButton(5)
+
+	c := elevator.Copy()
+	c.PushDestinationButton(8)
+	c.CurrentFloor = 3
+	if elevator.CallList.Len() != 1 {
+		t.Errorf("Original elevator should still have 1 call, got %d", elevator.CallList.Len())
+	}
+	if elevator.CurrentFloor != 1 {
+		t.Errorf("Original elevator should still be on floor 1, got %d", elevator.CurrentFloor)
+	}
+	if c.CallList.Len() != 2 {
+		t.Errorf("Copied elevator should have 2 calls, got %d", c.CallList.Len())
+	}
+}
+
+func TestElevatorServedFloors(t *testing.T) {
+	elevator := NewElevator(1, 1, 10)
+	if !elevator.Serves(7) || elevator.Serves(11) {
+		t.Errorf("Elevator should serve every floor up to 10")
+	}
+	elevator.ServedFloors = []int{1, 10}
+	if elevator.Serves(7) || !elevator.Serves(10) {
+		t.Errorf("Elevator should only serve floors 1 and 10")
+	}
+	if err := elevator.PushDestinationButton(7); err == nil {
+		t.Errorf("Elevator should refuse a trip to floor 7")
+	}
+	if err := elevator.CallElevator(7, 1); err == nil {
+		t.Errorf("Elevator should refuse a call from floor 7")
+	}
+	if elevator.CallList.Len() != 0 {
+		t.Errorf("Elevator should have no calls, got %d", elevator.CallList.Len())
+	}
+}
+
+func TestElevatorPriorityRunIgnoresAging(t *testing.T) {
+	elevator := NewElevator(1,1,10)
+	elevator.CurrentFloor = 5
+	elevator.Aging = AgingPolicy{MaxWait: time.Minute}
+
+	// nearest first would drop the passenger off at 6 before picking them up at 9
+	if err := elevator.StartPriorityRun(9, 6); err != nil {
+		t.Errorf("Priority run should start, got %s", err.Error())
+	}
+	call, _ := elevator.NextStop()
+	if call.Floor != 9 {
+		t.Errorf("Priority run should go to the calling floor first, got floor %d", call.Floor)
+	}
+	call, _ = elevator.NextStop()
+	if call.Floor != 6 {
+		t.Errorf("Priority run should go to the destination next, got floor %d", call.Floor)
+	}
+}
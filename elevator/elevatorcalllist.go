@@ -2,16 +2,43 @@ package elevator
 import (
     "fmt"
     "sync"
+    "time"
 )
 
 /*
 This was made as dumb and simple as possible -- this is NOT a real elevator
 */
 type Call struct {
-    Floor     int // the floor number
-    Direction int // the direction (1 for up, -1 for down)
+    Floor        int // the floor number
+    Direction    int // the direction (1 for up, -1 for down)
+    Type         CallType
+    RegisteredAt time.Time
+    Express      bool `json:",omitempty"` // maintenance override, always served first
 }
 
+// Same checks whether two calls are for the same stop, whenever they were made
+func (c Call) Same(o Call) bool {
+    return c.Floor == o.Floor && c.Direction == o.Direction && c.Type == o.Type
+}
+
+// AgingPolicy sets how the next stop is picked.  The zero policy serves calls
+// in the order they were made.  Otherwise the car goes to the nearest call,
+// each second a call has waited counts as Weight floors closer, and a call
+// that has waited MaxWait or longer goes ahead of everything else.
+type AgingPolicy struct {
+    MaxWait time.Duration
+    Weight  float64
+}
+
+// CallType is where a call came from -- a hall call can be answered by any car,
+// a car call belongs to the car it was made in
+type CallType string
+
+const (
+    HallCall CallType = "hall"
+    CarCall  CallType = "car"
+)
+
 type ElevatorCallList struct {
     mu sync.Mutex
     Calls []Call
@@ -29,12 +56,12 @@ func (e *ElevatorCallList) Len() int {
 }
 
 func (e *ElevatorCallList) Push(c Call) error {
-	// no duplicates
+	// no duplicates -- a hall call and a car call for the same stop are two calls
     e.mu.Lock()
     defer e.mu.Unlock()
 	for _, v := range e.Calls {
-		if v.Floor == c.Floor && v.Direction == c.Direction {
-			return fmt.Errorf("duplicate call on list: %v", c)
+		if v.Same(c) {
+			return fmt.Errorf("%w on list: %v", ErrDuplicateCall, c)
 		}
 	}
 	e.Calls = append(e.Calls, c)
@@ -63,4 +90,124 @@ func (e *ElevatorCallList) PopLeft() *Call {
 	v, ll := (e.Calls)[0], (e.Calls)[1:]
 	e.Calls = ll
 	return &v
-}
\ No newline at end of file
+}
+
+// Clear empties the call list and hands back whatever was on it
+func (e *ElevatorCallList) Clear() []Call {
+    e.mu.Lock()
+    defer e.mu.Unlock()
+	calls := e.Calls
+	e.Calls = make([]Call, 0)
+	return calls
+}
+
+// RemoveType takes every call of one type off the list and hands them back
+func (e *ElevatorCallList) RemoveType(t CallType) []Call {
+    e.mu.Lock()
+    defer e.mu.Unlock()
+	removed := make([]Call, 0)
+	kept := make([]Call, 0, len(e.Calls))
+	for _, c := range e.Calls {
+		if c.Type == t {
+			removed = append(removed, c)
+		} else {
+			kept = append(kept, c)
+		}
+	}
+	e.Calls = kept
+	return removed
+}
+
+// CountType counts the calls of one type on the list
+func (e *ElevatorCallList) CountType(t CallType) int {
+    e.mu.Lock()
+    defer e.mu.Unlock()
+	count := 0
+	for _, c := range e.Calls {
+		if c.Type == t {
+			count++
+		}
+	}
+	return count
+}
+
+// Remove takes a call off the list, it returns false if the call wasn't there
+func (e *ElevatorCallList) Remove(c Call) bool {
+    e.mu.Lock()
+    defer e.mu.Unlock()
+	for i, v := range e.Calls {
+		if v.Same(c) {
+			e.Calls = append(e.Calls[:i], e.Calls[i+1:]...)
+			return true
+		}
+	}
+	return false
+}
+
+// Contains checks whether a call is on the list
+func (e *ElevatorCallList) Contains(c Call) bool {
+    e.mu.Lock()
+    defer e.mu.Unlock()
+	for _, v := range e.Calls {
+		if v.Same(c) {
+			return true
+		}
+	}
+	return false
+}
+
+// Snapshot returns a copy of the calls on the list
+func (e *ElevatorCallList) Snapshot() []Call {
+    e.mu.Lock()
+    defer e.mu.Unlock()
+	return append([]Call{}, e.Calls...)
+}
+
+// Copy returns an independent copy of the list
+func (e *ElevatorCallList) Copy() *ElevatorCallList {
+	return &ElevatorCallList{Calls: e.Snapshot()}
+}
+
+// PopNext takes the next call to serve off the list for a car on floor, see AgingPolicy
+func (e *ElevatorCallList) PopNext(now time.Time, floor int, policy AgingPolicy) *Call {
+    e.mu.Lock()
+    defer e.mu.Unlock()
+	if len(e.Calls) == 0 {
+		return nil
+	}
+	next := 0
+	if !e.Calls[0].Express && (policy.MaxWait > 0 || policy.Weight > 0) {
+		next = nextAged(e.Calls, now, floor, policy)
+	}
+	v := e.Calls[next]
+	e.Calls = append(e.Calls[:next], e.Calls[next+1:]...)
+	return &v
+}
+
+// nextAged picks the index of the next call under an aging policy
+func nextAged(calls []Call, now time.Time, floor int, policy AgingPolicy) int {
+	// anybody past the bound goes first, longest wait first
+	oldest := -1
+	for i, c := range calls {
+		if policy.MaxWait > 0 && now.Sub(c.RegisteredAt) >= policy.MaxWait {
+			if oldest == -1 || c.RegisteredAt.Before(calls[oldest].RegisteredAt) {
+				oldest = i
+			}
+		}
+	}
+	if oldest != -1 {
+		return oldest
+	}
+	best, bestScore := 0, 0.0
+	for i, c := range calls {
+		distance := float64(c.Floor - floor)
+		if distance < 0 {
+			distance = -distance
+		}
+		score := distance - policy.Weight*now.Sub(c.RegisteredAt).Seconds()
+		if i == 0 || score < bestScore {
+			best, bestScore = i, score
+		}
+	}
+	return best
+}
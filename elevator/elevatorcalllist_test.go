@@ -2,6 +2,7 @@ package elevator
 
 import (
 	"testing"
+	"time"
 )
 
 func TestElevatorCallList(t *testing.T){
@@ -38,6 +39,22 @@ func TestElevatorCallList(t *testing.T){
 
 }
 
+func TestElevatorCallListHallAndCarCall(t *testing.T){
+	elevatorCallList := NewElevatorCallList()
+	if err := elevatorCallList.Push(Call{Floor: 5, Direction: 1, Type: CarCall}); err != nil {
+		t.Errorf("Car call should be pushed, got %s", err.Error())
+	}
+	if err := elevatorCallList.Push(Call{Floor: 5, Direction: 1, Type: HallCall}); err != nil {
+		t.Errorf("Hall call for the same stop should be pushed, got %s", err.Error())
+	}
+	if err := elevatorCallList.Push(Call{Floor: 5, Direction: 1, Type: HallCall}); err == nil {
+		t.Errorf("Second hall call for the same stop should be a duplicate")
+	}
+	if elevatorCallList.Len() != 2 {
+		t.Errorf("Elevator call list should have 2 calls, got %d", elevatorCallList.Len())
+	}
+}
+
 func TestElevatorCallListPrepend(t *testing.T){
 	elevatorCallList := NewElevatorCallList()
 	elevatorCallList.Push(Call{Floor: 3, Direction: 1})
@@ -57,3 +74,65 @@ func TestElevatorCallListPrepend(t *testing.T){
 }
 
 
+func TestElevatorCallListRemove(t *testing.T){
+	elevatorCallList := NewElevatorCallList()
+	elevatorCallList.Push(Call{Floor: 3, Direction: 1, Type: HallCall})
+	elevatorCallList.Push(Call{Floor: 5, Direction: 1, Type: CarCall})
+	elevatorCallList.Push(Call{Floor: 7, Direction: -1, Type: HallCall})
+
+	if !elevatorCallList.Remove(Call{Floor: 5, Direction: 1, Type: CarCall}) {
+		t.Errorf("Elevator call list should have removed the call")
+	}
+	if elevatorCallList.Remove(Call{Floor: 5, Direction: 1, Type: CarCall}) {
+		t.Errorf("Elevator call list should not remove a call twice")
+	}
+	hallCalls := elevatorCallList.RemoveType(HallCall)
+	if len(hallCalls) != 2 {
+		t.Errorf("Elevator call list should have returned 2 hall calls, got %d", len(hallCalls))
+	}
+	if elevatorCallList.Len() != 0 {
+		t.Errorf("Elevator call list should be empty, got %d", elevatorCallList.Len())
+	}
+}
+
+func TestElevatorCallListPopNext(t *testing.T){
+	now := time.Date(2024, 3, 1, 9, 0, 0, 0, time.UTC)
+	elevatorCallList := NewElevatorCallList()
+	elevatorCallList.Push(Call{Floor: 9, Direction: 1, RegisteredAt: now.Add(-30 * time.Second)})
+	elevatorCallList.Push(Call{Floor: 3, Direction: 1, RegisteredAt: now})
+
+	// no policy, first come first served
+	call := elevatorCallList.PopNext(now, 2, AgingPolicy{})
+	if call.Floor != 9 {
+		t.Errorf("Zero policy should serve calls in order, got floor %d", call.Floor)
+	}
+
+	elevatorCallList.Push(Call{Floor: 9, Direction: 1, RegisteredAt: now.Add(-30 * time.Second)})
+	// nearest first
+	call = elevatorCallList.PopNext(now, 2, AgingPolicy{MaxWait: time.Minute})
+	if call.Floor != 3 {
+		t.Errorf("Aging policy should serve the nearest call, got floor %d", call.Floor)
+	}
+
+	// waiting time makes up for distance
+	elevatorCallList.Push(Call{Floor: 3, Direction: 1, RegisteredAt: now})
+	call = elevatorCallList.PopNext(now, 2, AgingPolicy{Weight: 0.5})
+	if call.Floor != 9 {
+		t.Errorf("Aging weight should favour the older call, got floor %d", call.Floor)
+	}
+
+	// past MaxWait goes first whatever the distance
+	elevatorCallList.Push(Call{Floor: 9, Direction: 1, RegisteredAt: now.Add(-2 * time.Minute)})
+	call = elevatorCallList.PopNext(now, 2, AgingPolicy{MaxWait: time.Minute})
+	if call.Floor != 9 {
+		t.Errorf("Call past MaxWait should be served first, got floor %d", call.Floor)
+	}
+
+	// but an express call still beats it
+	elevatorCallList.Push(Call{Floor: 9, Direction: 1, RegisteredAt: now.Add(-2 * time.Minute)})
+	elevatorCallList.Prepend(Call{Floor: 5, Direction: 1, Type: CarCall, Express: true})
+	call = elevatorCallList.PopNext(now, 2, AgingPolicy{MaxWait: time.Minute})
+	if call.Floor != 5 {
+		t.Errorf("Express call should be served first, got floor %d", call.Floor)
+	}
+}
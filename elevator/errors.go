@@ -0,0 +1,50 @@
+package elevator
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Sentinel errors, check for them with errors.Is.  What the car returns is an
+// Error wrapping one of them, with the building and car it's about.
+var (
+	// the floor is off the end of the shaft or the car is already there
+	ErrInvalidFloor = errors.New("invalid floor")
+	// the car skips the floor, see ServedFloors
+	ErrFloorNotServed = errors.New("floor not served")
+	// the car's mode doesn't take the command
+	ErrWrongMode = errors.New("wrong mode")
+	// the car can't take the command yet -- doors open, still on its way
+	ErrNotReady = errors.New("not ready")
+	// the call is already on the list
+	ErrDuplicateCall = errors.New("duplicate call")
+	// there's nothing on the call list
+	ErrNoCalls = errors.New("no calls")
+)
+
+// Error is an error about a building or one of its cars, Err is the sentinel
+type Error struct {
+	Err        error
+	BuildingID int
+	// -1 when it's about the building rather than a car
+	ElevatorID int
+	msg        string
+}
+
+func (e *Error) Error() string {
+	return e.msg
+}
+
+func (e *Error) Unwrap() error {
+	return e.Err
+}
+
+// Errorf makes an Error, the message is formatted as with fmt.Errorf
+func Errorf(err error, buildingID int, elevatorID int, format string, a ...interface{}) error {
+	return &Error{Err: err, BuildingID: buildingID, ElevatorID: elevatorID, msg: fmt.Sprintf(format, a...)}
+}
+
+// errorf is Errorf about this car
+func (e *Elevator) errorf(err error, format string, a ...interface{}) error {
+	return Errorf(err, e.BuildingID, e.ElevatorID, format, a...)
+}
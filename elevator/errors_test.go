@@ -0,0 +1,35 @@
+package elevator
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestElevatorErrors(t *testing.T) {
+	elevator := NewElevator(2, 3, 10)
+	err := elevator.PushDestinationButton(11)
+	if !errors.Is(err, ErrInvalidFloor) {
+		t.Errorf("Floor 11 should be an invalid floor, got %v", err)
+	}
+	var e *Error
+	if !errors.As(err, &e) || e.BuildingID != 2 || e.ElevatorID != 3 {
+		t.Errorf("Error should be about elevator 3 in building 2, got %+v", e)
+	}
+	if err.Error() != "invalid floor: 11 for elevator: 3 in building: 2" {
+		t.Errorf("Error message should be unchanged, got %s", err.Error())
+	}
+
+	elevator.ServedFloors = []int{1, 10}
+	if err := elevator.CallElevator(5, 1); !errors.Is(err, ErrFloorNotServed) {
+		t.Errorf("Floor 5 should not be served, got %v", err)
+	}
+	if err := elevator.CallElevator(10, -1); err != nil {
+		t.Errorf("Elevator should be called, got %s", err.Error())
+	}
+	if err := elevator.CallElevator(10, -1); !errors.Is(err, ErrDuplicateCall) {
+		t.Errorf("A second call to 10 should be a duplicate, got %v", err)
+	}
+	if err := elevator.FirefighterDoorButton(true); !errors.Is(err, ErrWrongMode) {
+		t.Errorf("Elevator is not in firefighter service, got %v", err)
+	}
+}
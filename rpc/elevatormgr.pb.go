@@ -0,0 +1,5129 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.31.0
+// 	protoc        v4.25.3
+// source: elevatormgr.proto
+
+package rpc
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Mode int32
+
+const (
+	Mode_MODE_UNSPECIFIED      Mode = 0
+	Mode_MODE_NORMAL           Mode = 1
+	Mode_MODE_FIRE_RECALL      Mode = 2
+	Mode_MODE_FIREFIGHTER      Mode = 3
+	Mode_MODE_EMERGENCY_RETURN Mode = 4
+	Mode_MODE_EMERGENCY_PARKED Mode = 5
+	Mode_MODE_INDEPENDENT      Mode = 6
+	Mode_MODE_PRIORITY         Mode = 7
+	Mode_MODE_DRAINING         Mode = 8
+)
+
+// Enum value maps for Mode.
+var (
+	Mode_name = map[int32]string{
+		0: "MODE_UNSPECIFIED",
+		1: "MODE_NORMAL",
+		2: "MODE_FIRE_RECALL",
+		3: "MODE_FIREFIGHTER",
+		4: "MODE_EMERGENCY_RETURN",
+		5: "MODE_EMERGENCY_PARKED",
+		6: "MODE_INDEPENDENT",
+		7: "MODE_PRIORITY",
+		8: "MODE_DRAINING",
+	}
+	Mode_value = map[string]int32{
+		"MODE_UNSPECIFIED":      0,
+		"MODE_NORMAL":           1,
+		"MODE_FIRE_RECALL":      2,
+		"MODE_FIREFIGHTER":      3,
+		"MODE_EMERGENCY_RETURN": 4,
+		"MODE_EMERGENCY_PARKED": 5,
+		"MODE_INDEPENDENT":      6,
+		"MODE_PRIORITY":         7,
+		"MODE_DRAINING":         8,
+	}
+)
+
+func (x Mode) Enum() *Mode {
+	p := new(Mode)
+	*p = x
+	return p
+}
+
+func (x Mode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Mode) Descriptor() protoreflect.EnumDescriptor {
+	return file_elevatormgr_proto_enumTypes[0].Descriptor()
+}
+
+func (Mode) Type() protoreflect.EnumType {
+	return &file_elevatormgr_proto_enumTypes[0]
+}
+
+func (x Mode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Mode.Descriptor instead.
+func (Mode) EnumDescriptor() ([]byte, []int) {
+	return file_elevatormgr_proto_rawDescGZIP(), []int{0}
+}
+
+type CallType int32
+
+const (
+	CallType_CALL_TYPE_UNSPECIFIED CallType = 0
+	CallType_CALL_TYPE_HALL        CallType = 1
+	CallType_CALL_TYPE_CAR         CallType = 2
+)
+
+// Enum value maps for CallType.
+var (
+	CallType_name = map[int32]string{
+		0: "CALL_TYPE_UNSPECIFIED",
+		1: "CALL_TYPE_HALL",
+		2: "CALL_TYPE_CAR",
+	}
+	CallType_value = map[string]int32{
+		"CALL_TYPE_UNSPECIFIED": 0,
+		"CALL_TYPE_HALL":        1,
+		"CALL_TYPE_CAR":         2,
+	}
+)
+
+func (x CallType) Enum() *CallType {
+	p := new(CallType)
+	*p = x
+	return p
+}
+
+func (x CallType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CallType) Descriptor() protoreflect.EnumDescriptor {
+	return file_elevatormgr_proto_enumTypes[1].Descriptor()
+}
+
+func (CallType) Type() protoreflect.EnumType {
+	return &file_elevatormgr_proto_enumTypes[1]
+}
+
+func (x CallType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CallType.Descriptor instead.
+func (CallType) EnumDescriptor() ([]byte, []int) {
+	return file_elevatormgr_proto_rawDescGZIP(), []int{1}
+}
+
+type CallStatus int32
+
+const (
+	CallStatus_CALL_STATUS_UNSPECIFIED CallStatus = 0
+	CallStatus_CALL_STATUS_REGISTERED  CallStatus = 1
+	CallStatus_CALL_STATUS_ASSIGNED    CallStatus = 2
+	CallStatus_CALL_STATUS_REASSIGNED  CallStatus = 3
+	CallStatus_CALL_STATUS_ANSWERED    CallStatus = 4
+	CallStatus_CALL_STATUS_COMPLETED   CallStatus = 5
+	CallStatus_CALL_STATUS_CANCELLED   CallStatus = 6
+	CallStatus_CALL_STATUS_EXPIRED     CallStatus = 7
+)
+
+// Enum value maps for CallStatus.
+var (
+	CallStatus_name = map[int32]string{
+		0: "CALL_STATUS_UNSPECIFIED",
+		1: "CALL_STATUS_REGISTERED",
+		2: "CALL_STATUS_ASSIGNED",
+		3: "CALL_STATUS_REASSIGNED",
+		4: "CALL_STATUS_ANSWERED",
+		5: "CALL_STATUS_COMPLETED",
+		6: "CALL_STATUS_CANCELLED",
+		7: "CALL_STATUS_EXPIRED",
+	}
+	CallStatus_value = map[string]int32{
+		"CALL_STATUS_UNSPECIFIED": 0,
+		"CALL_STATUS_REGISTERED":  1,
+		"CALL_STATUS_ASSIGNED":    2,
+		"CALL_STATUS_REASSIGNED":  3,
+		"CALL_STATUS_ANSWERED":    4,
+		"CALL_STATUS_COMPLETED":   5,
+		"CALL_STATUS_CANCELLED":   6,
+		"CALL_STATUS_EXPIRED":     7,
+	}
+)
+
+func (x CallStatus) Enum() *CallStatus {
+	p := new(CallStatus)
+	*p = x
+	return p
+}
+
+func (x CallStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CallStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_elevatormgr_proto_enumTypes[2].Descriptor()
+}
+
+func (CallStatus) Type() protoreflect.EnumType {
+	return &file_elevatormgr_proto_enumTypes[2]
+}
+
+func (x CallStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CallStatus.Descriptor instead.
+func (CallStatus) EnumDescriptor() ([]byte, []int) {
+	return file_elevatormgr_proto_rawDescGZIP(), []int{2}
+}
+
+type Building struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id        int32   `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Floors    int32   `protobuf:"varint,2,opt,name=floors,proto3" json:"floors,omitempty"`
+	Elevators []int32 `protobuf:"varint,3,rep,packed,name=elevators,proto3" json:"elevators,omitempty"`
+}
+
+func (x *Building) Reset() {
+	*x = Building{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_elevatormgr_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Building) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Building) ProtoMessage() {}
+
+func (x *Building) ProtoReflect() protoreflect.Message {
+	mi := &file_elevatormgr_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Building.ProtoReflect.Descriptor instead.
+func (*Building) Descriptor() ([]byte, []int) {
+	return file_elevatormgr_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Building) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Building) GetFloors() int32 {
+	if x != nil {
+		return x.Floors
+	}
+	return 0
+}
+
+func (x *Building) GetElevators() []int32 {
+	if x != nil {
+		return x.Elevators
+	}
+	return nil
+}
+
+type Call struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Floor int32 `protobuf:"varint,1,opt,name=floor,proto3" json:"floor,omitempty"`
+	// 1 up, -1 down
+	Direction int32    `protobuf:"varint,2,opt,name=direction,proto3" json:"direction,omitempty"`
+	Type      CallType `protobuf:"varint,3,opt,name=type,proto3,enum=elevatormgr.v1.CallType" json:"type,omitempty"`
+	// unix nanoseconds
+	RegisteredAt int64 `protobuf:"varint,4,opt,name=registered_at,json=registeredAt,proto3" json:"registered_at,omitempty"`
+	// a maintenance override, always served first
+	Express bool `protobuf:"varint,5,opt,name=express,proto3" json:"express,omitempty"`
+}
+
+func (x *Call) Reset() {
+	*x = Call{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_elevatormgr_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Call) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Call) ProtoMessage() {}
+
+func (x *Call) ProtoReflect() protoreflect.Message {
+	mi := &file_elevatormgr_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Call.ProtoReflect.Descriptor instead.
+func (*Call) Descriptor() ([]byte, []int) {
+	return file_elevatormgr_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Call) GetFloor() int32 {
+	if x != nil {
+		return x.Floor
+	}
+	return 0
+}
+
+func (x *Call) GetDirection() int32 {
+	if x != nil {
+		return x.Direction
+	}
+	return 0
+}
+
+func (x *Call) GetType() CallType {
+	if x != nil {
+		return x.Type
+	}
+	return CallType_CALL_TYPE_UNSPECIFIED
+}
+
+func (x *Call) GetRegisteredAt() int64 {
+	if x != nil {
+		return x.RegisteredAt
+	}
+	return 0
+}
+
+func (x *Call) GetExpress() bool {
+	if x != nil {
+		return x.Express
+	}
+	return false
+}
+
+type Elevator struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Building     int32   `protobuf:"varint,1,opt,name=building,proto3" json:"building,omitempty"`
+	Id           int32   `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	CurrentFloor int32   `protobuf:"varint,3,opt,name=current_floor,json=currentFloor,proto3" json:"current_floor,omitempty"`
+	Direction    int32   `protobuf:"varint,4,opt,name=direction,proto3" json:"direction,omitempty"`
+	MaxFloor     int32   `protobuf:"varint,5,opt,name=max_floor,json=maxFloor,proto3" json:"max_floor,omitempty"`
+	InService    bool    `protobuf:"varint,6,opt,name=in_service,json=inService,proto3" json:"in_service,omitempty"`
+	Mode         Mode    `protobuf:"varint,7,opt,name=mode,proto3,enum=elevatormgr.v1.Mode" json:"mode,omitempty"`
+	DoorsOpen    bool    `protobuf:"varint,8,opt,name=doors_open,json=doorsOpen,proto3" json:"doors_open,omitempty"`
+	Calls        []*Call `protobuf:"bytes,9,rep,name=calls,proto3" json:"calls,omitempty"`
+	// calls put aside while the car runs a priority call
+	DeferredCalls []*Call `protobuf:"bytes,10,rep,name=deferred_calls,json=deferredCalls,proto3" json:"deferred_calls,omitempty"`
+	// floors the car stops at, all of them when empty
+	ServedFloors []int32 `protobuf:"varint,11,rep,packed,name=served_floors,json=servedFloors,proto3" json:"served_floors,omitempty"`
+	// passengers
+	Capacity int32 `protobuf:"varint,12,opt,name=capacity,proto3" json:"capacity,omitempty"`
+	// metres per second
+	Speed float64 `protobuf:"fixed64,13,opt,name=speed,proto3" json:"speed,omitempty"`
+}
+
+func (x *Elevator) Reset() {
+	*x = Elevator{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_elevatormgr_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Elevator) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Elevator) ProtoMessage() {}
+
+func (x *Elevator) ProtoReflect() protoreflect.Message {
+	mi := &file_elevatormgr_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Elevator.ProtoReflect.Descriptor instead.
+func (*Elevator) Descriptor() ([]byte, []int) {
+	return file_elevatormgr_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Elevator) GetBuilding() int32 {
+	if x != nil {
+		return x.Building
+	}
+	return 0
+}
+
+func (x *Elevator) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Elevator) GetCurrentFloor() int32 {
+	if x != nil {
+		return x.CurrentFloor
+	}
+	return 0
+}
+
+func (x *Elevator) GetDirection() int32 {
+	if x != nil {
+		return x.Direction
+	}
+	return 0
+}
+
+func (x *Elevator) GetMaxFloor() int32 {
+	if x != nil {
+		return x.MaxFloor
+	}
+	return 0
+}
+
+func (x *Elevator) GetInService() bool {
+	if x != nil {
+		return x.InService
+	}
+	return false
+}
+
+func (x *Elevator) GetMode() Mode {
+	if x != nil {
+		return x.Mode
+	}
+	return Mode_MODE_UNSPECIFIED
+}
+
+func (x *Elevator) GetDoorsOpen() bool {
+	if x != nil {
+		return x.DoorsOpen
+	}
+	return false
+}
+
+func (x *Elevator) GetCalls() []*Call {
+	if x != nil {
+		return x.Calls
+	}
+	return nil
+}
+
+func (x *Elevator) GetDeferredCalls() []*Call {
+	if x != nil {
+		return x.DeferredCalls
+	}
+	return nil
+}
+
+func (x *Elevator) GetServedFloors() []int32 {
+	if x != nil {
+		return x.ServedFloors
+	}
+	return nil
+}
+
+func (x *Elevator) GetCapacity() int32 {
+	if x != nil {
+		return x.Capacity
+	}
+	return 0
+}
+
+func (x *Elevator) GetSpeed() float64 {
+	if x != nil {
+		return x.Speed
+	}
+	return 0
+}
+
+type TicketStatusChange struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status   CallStatus `protobuf:"varint,1,opt,name=status,proto3,enum=elevatormgr.v1.CallStatus" json:"status,omitempty"`
+	Elevator int32      `protobuf:"varint,2,opt,name=elevator,proto3" json:"elevator,omitempty"`
+	// unix nanoseconds
+	Time int64 `protobuf:"varint,3,opt,name=time,proto3" json:"time,omitempty"`
+}
+
+func (x *TicketStatusChange) Reset() {
+	*x = TicketStatusChange{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_elevatormgr_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TicketStatusChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TicketStatusChange) ProtoMessage() {}
+
+func (x *TicketStatusChange) ProtoReflect() protoreflect.Message {
+	mi := &file_elevatormgr_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TicketStatusChange.ProtoReflect.Descriptor instead.
+func (*TicketStatusChange) Descriptor() ([]byte, []int) {
+	return file_elevatormgr_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *TicketStatusChange) GetStatus() CallStatus {
+	if x != nil {
+		return x.Status
+	}
+	return CallStatus_CALL_STATUS_UNSPECIFIED
+}
+
+func (x *TicketStatusChange) GetElevator() int32 {
+	if x != nil {
+		return x.Elevator
+	}
+	return 0
+}
+
+func (x *TicketStatusChange) GetTime() int64 {
+	if x != nil {
+		return x.Time
+	}
+	return 0
+}
+
+type Ticket struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id        string                `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Building  int32                 `protobuf:"varint,2,opt,name=building,proto3" json:"building,omitempty"`
+	Type      CallType              `protobuf:"varint,3,opt,name=type,proto3,enum=elevatormgr.v1.CallType" json:"type,omitempty"`
+	Floor     int32                 `protobuf:"varint,4,opt,name=floor,proto3" json:"floor,omitempty"`
+	Direction int32                 `protobuf:"varint,5,opt,name=direction,proto3" json:"direction,omitempty"`
+	Elevator  int32                 `protobuf:"varint,6,opt,name=elevator,proto3" json:"elevator,omitempty"`
+	Status    CallStatus            `protobuf:"varint,7,opt,name=status,proto3,enum=elevatormgr.v1.CallStatus" json:"status,omitempty"`
+	History   []*TicketStatusChange `protobuf:"bytes,8,rep,name=history,proto3" json:"history,omitempty"`
+}
+
+func (x *Ticket) Reset() {
+	*x = Ticket{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_elevatormgr_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Ticket) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Ticket) ProtoMessage() {}
+
+func (x *Ticket) ProtoReflect() protoreflect.Message {
+	mi := &file_elevatormgr_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Ticket.ProtoReflect.Descriptor instead.
+func (*Ticket) Descriptor() ([]byte, []int) {
+	return file_elevatormgr_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *Ticket) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Ticket) GetBuilding() int32 {
+	if x != nil {
+		return x.Building
+	}
+	return 0
+}
+
+func (x *Ticket) GetType() CallType {
+	if x != nil {
+		return x.Type
+	}
+	return CallType_CALL_TYPE_UNSPECIFIED
+}
+
+func (x *Ticket) GetFloor() int32 {
+	if x != nil {
+		return x.Floor
+	}
+	return 0
+}
+
+func (x *Ticket) GetDirection() int32 {
+	if x != nil {
+		return x.Direction
+	}
+	return 0
+}
+
+func (x *Ticket) GetElevator() int32 {
+	if x != nil {
+		return x.Elevator
+	}
+	return 0
+}
+
+func (x *Ticket) GetStatus() CallStatus {
+	if x != nil {
+		return x.Status
+	}
+	return CallStatus_CALL_STATUS_UNSPECIFIED
+}
+
+func (x *Ticket) GetHistory() []*TicketStatusChange {
+	if x != nil {
+		return x.History
+	}
+	return nil
+}
+
+type HallCall struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Floor     int32 `protobuf:"varint,1,opt,name=floor,proto3" json:"floor,omitempty"`
+	Direction int32 `protobuf:"varint,2,opt,name=direction,proto3" json:"direction,omitempty"`
+	Elevator  int32 `protobuf:"varint,3,opt,name=elevator,proto3" json:"elevator,omitempty"`
+	// unix nanoseconds
+	RegisteredAt int64  `protobuf:"varint,4,opt,name=registered_at,json=registeredAt,proto3" json:"registered_at,omitempty"`
+	AssignedAt   int64  `protobuf:"varint,5,opt,name=assigned_at,json=assignedAt,proto3" json:"assigned_at,omitempty"`
+	Ticket       string `protobuf:"bytes,6,opt,name=ticket,proto3" json:"ticket,omitempty"`
+	// zero until the call is overdue
+	EscalatedAt int64 `protobuf:"varint,7,opt,name=escalated_at,json=escalatedAt,proto3" json:"escalated_at,omitempty"`
+}
+
+func (x *HallCall) Reset() {
+	*x = HallCall{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_elevatormgr_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HallCall) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HallCall) ProtoMessage() {}
+
+func (x *HallCall) ProtoReflect() protoreflect.Message {
+	mi := &file_elevatormgr_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HallCall.ProtoReflect.Descriptor instead.
+func (*HallCall) Descriptor() ([]byte, []int) {
+	return file_elevatormgr_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *HallCall) GetFloor() int32 {
+	if x != nil {
+		return x.Floor
+	}
+	return 0
+}
+
+func (x *HallCall) GetDirection() int32 {
+	if x != nil {
+		return x.Direction
+	}
+	return 0
+}
+
+func (x *HallCall) GetElevator() int32 {
+	if x != nil {
+		return x.Elevator
+	}
+	return 0
+}
+
+func (x *HallCall) GetRegisteredAt() int64 {
+	if x != nil {
+		return x.RegisteredAt
+	}
+	return 0
+}
+
+func (x *HallCall) GetAssignedAt() int64 {
+	if x != nil {
+		return x.AssignedAt
+	}
+	return 0
+}
+
+func (x *HallCall) GetTicket() string {
+	if x != nil {
+		return x.Ticket
+	}
+	return ""
+}
+
+func (x *HallCall) GetEscalatedAt() int64 {
+	if x != nil {
+		return x.EscalatedAt
+	}
+	return 0
+}
+
+// where a hall call went when its car stopped taking them, elevator is -1
+// when no car could take it
+type Reassignment struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Call     *Call `protobuf:"bytes,1,opt,name=call,proto3" json:"call,omitempty"`
+	Elevator int32 `protobuf:"varint,2,opt,name=elevator,proto3" json:"elevator,omitempty"`
+}
+
+func (x *Reassignment) Reset() {
+	*x = Reassignment{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_elevatormgr_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Reassignment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Reassignment) ProtoMessage() {}
+
+func (x *Reassignment) ProtoReflect() protoreflect.Message {
+	mi := &file_elevatormgr_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Reassignment.ProtoReflect.Descriptor instead.
+func (*Reassignment) Descriptor() ([]byte, []int) {
+	return file_elevatormgr_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *Reassignment) GetCall() *Call {
+	if x != nil {
+		return x.Call
+	}
+	return nil
+}
+
+func (x *Reassignment) GetElevator() int32 {
+	if x != nil {
+		return x.Elevator
+	}
+	return 0
+}
+
+type ListBuildingsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ListBuildingsRequest) Reset() {
+	*x = ListBuildingsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_elevatormgr_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListBuildingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBuildingsRequest) ProtoMessage() {}
+
+func (x *ListBuildingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_elevatormgr_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBuildingsRequest.ProtoReflect.Descriptor instead.
+func (*ListBuildingsRequest) Descriptor() ([]byte, []int) {
+	return file_elevatormgr_proto_rawDescGZIP(), []int{7}
+}
+
+type ListBuildingsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Buildings []*Building `protobuf:"bytes,1,rep,name=buildings,proto3" json:"buildings,omitempty"`
+}
+
+func (x *ListBuildingsResponse) Reset() {
+	*x = ListBuildingsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_elevatormgr_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListBuildingsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBuildingsResponse) ProtoMessage() {}
+
+func (x *ListBuildingsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_elevatormgr_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBuildingsResponse.ProtoReflect.Descriptor instead.
+func (*ListBuildingsResponse) Descriptor() ([]byte, []int) {
+	return file_elevatormgr_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ListBuildingsResponse) GetBuildings() []*Building {
+	if x != nil {
+		return x.Buildings
+	}
+	return nil
+}
+
+type GetElevatorsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Building int32 `protobuf:"varint,1,opt,name=building,proto3" json:"building,omitempty"`
+}
+
+func (x *GetElevatorsRequest) Reset() {
+	*x = GetElevatorsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_elevatormgr_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetElevatorsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetElevatorsRequest) ProtoMessage() {}
+
+func (x *GetElevatorsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_elevatormgr_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetElevatorsRequest.ProtoReflect.Descriptor instead.
+func (*GetElevatorsRequest) Descriptor() ([]byte, []int) {
+	return file_elevatormgr_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *GetElevatorsRequest) GetBuilding() int32 {
+	if x != nil {
+		return x.Building
+	}
+	return 0
+}
+
+type GetElevatorsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Elevators []*Elevator `protobuf:"bytes,1,rep,name=elevators,proto3" json:"elevators,omitempty"`
+}
+
+func (x *GetElevatorsResponse) Reset() {
+	*x = GetElevatorsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_elevatormgr_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetElevatorsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetElevatorsResponse) ProtoMessage() {}
+
+func (x *GetElevatorsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_elevatormgr_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetElevatorsResponse.ProtoReflect.Descriptor instead.
+func (*GetElevatorsResponse) Descriptor() ([]byte, []int) {
+	return file_elevatormgr_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *GetElevatorsResponse) GetElevators() []*Elevator {
+	if x != nil {
+		return x.Elevators
+	}
+	return nil
+}
+
+type GetElevatorRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Building int32 `protobuf:"varint,1,opt,name=building,proto3" json:"building,omitempty"`
+	Elevator int32 `protobuf:"varint,2,opt,name=elevator,proto3" json:"elevator,omitempty"`
+}
+
+func (x *GetElevatorRequest) Reset() {
+	*x = GetElevatorRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_elevatormgr_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetElevatorRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetElevatorRequest) ProtoMessage() {}
+
+func (x *GetElevatorRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_elevatormgr_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetElevatorRequest.ProtoReflect.Descriptor instead.
+func (*GetElevatorRequest) Descriptor() ([]byte, []int) {
+	return file_elevatormgr_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *GetElevatorRequest) GetBuilding() int32 {
+	if x != nil {
+		return x.Building
+	}
+	return 0
+}
+
+func (x *GetElevatorRequest) GetElevator() int32 {
+	if x != nil {
+		return x.Elevator
+	}
+	return 0
+}
+
+type WatchElevatorsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Building int32 `protobuf:"varint,1,opt,name=building,proto3" json:"building,omitempty"`
+}
+
+func (x *WatchElevatorsRequest) Reset() {
+	*x = WatchElevatorsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_elevatormgr_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WatchElevatorsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchElevatorsRequest) ProtoMessage() {}
+
+func (x *WatchElevatorsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_elevatormgr_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchElevatorsRequest.ProtoReflect.Descriptor instead.
+func (*WatchElevatorsRequest) Descriptor() ([]byte, []int) {
+	return file_elevatormgr_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *WatchElevatorsRequest) GetBuilding() int32 {
+	if x != nil {
+		return x.Building
+	}
+	return 0
+}
+
+type CallElevatorRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Building  int32 `protobuf:"varint,1,opt,name=building,proto3" json:"building,omitempty"`
+	Floor     int32 `protobuf:"varint,2,opt,name=floor,proto3" json:"floor,omitempty"`
+	Direction int32 `protobuf:"varint,3,opt,name=direction,proto3" json:"direction,omitempty"`
+}
+
+func (x *CallElevatorRequest) Reset() {
+	*x = CallElevatorRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_elevatormgr_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CallElevatorRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CallElevatorRequest) ProtoMessage() {}
+
+func (x *CallElevatorRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_elevatormgr_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CallElevatorRequest.ProtoReflect.Descriptor instead.
+func (*CallElevatorRequest) Descriptor() ([]byte, []int) {
+	return file_elevatormgr_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *CallElevatorRequest) GetBuilding() int32 {
+	if x != nil {
+		return x.Building
+	}
+	return 0
+}
+
+func (x *CallElevatorRequest) GetFloor() int32 {
+	if x != nil {
+		return x.Floor
+	}
+	return 0
+}
+
+func (x *CallElevatorRequest) GetDirection() int32 {
+	if x != nil {
+		return x.Direction
+	}
+	return 0
+}
+
+type PushDestinationRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Building int32 `protobuf:"varint,1,opt,name=building,proto3" json:"building,omitempty"`
+	Elevator int32 `protobuf:"varint,2,opt,name=elevator,proto3" json:"elevator,omitempty"`
+	Floor    int32 `protobuf:"varint,3,opt,name=floor,proto3" json:"floor,omitempty"`
+}
+
+func (x *PushDestinationRequest) Reset() {
+	*x = PushDestinationRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_elevatormgr_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PushDestinationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PushDestinationRequest) ProtoMessage() {}
+
+func (x *PushDestinationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_elevatormgr_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PushDestinationRequest.ProtoReflect.Descriptor instead.
+func (*PushDestinationRequest) Descriptor() ([]byte, []int) {
+	return file_elevatormgr_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *PushDestinationRequest) GetBuilding() int32 {
+	if x != nil {
+		return x.Building
+	}
+	return 0
+}
+
+func (x *PushDestinationRequest) GetElevator() int32 {
+	if x != nil {
+		return x.Elevator
+	}
+	return 0
+}
+
+func (x *PushDestinationRequest) GetFloor() int32 {
+	if x != nil {
+		return x.Floor
+	}
+	return 0
+}
+
+type CancelHallCallRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Building  int32  `protobuf:"varint,1,opt,name=building,proto3" json:"building,omitempty"`
+	Floor     int32  `protobuf:"varint,2,opt,name=floor,proto3" json:"floor,omitempty"`
+	Direction int32  `protobuf:"varint,3,opt,name=direction,proto3" json:"direction,omitempty"`
+	Reason    string `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (x *CancelHallCallRequest) Reset() {
+	*x = CancelHallCallRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_elevatormgr_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CancelHallCallRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelHallCallRequest) ProtoMessage() {}
+
+func (x *CancelHallCallRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_elevatormgr_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelHallCallRequest.ProtoReflect.Descriptor instead.
+func (*CancelHallCallRequest) Descriptor() ([]byte, []int) {
+	return file_elevatormgr_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *CancelHallCallRequest) GetBuilding() int32 {
+	if x != nil {
+		return x.Building
+	}
+	return 0
+}
+
+func (x *CancelHallCallRequest) GetFloor() int32 {
+	if x != nil {
+		return x.Floor
+	}
+	return 0
+}
+
+func (x *CancelHallCallRequest) GetDirection() int32 {
+	if x != nil {
+		return x.Direction
+	}
+	return 0
+}
+
+func (x *CancelHallCallRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type CancelCarCallRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Building int32  `protobuf:"varint,1,opt,name=building,proto3" json:"building,omitempty"`
+	Elevator int32  `protobuf:"varint,2,opt,name=elevator,proto3" json:"elevator,omitempty"`
+	Floor    int32  `protobuf:"varint,3,opt,name=floor,proto3" json:"floor,omitempty"`
+	Reason   string `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (x *CancelCarCallRequest) Reset() {
+	*x = CancelCarCallRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_elevatormgr_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CancelCarCallRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelCarCallRequest) ProtoMessage() {}
+
+func (x *CancelCarCallRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_elevatormgr_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelCarCallRequest.ProtoReflect.Descriptor instead.
+func (*CancelCarCallRequest) Descriptor() ([]byte, []int) {
+	return file_elevatormgr_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *CancelCarCallRequest) GetBuilding() int32 {
+	if x != nil {
+		return x.Building
+	}
+	return 0
+}
+
+func (x *CancelCarCallRequest) GetElevator() int32 {
+	if x != nil {
+		return x.Elevator
+	}
+	return 0
+}
+
+func (x *CancelCarCallRequest) GetFloor() int32 {
+	if x != nil {
+		return x.Floor
+	}
+	return 0
+}
+
+func (x *CancelCarCallRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type CancelResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *CancelResponse) Reset() {
+	*x = CancelResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_elevatormgr_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CancelResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelResponse) ProtoMessage() {}
+
+func (x *CancelResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_elevatormgr_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelResponse.ProtoReflect.Descriptor instead.
+func (*CancelResponse) Descriptor() ([]byte, []int) {
+	return file_elevatormgr_proto_rawDescGZIP(), []int{17}
+}
+
+type GetCallRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Building int32  `protobuf:"varint,1,opt,name=building,proto3" json:"building,omitempty"`
+	Id       string `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *GetCallRequest) Reset() {
+	*x = GetCallRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_elevatormgr_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetCallRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCallRequest) ProtoMessage() {}
+
+func (x *GetCallRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_elevatormgr_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCallRequest.ProtoReflect.Descriptor instead.
+func (*GetCallRequest) Descriptor() ([]byte, []int) {
+	return file_elevatormgr_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *GetCallRequest) GetBuilding() int32 {
+	if x != nil {
+		return x.Building
+	}
+	return 0
+}
+
+func (x *GetCallRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type GetHallCallsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Building int32 `protobuf:"varint,1,opt,name=building,proto3" json:"building,omitempty"`
+	// only the ones waiting longer than the building's MaxWait
+	Overdue bool `protobuf:"varint,2,opt,name=overdue,proto3" json:"overdue,omitempty"`
+}
+
+func (x *GetHallCallsRequest) Reset() {
+	*x = GetHallCallsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_elevatormgr_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetHallCallsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetHallCallsRequest) ProtoMessage() {}
+
+func (x *GetHallCallsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_elevatormgr_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetHallCallsRequest.ProtoReflect.Descriptor instead.
+func (*GetHallCallsRequest) Descriptor() ([]byte, []int) {
+	return file_elevatormgr_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *GetHallCallsRequest) GetBuilding() int32 {
+	if x != nil {
+		return x.Building
+	}
+	return 0
+}
+
+func (x *GetHallCallsRequest) GetOverdue() bool {
+	if x != nil {
+		return x.Overdue
+	}
+	return false
+}
+
+type GetHallCallsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	HallCalls []*HallCall `protobuf:"bytes,1,rep,name=hall_calls,json=hallCalls,proto3" json:"hall_calls,omitempty"`
+}
+
+func (x *GetHallCallsResponse) Reset() {
+	*x = GetHallCallsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_elevatormgr_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetHallCallsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetHallCallsResponse) ProtoMessage() {}
+
+func (x *GetHallCallsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_elevatormgr_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetHallCallsResponse.ProtoReflect.Descriptor instead.
+func (*GetHallCallsResponse) Descriptor() ([]byte, []int) {
+	return file_elevatormgr_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *GetHallCallsResponse) GetHallCalls() []*HallCall {
+	if x != nil {
+		return x.HallCalls
+	}
+	return nil
+}
+
+// reason goes in the audit log on every maintenance command
+type ElevatorCommand struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Building int32  `protobuf:"varint,1,opt,name=building,proto3" json:"building,omitempty"`
+	Elevator int32  `protobuf:"varint,2,opt,name=elevator,proto3" json:"elevator,omitempty"`
+	Reason   string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (x *ElevatorCommand) Reset() {
+	*x = ElevatorCommand{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_elevatormgr_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ElevatorCommand) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ElevatorCommand) ProtoMessage() {}
+
+func (x *ElevatorCommand) ProtoReflect() protoreflect.Message {
+	mi := &file_elevatormgr_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ElevatorCommand.ProtoReflect.Descriptor instead.
+func (*ElevatorCommand) Descriptor() ([]byte, []int) {
+	return file_elevatormgr_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ElevatorCommand) GetBuilding() int32 {
+	if x != nil {
+		return x.Building
+	}
+	return 0
+}
+
+func (x *ElevatorCommand) GetElevator() int32 {
+	if x != nil {
+		return x.Elevator
+	}
+	return 0
+}
+
+func (x *ElevatorCommand) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type SetInServiceRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Building  int32  `protobuf:"varint,1,opt,name=building,proto3" json:"building,omitempty"`
+	Elevator  int32  `protobuf:"varint,2,opt,name=elevator,proto3" json:"elevator,omitempty"`
+	InService bool   `protobuf:"varint,3,opt,name=in_service,json=inService,proto3" json:"in_service,omitempty"`
+	Reason    string `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (x *SetInServiceRequest) Reset() {
+	*x = SetInServiceRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_elevatormgr_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetInServiceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetInServiceRequest) ProtoMessage() {}
+
+func (x *SetInServiceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_elevatormgr_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetInServiceRequest.ProtoReflect.Descriptor instead.
+func (*SetInServiceRequest) Descriptor() ([]byte, []int) {
+	return file_elevatormgr_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *SetInServiceRequest) GetBuilding() int32 {
+	if x != nil {
+		return x.Building
+	}
+	return 0
+}
+
+func (x *SetInServiceRequest) GetElevator() int32 {
+	if x != nil {
+		return x.Elevator
+	}
+	return 0
+}
+
+func (x *SetInServiceRequest) GetInService() bool {
+	if x != nil {
+		return x.InService
+	}
+	return false
+}
+
+func (x *SetInServiceRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type SetIndependentServiceRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Building int32  `protobuf:"varint,1,opt,name=building,proto3" json:"building,omitempty"`
+	Elevator int32  `protobuf:"varint,2,opt,name=elevator,proto3" json:"elevator,omitempty"`
+	On       bool   `protobuf:"varint,3,opt,name=on,proto3" json:"on,omitempty"`
+	Reason   string `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (x *SetIndependentServiceRequest) Reset() {
+	*x = SetIndependentServiceRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_elevatormgr_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetIndependentServiceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetIndependentServiceRequest) ProtoMessage() {}
+
+func (x *SetIndependentServiceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_elevatormgr_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetIndependentServiceRequest.ProtoReflect.Descriptor instead.
+func (*SetIndependentServiceRequest) Descriptor() ([]byte, []int) {
+	return file_elevatormgr_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *SetIndependentServiceRequest) GetBuilding() int32 {
+	if x != nil {
+		return x.Building
+	}
+	return 0
+}
+
+func (x *SetIndependentServiceRequest) GetElevator() int32 {
+	if x != nil {
+		return x.Elevator
+	}
+	return 0
+}
+
+func (x *SetIndependentServiceRequest) GetOn() bool {
+	if x != nil {
+		return x.On
+	}
+	return false
+}
+
+func (x *SetIndependentServiceRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type OverrideCallRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Building  int32  `protobuf:"varint,1,opt,name=building,proto3" json:"building,omitempty"`
+	Elevator  int32  `protobuf:"varint,2,opt,name=elevator,proto3" json:"elevator,omitempty"`
+	Floor     int32  `protobuf:"varint,3,opt,name=floor,proto3" json:"floor,omitempty"`
+	Direction int32  `protobuf:"varint,4,opt,name=direction,proto3" json:"direction,omitempty"`
+	Reason    string `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (x *OverrideCallRequest) Reset() {
+	*x = OverrideCallRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_elevatormgr_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OverrideCallRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OverrideCallRequest) ProtoMessage() {}
+
+func (x *OverrideCallRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_elevatormgr_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OverrideCallRequest.ProtoReflect.Descriptor instead.
+func (*OverrideCallRequest) Descriptor() ([]byte, []int) {
+	return file_elevatormgr_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *OverrideCallRequest) GetBuilding() int32 {
+	if x != nil {
+		return x.Building
+	}
+	return 0
+}
+
+func (x *OverrideCallRequest) GetElevator() int32 {
+	if x != nil {
+		return x.Elevator
+	}
+	return 0
+}
+
+func (x *OverrideCallRequest) GetFloor() int32 {
+	if x != nil {
+		return x.Floor
+	}
+	return 0
+}
+
+func (x *OverrideCallRequest) GetDirection() int32 {
+	if x != nil {
+		return x.Direction
+	}
+	return 0
+}
+
+func (x *OverrideCallRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type ReassignHallCallRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Building  int32  `protobuf:"varint,1,opt,name=building,proto3" json:"building,omitempty"`
+	Floor     int32  `protobuf:"varint,2,opt,name=floor,proto3" json:"floor,omitempty"`
+	Direction int32  `protobuf:"varint,3,opt,name=direction,proto3" json:"direction,omitempty"`
+	Elevator  int32  `protobuf:"varint,4,opt,name=elevator,proto3" json:"elevator,omitempty"`
+	Reason    string `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (x *ReassignHallCallRequest) Reset() {
+	*x = ReassignHallCallRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_elevatormgr_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReassignHallCallRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReassignHallCallRequest) ProtoMessage() {}
+
+func (x *ReassignHallCallRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_elevatormgr_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReassignHallCallRequest.ProtoReflect.Descriptor instead.
+func (*ReassignHallCallRequest) Descriptor() ([]byte, []int) {
+	return file_elevatormgr_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ReassignHallCallRequest) GetBuilding() int32 {
+	if x != nil {
+		return x.Building
+	}
+	return 0
+}
+
+func (x *ReassignHallCallRequest) GetFloor() int32 {
+	if x != nil {
+		return x.Floor
+	}
+	return 0
+}
+
+func (x *ReassignHallCallRequest) GetDirection() int32 {
+	if x != nil {
+		return x.Direction
+	}
+	return 0
+}
+
+func (x *ReassignHallCallRequest) GetElevator() int32 {
+	if x != nil {
+		return x.Elevator
+	}
+	return 0
+}
+
+func (x *ReassignHallCallRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+// the car after a maintenance command and where its hall calls went.  When
+// the command is waiting on approval only approval_id is set.
+type CarChange struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Elevator   *Elevator       `protobuf:"bytes,1,opt,name=elevator,proto3" json:"elevator,omitempty"`
+	Reassigned []*Reassignment `protobuf:"bytes,2,rep,name=reassigned,proto3" json:"reassigned,omitempty"`
+	ApprovalId string          `protobuf:"bytes,3,opt,name=approval_id,json=approvalId,proto3" json:"approval_id,omitempty"`
+}
+
+func (x *CarChange) Reset() {
+	*x = CarChange{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_elevatormgr_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CarChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CarChange) ProtoMessage() {}
+
+func (x *CarChange) ProtoReflect() protoreflect.Message {
+	mi := &file_elevatormgr_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CarChange.ProtoReflect.Descriptor instead.
+func (*CarChange) Descriptor() ([]byte, []int) {
+	return file_elevatormgr_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *CarChange) GetElevator() *Elevator {
+	if x != nil {
+		return x.Elevator
+	}
+	return nil
+}
+
+func (x *CarChange) GetReassigned() []*Reassignment {
+	if x != nil {
+		return x.Reassigned
+	}
+	return nil
+}
+
+func (x *CarChange) GetApprovalId() string {
+	if x != nil {
+		return x.ApprovalId
+	}
+	return ""
+}
+
+// a command on the whole building
+type BuildingCommand struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Building int32  `protobuf:"varint,1,opt,name=building,proto3" json:"building,omitempty"`
+	Reason   string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (x *BuildingCommand) Reset() {
+	*x = BuildingCommand{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_elevatormgr_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BuildingCommand) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BuildingCommand) ProtoMessage() {}
+
+func (x *BuildingCommand) ProtoReflect() protoreflect.Message {
+	mi := &file_elevatormgr_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BuildingCommand.ProtoReflect.Descriptor instead.
+func (*BuildingCommand) Descriptor() ([]byte, []int) {
+	return file_elevatormgr_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *BuildingCommand) GetBuilding() int32 {
+	if x != nil {
+		return x.Building
+	}
+	return 0
+}
+
+func (x *BuildingCommand) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+// a change parked for a second person
+type PendingChange struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ApprovalId string `protobuf:"bytes,1,opt,name=approval_id,json=approvalId,proto3" json:"approval_id,omitempty"`
+	Action     string `protobuf:"bytes,2,opt,name=action,proto3" json:"action,omitempty"`
+	// unix nanoseconds
+	ExpiresAt int64 `protobuf:"varint,3,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+}
+
+func (x *PendingChange) Reset() {
+	*x = PendingChange{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_elevatormgr_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PendingChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PendingChange) ProtoMessage() {}
+
+func (x *PendingChange) ProtoReflect() protoreflect.Message {
+	mi := &file_elevatormgr_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PendingChange.ProtoReflect.Descriptor instead.
+func (*PendingChange) Descriptor() ([]byte, []int) {
+	return file_elevatormgr_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *PendingChange) GetApprovalId() string {
+	if x != nil {
+		return x.ApprovalId
+	}
+	return ""
+}
+
+func (x *PendingChange) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *PendingChange) GetExpiresAt() int64 {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return 0
+}
+
+type PriorityCallRecord struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// unix nanoseconds
+	Time        int64  `protobuf:"varint,1,opt,name=time,proto3" json:"time,omitempty"`
+	Floor       int32  `protobuf:"varint,2,opt,name=floor,proto3" json:"floor,omitempty"`
+	Destination int32  `protobuf:"varint,3,opt,name=destination,proto3" json:"destination,omitempty"`
+	Elevator    int32  `protobuf:"varint,4,opt,name=elevator,proto3" json:"elevator,omitempty"`
+	RequestedBy string `protobuf:"bytes,5,opt,name=requested_by,json=requestedBy,proto3" json:"requested_by,omitempty"`
+	Reason      string `protobuf:"bytes,6,opt,name=reason,proto3" json:"reason,omitempty"`
+	Authorized  bool   `protobuf:"varint,7,opt,name=authorized,proto3" json:"authorized,omitempty"`
+	Error       string `protobuf:"bytes,8,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *PriorityCallRecord) Reset() {
+	*x = PriorityCallRecord{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_elevatormgr_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PriorityCallRecord) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PriorityCallRecord) ProtoMessage() {}
+
+func (x *PriorityCallRecord) ProtoReflect() protoreflect.Message {
+	mi := &file_elevatormgr_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PriorityCallRecord.ProtoReflect.Descriptor instead.
+func (*PriorityCallRecord) Descriptor() ([]byte, []int) {
+	return file_elevatormgr_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *PriorityCallRecord) GetTime() int64 {
+	if x != nil {
+		return x.Time
+	}
+	return 0
+}
+
+func (x *PriorityCallRecord) GetFloor() int32 {
+	if x != nil {
+		return x.Floor
+	}
+	return 0
+}
+
+func (x *PriorityCallRecord) GetDestination() int32 {
+	if x != nil {
+		return x.Destination
+	}
+	return 0
+}
+
+func (x *PriorityCallRecord) GetElevator() int32 {
+	if x != nil {
+		return x.Elevator
+	}
+	return 0
+}
+
+func (x *PriorityCallRecord) GetRequestedBy() string {
+	if x != nil {
+		return x.RequestedBy
+	}
+	return ""
+}
+
+func (x *PriorityCallRecord) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *PriorityCallRecord) GetAuthorized() bool {
+	if x != nil {
+		return x.Authorized
+	}
+	return false
+}
+
+func (x *PriorityCallRecord) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type GetPriorityCallLogRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Building int32 `protobuf:"varint,1,opt,name=building,proto3" json:"building,omitempty"`
+}
+
+func (x *GetPriorityCallLogRequest) Reset() {
+	*x = GetPriorityCallLogRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_elevatormgr_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPriorityCallLogRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPriorityCallLogRequest) ProtoMessage() {}
+
+func (x *GetPriorityCallLogRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_elevatormgr_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPriorityCallLogRequest.ProtoReflect.Descriptor instead.
+func (*GetPriorityCallLogRequest) Descriptor() ([]byte, []int) {
+	return file_elevatormgr_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *GetPriorityCallLogRequest) GetBuilding() int32 {
+	if x != nil {
+		return x.Building
+	}
+	return 0
+}
+
+type GetPriorityCallLogResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Records []*PriorityCallRecord `protobuf:"bytes,1,rep,name=records,proto3" json:"records,omitempty"`
+}
+
+func (x *GetPriorityCallLogResponse) Reset() {
+	*x = GetPriorityCallLogResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_elevatormgr_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPriorityCallLogResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPriorityCallLogResponse) ProtoMessage() {}
+
+func (x *GetPriorityCallLogResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_elevatormgr_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPriorityCallLogResponse.ProtoReflect.Descriptor instead.
+func (*GetPriorityCallLogResponse) Descriptor() ([]byte, []int) {
+	return file_elevatormgr_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *GetPriorityCallLogResponse) GetRecords() []*PriorityCallRecord {
+	if x != nil {
+		return x.Records
+	}
+	return nil
+}
+
+type PriorityCallRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Building    int32  `protobuf:"varint,1,opt,name=building,proto3" json:"building,omitempty"`
+	Floor       int32  `protobuf:"varint,2,opt,name=floor,proto3" json:"floor,omitempty"`
+	Destination int32  `protobuf:"varint,3,opt,name=destination,proto3" json:"destination,omitempty"`
+	RequestedBy string `protobuf:"bytes,4,opt,name=requested_by,json=requestedBy,proto3" json:"requested_by,omitempty"`
+	Reason      string `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (x *PriorityCallRequest) Reset() {
+	*x = PriorityCallRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_elevatormgr_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PriorityCallRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PriorityCallRequest) ProtoMessage() {}
+
+func (x *PriorityCallRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_elevatormgr_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PriorityCallRequest.ProtoReflect.Descriptor instead.
+func (*PriorityCallRequest) Descriptor() ([]byte, []int) {
+	return file_elevatormgr_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *PriorityCallRequest) GetBuilding() int32 {
+	if x != nil {
+		return x.Building
+	}
+	return 0
+}
+
+func (x *PriorityCallRequest) GetFloor() int32 {
+	if x != nil {
+		return x.Floor
+	}
+	return 0
+}
+
+func (x *PriorityCallRequest) GetDestination() int32 {
+	if x != nil {
+		return x.Destination
+	}
+	return 0
+}
+
+func (x *PriorityCallRequest) GetRequestedBy() string {
+	if x != nil {
+		return x.RequestedBy
+	}
+	return ""
+}
+
+func (x *PriorityCallRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type MaintenanceWindow struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id       int32 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Elevator int32 `protobuf:"varint,2,opt,name=elevator,proto3" json:"elevator,omitempty"`
+	// unix nanoseconds
+	Start      int64  `protobuf:"varint,3,opt,name=start,proto3" json:"start,omitempty"`
+	End        int64  `protobuf:"varint,4,opt,name=end,proto3" json:"end,omitempty"`
+	Reason     string `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	Technician string `protobuf:"bytes,6,opt,name=technician,proto3" json:"technician,omitempty"`
+	Active     bool   `protobuf:"varint,7,opt,name=active,proto3" json:"active,omitempty"`
+}
+
+func (x *MaintenanceWindow) Reset() {
+	*x = MaintenanceWindow{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_elevatormgr_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MaintenanceWindow) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MaintenanceWindow) ProtoMessage() {}
+
+func (x *MaintenanceWindow) ProtoReflect() protoreflect.Message {
+	mi := &file_elevatormgr_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MaintenanceWindow.ProtoReflect.Descriptor instead.
+func (*MaintenanceWindow) Descriptor() ([]byte, []int) {
+	return file_elevatormgr_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *MaintenanceWindow) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *MaintenanceWindow) GetElevator() int32 {
+	if x != nil {
+		return x.Elevator
+	}
+	return 0
+}
+
+func (x *MaintenanceWindow) GetStart() int64 {
+	if x != nil {
+		return x.Start
+	}
+	return 0
+}
+
+func (x *MaintenanceWindow) GetEnd() int64 {
+	if x != nil {
+		return x.End
+	}
+	return 0
+}
+
+func (x *MaintenanceWindow) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *MaintenanceWindow) GetTechnician() string {
+	if x != nil {
+		return x.Technician
+	}
+	return ""
+}
+
+func (x *MaintenanceWindow) GetActive() bool {
+	if x != nil {
+		return x.Active
+	}
+	return false
+}
+
+type GetMaintenanceWindowsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Building int32 `protobuf:"varint,1,opt,name=building,proto3" json:"building,omitempty"`
+}
+
+func (x *GetMaintenanceWindowsRequest) Reset() {
+	*x = GetMaintenanceWindowsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_elevatormgr_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetMaintenanceWindowsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMaintenanceWindowsRequest) ProtoMessage() {}
+
+func (x *GetMaintenanceWindowsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_elevatormgr_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMaintenanceWindowsRequest.ProtoReflect.Descriptor instead.
+func (*GetMaintenanceWindowsRequest) Descriptor() ([]byte, []int) {
+	return file_elevatormgr_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *GetMaintenanceWindowsRequest) GetBuilding() int32 {
+	if x != nil {
+		return x.Building
+	}
+	return 0
+}
+
+type GetMaintenanceWindowsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Windows []*MaintenanceWindow `protobuf:"bytes,1,rep,name=windows,proto3" json:"windows,omitempty"`
+}
+
+func (x *GetMaintenanceWindowsResponse) Reset() {
+	*x = GetMaintenanceWindowsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_elevatormgr_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetMaintenanceWindowsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMaintenanceWindowsResponse) ProtoMessage() {}
+
+func (x *GetMaintenanceWindowsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_elevatormgr_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMaintenanceWindowsResponse.ProtoReflect.Descriptor instead.
+func (*GetMaintenanceWindowsResponse) Descriptor() ([]byte, []int) {
+	return file_elevatormgr_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *GetMaintenanceWindowsResponse) GetWindows() []*MaintenanceWindow {
+	if x != nil {
+		return x.Windows
+	}
+	return nil
+}
+
+type ScheduleMaintenanceRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Building int32 `protobuf:"varint,1,opt,name=building,proto3" json:"building,omitempty"`
+	Elevator int32 `protobuf:"varint,2,opt,name=elevator,proto3" json:"elevator,omitempty"`
+	// unix nanoseconds
+	Start      int64  `protobuf:"varint,3,opt,name=start,proto3" json:"start,omitempty"`
+	End        int64  `protobuf:"varint,4,opt,name=end,proto3" json:"end,omitempty"`
+	Reason     string `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	Technician string `protobuf:"bytes,6,opt,name=technician,proto3" json:"technician,omitempty"`
+}
+
+func (x *ScheduleMaintenanceRequest) Reset() {
+	*x = ScheduleMaintenanceRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_elevatormgr_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ScheduleMaintenanceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScheduleMaintenanceRequest) ProtoMessage() {}
+
+func (x *ScheduleMaintenanceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_elevatormgr_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScheduleMaintenanceRequest.ProtoReflect.Descriptor instead.
+func (*ScheduleMaintenanceRequest) Descriptor() ([]byte, []int) {
+	return file_elevatormgr_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *ScheduleMaintenanceRequest) GetBuilding() int32 {
+	if x != nil {
+		return x.Building
+	}
+	return 0
+}
+
+func (x *ScheduleMaintenanceRequest) GetElevator() int32 {
+	if x != nil {
+		return x.Elevator
+	}
+	return 0
+}
+
+func (x *ScheduleMaintenanceRequest) GetStart() int64 {
+	if x != nil {
+		return x.Start
+	}
+	return 0
+}
+
+func (x *ScheduleMaintenanceRequest) GetEnd() int64 {
+	if x != nil {
+		return x.End
+	}
+	return 0
+}
+
+func (x *ScheduleMaintenanceRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *ScheduleMaintenanceRequest) GetTechnician() string {
+	if x != nil {
+		return x.Technician
+	}
+	return ""
+}
+
+// the window that was booked.  When the booking is waiting on approval only
+// approval_id is set.
+type MaintenanceWindowChange struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Window     *MaintenanceWindow `protobuf:"bytes,1,opt,name=window,proto3" json:"window,omitempty"`
+	ApprovalId string             `protobuf:"bytes,2,opt,name=approval_id,json=approvalId,proto3" json:"approval_id,omitempty"`
+}
+
+func (x *MaintenanceWindowChange) Reset() {
+	*x = MaintenanceWindowChange{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_elevatormgr_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MaintenanceWindowChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MaintenanceWindowChange) ProtoMessage() {}
+
+func (x *MaintenanceWindowChange) ProtoReflect() protoreflect.Message {
+	mi := &file_elevatormgr_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MaintenanceWindowChange.ProtoReflect.Descriptor instead.
+func (*MaintenanceWindowChange) Descriptor() ([]byte, []int) {
+	return file_elevatormgr_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *MaintenanceWindowChange) GetWindow() *MaintenanceWindow {
+	if x != nil {
+		return x.Window
+	}
+	return nil
+}
+
+func (x *MaintenanceWindowChange) GetApprovalId() string {
+	if x != nil {
+		return x.ApprovalId
+	}
+	return ""
+}
+
+type AgingPolicy struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// nanoseconds, zero leaves aging off
+	MaxWait int64   `protobuf:"varint,1,opt,name=max_wait,json=maxWait,proto3" json:"max_wait,omitempty"`
+	Weight  float64 `protobuf:"fixed64,2,opt,name=weight,proto3" json:"weight,omitempty"`
+}
+
+func (x *AgingPolicy) Reset() {
+	*x = AgingPolicy{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_elevatormgr_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AgingPolicy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AgingPolicy) ProtoMessage() {}
+
+func (x *AgingPolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_elevatormgr_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AgingPolicy.ProtoReflect.Descriptor instead.
+func (*AgingPolicy) Descriptor() ([]byte, []int) {
+	return file_elevatormgr_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *AgingPolicy) GetMaxWait() int64 {
+	if x != nil {
+		return x.MaxWait
+	}
+	return 0
+}
+
+func (x *AgingPolicy) GetWeight() float64 {
+	if x != nil {
+		return x.Weight
+	}
+	return 0
+}
+
+type GetAgingPolicyRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Building int32 `protobuf:"varint,1,opt,name=building,proto3" json:"building,omitempty"`
+}
+
+func (x *GetAgingPolicyRequest) Reset() {
+	*x = GetAgingPolicyRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_elevatormgr_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetAgingPolicyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAgingPolicyRequest) ProtoMessage() {}
+
+func (x *GetAgingPolicyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_elevatormgr_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAgingPolicyRequest.ProtoReflect.Descriptor instead.
+func (*GetAgingPolicyRequest) Descriptor() ([]byte, []int) {
+	return file_elevatormgr_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *GetAgingPolicyRequest) GetBuilding() int32 {
+	if x != nil {
+		return x.Building
+	}
+	return 0
+}
+
+type SetAgingPolicyRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Building int32        `protobuf:"varint,1,opt,name=building,proto3" json:"building,omitempty"`
+	Policy   *AgingPolicy `protobuf:"bytes,2,opt,name=policy,proto3" json:"policy,omitempty"`
+	Reason   string       `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (x *SetAgingPolicyRequest) Reset() {
+	*x = SetAgingPolicyRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_elevatormgr_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetAgingPolicyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetAgingPolicyRequest) ProtoMessage() {}
+
+func (x *SetAgingPolicyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_elevatormgr_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetAgingPolicyRequest.ProtoReflect.Descriptor instead.
+func (*SetAgingPolicyRequest) Descriptor() ([]byte, []int) {
+	return file_elevatormgr_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *SetAgingPolicyRequest) GetBuilding() int32 {
+	if x != nil {
+		return x.Building
+	}
+	return 0
+}
+
+func (x *SetAgingPolicyRequest) GetPolicy() *AgingPolicy {
+	if x != nil {
+		return x.Policy
+	}
+	return nil
+}
+
+func (x *SetAgingPolicyRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type SetSmokeDetectorRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Building int32 `protobuf:"varint,1,opt,name=building,proto3" json:"building,omitempty"`
+	Floor    int32 `protobuf:"varint,2,opt,name=floor,proto3" json:"floor,omitempty"`
+	Active   bool  `protobuf:"varint,3,opt,name=active,proto3" json:"active,omitempty"`
+}
+
+func (x *SetSmokeDetectorRequest) Reset() {
+	*x = SetSmokeDetectorRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_elevatormgr_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetSmokeDetectorRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetSmokeDetectorRequest) ProtoMessage() {}
+
+func (x *SetSmokeDetectorRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_elevatormgr_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetSmokeDetectorRequest.ProtoReflect.Descriptor instead.
+func (*SetSmokeDetectorRequest) Descriptor() ([]byte, []int) {
+	return file_elevatormgr_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *SetSmokeDetectorRequest) GetBuilding() int32 {
+	if x != nil {
+		return x.Building
+	}
+	return 0
+}
+
+func (x *SetSmokeDetectorRequest) GetFloor() int32 {
+	if x != nil {
+		return x.Floor
+	}
+	return 0
+}
+
+func (x *SetSmokeDetectorRequest) GetActive() bool {
+	if x != nil {
+		return x.Active
+	}
+	return false
+}
+
+type SmokeDetector struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Floor  int32 `protobuf:"varint,1,opt,name=floor,proto3" json:"floor,omitempty"`
+	Active bool  `protobuf:"varint,2,opt,name=active,proto3" json:"active,omitempty"`
+}
+
+func (x *SmokeDetector) Reset() {
+	*x = SmokeDetector{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_elevatormgr_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SmokeDetector) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SmokeDetector) ProtoMessage() {}
+
+func (x *SmokeDetector) ProtoReflect() protoreflect.Message {
+	mi := &file_elevatormgr_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SmokeDetector.ProtoReflect.Descriptor instead.
+func (*SmokeDetector) Descriptor() ([]byte, []int) {
+	return file_elevatormgr_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *SmokeDetector) GetFloor() int32 {
+	if x != nil {
+		return x.Floor
+	}
+	return 0
+}
+
+func (x *SmokeDetector) GetActive() bool {
+	if x != nil {
+		return x.Active
+	}
+	return false
+}
+
+type FireRecall struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Active bool `protobuf:"varint,1,opt,name=active,proto3" json:"active,omitempty"`
+	// where the cars were sent, zero once reset
+	RecallFloor int32 `protobuf:"varint,2,opt,name=recall_floor,json=recallFloor,proto3" json:"recall_floor,omitempty"`
+}
+
+func (x *FireRecall) Reset() {
+	*x = FireRecall{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_elevatormgr_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FireRecall) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FireRecall) ProtoMessage() {}
+
+func (x *FireRecall) ProtoReflect() protoreflect.Message {
+	mi := &file_elevatormgr_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FireRecall.ProtoReflect.Descriptor instead.
+func (*FireRecall) Descriptor() ([]byte, []int) {
+	return file_elevatormgr_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *FireRecall) GetActive() bool {
+	if x != nil {
+		return x.Active
+	}
+	return false
+}
+
+func (x *FireRecall) GetRecallFloor() int32 {
+	if x != nil {
+		return x.RecallFloor
+	}
+	return 0
+}
+
+type ActivateEmergencyPowerRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Building    int32  `protobuf:"varint,1,opt,name=building,proto3" json:"building,omitempty"`
+	RunningCars int32  `protobuf:"varint,2,opt,name=running_cars,json=runningCars,proto3" json:"running_cars,omitempty"`
+	Reason      string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (x *ActivateEmergencyPowerRequest) Reset() {
+	*x = ActivateEmergencyPowerRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_elevatormgr_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ActivateEmergencyPowerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ActivateEmergencyPowerRequest) ProtoMessage() {}
+
+func (x *ActivateEmergencyPowerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_elevatormgr_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ActivateEmergencyPowerRequest.ProtoReflect.Descriptor instead.
+func (*ActivateEmergencyPowerRequest) Descriptor() ([]byte, []int) {
+	return file_elevatormgr_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *ActivateEmergencyPowerRequest) GetBuilding() int32 {
+	if x != nil {
+		return x.Building
+	}
+	return 0
+}
+
+func (x *ActivateEmergencyPowerRequest) GetRunningCars() int32 {
+	if x != nil {
+		return x.RunningCars
+	}
+	return 0
+}
+
+func (x *ActivateEmergencyPowerRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type SetEmergencyPowerCarsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Building  int32   `protobuf:"varint,1,opt,name=building,proto3" json:"building,omitempty"`
+	Elevators []int32 `protobuf:"varint,2,rep,packed,name=elevators,proto3" json:"elevators,omitempty"`
+	Reason    string  `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (x *SetEmergencyPowerCarsRequest) Reset() {
+	*x = SetEmergencyPowerCarsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_elevatormgr_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetEmergencyPowerCarsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetEmergencyPowerCarsRequest) ProtoMessage() {}
+
+func (x *SetEmergencyPowerCarsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_elevatormgr_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetEmergencyPowerCarsRequest.ProtoReflect.Descriptor instead.
+func (*SetEmergencyPowerCarsRequest) Descriptor() ([]byte, []int) {
+	return file_elevatormgr_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *SetEmergencyPowerCarsRequest) GetBuilding() int32 {
+	if x != nil {
+		return x.Building
+	}
+	return 0
+}
+
+func (x *SetEmergencyPowerCarsRequest) GetElevators() []int32 {
+	if x != nil {
+		return x.Elevators
+	}
+	return nil
+}
+
+func (x *SetEmergencyPowerCarsRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type GetEmergencyPowerRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Building int32 `protobuf:"varint,1,opt,name=building,proto3" json:"building,omitempty"`
+}
+
+func (x *GetEmergencyPowerRequest) Reset() {
+	*x = GetEmergencyPowerRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_elevatormgr_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetEmergencyPowerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEmergencyPowerRequest) ProtoMessage() {}
+
+func (x *GetEmergencyPowerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_elevatormgr_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEmergencyPowerRequest.ProtoReflect.Descriptor instead.
+func (*GetEmergencyPowerRequest) Descriptor() ([]byte, []int) {
+	return file_elevatormgr_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *GetEmergencyPowerRequest) GetBuilding() int32 {
+	if x != nil {
+		return x.Building
+	}
+	return 0
+}
+
+type EmergencyPowerStatus struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Active      bool    `protobuf:"varint,1,opt,name=active,proto3" json:"active,omitempty"`
+	RunningCars int32   `protobuf:"varint,2,opt,name=running_cars,json=runningCars,proto3" json:"running_cars,omitempty"`
+	Selected    []int32 `protobuf:"varint,3,rep,packed,name=selected,proto3" json:"selected,omitempty"`
+	// cars still to be brought home, in order
+	ReturnQueue []int32 `protobuf:"varint,4,rep,packed,name=return_queue,json=returnQueue,proto3" json:"return_queue,omitempty"`
+}
+
+func (x *EmergencyPowerStatus) Reset() {
+	*x = EmergencyPowerStatus{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_elevatormgr_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EmergencyPowerStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EmergencyPowerStatus) ProtoMessage() {}
+
+func (x *EmergencyPowerStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_elevatormgr_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EmergencyPowerStatus.ProtoReflect.Descriptor instead.
+func (*EmergencyPowerStatus) Descriptor() ([]byte, []int) {
+	return file_elevatormgr_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *EmergencyPowerStatus) GetActive() bool {
+	if x != nil {
+		return x.Active
+	}
+	return false
+}
+
+func (x *EmergencyPowerStatus) GetRunningCars() int32 {
+	if x != nil {
+		return x.RunningCars
+	}
+	return 0
+}
+
+func (x *EmergencyPowerStatus) GetSelected() []int32 {
+	if x != nil {
+		return x.Selected
+	}
+	return nil
+}
+
+func (x *EmergencyPowerStatus) GetReturnQueue() []int32 {
+	if x != nil {
+		return x.ReturnQueue
+	}
+	return nil
+}
+
+type SetFirefighterServiceRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Building int32  `protobuf:"varint,1,opt,name=building,proto3" json:"building,omitempty"`
+	Elevator int32  `protobuf:"varint,2,opt,name=elevator,proto3" json:"elevator,omitempty"`
+	On       bool   `protobuf:"varint,3,opt,name=on,proto3" json:"on,omitempty"`
+	Reason   string `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (x *SetFirefighterServiceRequest) Reset() {
+	*x = SetFirefighterServiceRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_elevatormgr_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetFirefighterServiceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetFirefighterServiceRequest) ProtoMessage() {}
+
+func (x *SetFirefighterServiceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_elevatormgr_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetFirefighterServiceRequest.ProtoReflect.Descriptor instead.
+func (*SetFirefighterServiceRequest) Descriptor() ([]byte, []int) {
+	return file_elevatormgr_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *SetFirefighterServiceRequest) GetBuilding() int32 {
+	if x != nil {
+		return x.Building
+	}
+	return 0
+}
+
+func (x *SetFirefighterServiceRequest) GetElevator() int32 {
+	if x != nil {
+		return x.Elevator
+	}
+	return 0
+}
+
+func (x *SetFirefighterServiceRequest) GetOn() bool {
+	if x != nil {
+		return x.On
+	}
+	return false
+}
+
+func (x *SetFirefighterServiceRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type FirefighterCallRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Building int32 `protobuf:"varint,1,opt,name=building,proto3" json:"building,omitempty"`
+	Elevator int32 `protobuf:"varint,2,opt,name=elevator,proto3" json:"elevator,omitempty"`
+	Floor    int32 `protobuf:"varint,3,opt,name=floor,proto3" json:"floor,omitempty"`
+}
+
+func (x *FirefighterCallRequest) Reset() {
+	*x = FirefighterCallRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_elevatormgr_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FirefighterCallRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FirefighterCallRequest) ProtoMessage() {}
+
+func (x *FirefighterCallRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_elevatormgr_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FirefighterCallRequest.ProtoReflect.Descriptor instead.
+func (*FirefighterCallRequest) Descriptor() ([]byte, []int) {
+	return file_elevatormgr_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *FirefighterCallRequest) GetBuilding() int32 {
+	if x != nil {
+		return x.Building
+	}
+	return 0
+}
+
+func (x *FirefighterCallRequest) GetElevator() int32 {
+	if x != nil {
+		return x.Elevator
+	}
+	return 0
+}
+
+func (x *FirefighterCallRequest) GetFloor() int32 {
+	if x != nil {
+		return x.Floor
+	}
+	return 0
+}
+
+type SetFirefighterDoorRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Building int32 `protobuf:"varint,1,opt,name=building,proto3" json:"building,omitempty"`
+	Elevator int32 `protobuf:"varint,2,opt,name=elevator,proto3" json:"elevator,omitempty"`
+	Pressed  bool  `protobuf:"varint,3,opt,name=pressed,proto3" json:"pressed,omitempty"`
+}
+
+func (x *SetFirefighterDoorRequest) Reset() {
+	*x = SetFirefighterDoorRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_elevatormgr_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetFirefighterDoorRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetFirefighterDoorRequest) ProtoMessage() {}
+
+func (x *SetFirefighterDoorRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_elevatormgr_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetFirefighterDoorRequest.ProtoReflect.Descriptor instead.
+func (*SetFirefighterDoorRequest) Descriptor() ([]byte, []int) {
+	return file_elevatormgr_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *SetFirefighterDoorRequest) GetBuilding() int32 {
+	if x != nil {
+		return x.Building
+	}
+	return 0
+}
+
+func (x *SetFirefighterDoorRequest) GetElevator() int32 {
+	if x != nil {
+		return x.Elevator
+	}
+	return 0
+}
+
+func (x *SetFirefighterDoorRequest) GetPressed() bool {
+	if x != nil {
+		return x.Pressed
+	}
+	return false
+}
+
+type CommissionElevatorRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Building int32 `protobuf:"varint,1,opt,name=building,proto3" json:"building,omitempty"`
+	// the next ID after the highest in the building when unset
+	Elevator *int32 `protobuf:"varint,2,opt,name=elevator,proto3,oneof" json:"elevator,omitempty"`
+	// all of them when empty
+	ServedFloors []int32 `protobuf:"varint,3,rep,packed,name=served_floors,json=servedFloors,proto3" json:"served_floors,omitempty"`
+	Capacity     int32   `protobuf:"varint,4,opt,name=capacity,proto3" json:"capacity,omitempty"`
+	Speed        float64 `protobuf:"fixed64,5,opt,name=speed,proto3" json:"speed,omitempty"`
+	Reason       string  `protobuf:"bytes,6,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (x *CommissionElevatorRequest) Reset() {
+	*x = CommissionElevatorRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_elevatormgr_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CommissionElevatorRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CommissionElevatorRequest) ProtoMessage() {}
+
+func (x *CommissionElevatorRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_elevatormgr_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CommissionElevatorRequest.ProtoReflect.Descriptor instead.
+func (*CommissionElevatorRequest) Descriptor() ([]byte, []int) {
+	return file_elevatormgr_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *CommissionElevatorRequest) GetBuilding() int32 {
+	if x != nil {
+		return x.Building
+	}
+	return 0
+}
+
+func (x *CommissionElevatorRequest) GetElevator() int32 {
+	if x != nil && x.Elevator != nil {
+		return *x.Elevator
+	}
+	return 0
+}
+
+func (x *CommissionElevatorRequest) GetServedFloors() []int32 {
+	if x != nil {
+		return x.ServedFloors
+	}
+	return nil
+}
+
+func (x *CommissionElevatorRequest) GetCapacity() int32 {
+	if x != nil {
+		return x.Capacity
+	}
+	return 0
+}
+
+func (x *CommissionElevatorRequest) GetSpeed() float64 {
+	if x != nil {
+		return x.Speed
+	}
+	return 0
+}
+
+func (x *CommissionElevatorRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type ExtendFloorsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Building int32 `protobuf:"varint,1,opt,name=building,proto3" json:"building,omitempty"`
+	// the new total, floors can only be added
+	Floors int32  `protobuf:"varint,2,opt,name=floors,proto3" json:"floors,omitempty"`
+	Reason string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (x *ExtendFloorsRequest) Reset() {
+	*x = ExtendFloorsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_elevatormgr_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExtendFloorsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExtendFloorsRequest) ProtoMessage() {}
+
+func (x *ExtendFloorsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_elevatormgr_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExtendFloorsRequest.ProtoReflect.Descriptor instead.
+func (*ExtendFloorsRequest) Descriptor() ([]byte, []int) {
+	return file_elevatormgr_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *ExtendFloorsRequest) GetBuilding() int32 {
+	if x != nil {
+		return x.Building
+	}
+	return 0
+}
+
+func (x *ExtendFloorsRequest) GetFloors() int32 {
+	if x != nil {
+		return x.Floors
+	}
+	return 0
+}
+
+func (x *ExtendFloorsRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+var File_elevatormgr_proto protoreflect.FileDescriptor
+
+var file_elevatormgr_proto_rawDesc = []byte{
+	0x0a, 0x11, 0x65, 0x6c, 0x65, 0x76, 0x61, 0x74, 0x6f, 0x72, 0x6d, 0x67, 0x72, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x12, 0x0e, 0x65, 0x6c, 0x65, 0x76, 0x61, 0x74, 0x6f, 0x72, 0x6d, 0x67, 0x72,
+	0x2e, 0x76, 0x31, 0x22, 0x50, 0x0a, 0x08, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x69, 0x6e, 0x67, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12,
+	0x16, 0x0a, 0x06, 0x66, 0x6c, 0x6f, 0x6f, 0x72, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x06, 0x66, 0x6c, 0x6f, 0x6f, 0x72, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x65, 0x6c, 0x65, 0x76, 0x61,
+	0x74, 0x6f, 0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x05, 0x52, 0x09, 0x65, 0x6c, 0x65, 0x76,
+	0x61, 0x74, 0x6f, 0x72, 0x73, 0x22, 0xa7, 0x01, 0x0a, 0x04, 0x43, 0x61, 0x6c, 0x6c, 0x12, 0x14,
+	0x0a, 0x05, 0x66, 0x6c, 0x6f, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x66,
+	0x6c, 0x6f, 0x6f, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x2c, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x18, 0x2e, 0x65, 0x6c, 0x65, 0x76, 0x61, 0x74, 0x6f, 0x72, 0x6d, 0x67, 0x72, 0x2e, 0x76,
+	0x31, 0x2e, 0x43, 0x61, 0x6c, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65,
+	0x12, 0x23, 0x0a, 0x0d, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x65, 0x64, 0x5f, 0x61,
+	0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65,
+	0x72, 0x65, 0x64, 0x41, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x65, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x22,
+	0xbe, 0x03, 0x0a, 0x08, 0x45, 0x6c, 0x65, 0x76, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x1a, 0x0a, 0x08,
+	0x62, 0x75, 0x69, 0x6c, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08,
+	0x62, 0x75, 0x69, 0x6c, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x75, 0x72, 0x72,
+	0x65, 0x6e, 0x74, 0x5f, 0x66, 0x6c, 0x6f, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x0c, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x46, 0x6c, 0x6f, 0x6f, 0x72, 0x12, 0x1c, 0x0a,
+	0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x6d,
+	0x61, 0x78, 0x5f, 0x66, 0x6c, 0x6f, 0x6f, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08,
+	0x6d, 0x61, 0x78, 0x46, 0x6c, 0x6f, 0x6f, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x69, 0x6e, 0x5f, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x69, 0x6e,
+	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x28, 0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x65, 0x6c, 0x65, 0x76, 0x61, 0x74, 0x6f, 0x72,
+	0x6d, 0x67, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x6d, 0x6f, 0x64,
+	0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x64, 0x6f, 0x6f, 0x72, 0x73, 0x5f, 0x6f, 0x70, 0x65, 0x6e, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x64, 0x6f, 0x6f, 0x72, 0x73, 0x4f, 0x70, 0x65, 0x6e,
+	0x12, 0x2a, 0x0a, 0x05, 0x63, 0x61, 0x6c, 0x6c, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x14, 0x2e, 0x65, 0x6c, 0x65, 0x76, 0x61, 0x74, 0x6f, 0x72, 0x6d, 0x67, 0x72, 0x2e, 0x76, 0x31,
+	0x2e, 0x43, 0x61, 0x6c, 0x6c, 0x52, 0x05, 0x63, 0x61, 0x6c, 0x6c, 0x73, 0x12, 0x3b, 0x0a, 0x0e,
+	0x64, 0x65, 0x66, 0x65, 0x72, 0x72, 0x65, 0x64, 0x5f, 0x63, 0x61, 0x6c, 0x6c, 0x73, 0x18, 0x0a,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x65, 0x6c, 0x65, 0x76, 0x61, 0x74, 0x6f, 0x72, 0x6d,
+	0x67, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61, 0x6c, 0x6c, 0x52, 0x0d, 0x64, 0x65, 0x66, 0x65,
+	0x72, 0x72, 0x65, 0x64, 0x43, 0x61, 0x6c, 0x6c, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x64, 0x5f, 0x66, 0x6c, 0x6f, 0x6f, 0x72, 0x73, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x05,
+	0x52, 0x0c, 0x73, 0x65, 0x72, 0x76, 0x65, 0x64, 0x46, 0x6c, 0x6f, 0x6f, 0x72, 0x73, 0x12, 0x1a,
+	0x0a, 0x08, 0x63, 0x61, 0x70, 0x61, 0x63, 0x69, 0x74, 0x79, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x08, 0x63, 0x61, 0x70, 0x61, 0x63, 0x69, 0x74, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x70,
+	0x65, 0x65, 0x64, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x73, 0x70, 0x65, 0x65, 0x64,
+	0x22, 0x78, 0x0a, 0x12, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x32, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x65, 0x6c, 0x65, 0x76, 0x61, 0x74, 0x6f,
+	0x72, 0x6d, 0x67, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61, 0x6c, 0x6c, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x6c,
+	0x65, 0x76, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x65, 0x6c,
+	0x65, 0x76, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x22, 0xa4, 0x02, 0x0a, 0x06, 0x54,
+	0x69, 0x63, 0x6b, 0x65, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x69, 0x6e,
+	0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x69, 0x6e,
+	0x67, 0x12, 0x2c, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x18, 0x2e, 0x65, 0x6c, 0x65, 0x76, 0x61, 0x74, 0x6f, 0x72, 0x6d, 0x67, 0x72, 0x2e, 0x76, 0x31,
+	0x2e, 0x43, 0x61, 0x6c, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12,
+	0x14, 0x0a, 0x05, 0x66, 0x6c, 0x6f, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05,
+	0x66, 0x6c, 0x6f, 0x6f, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x6c, 0x65, 0x76, 0x61, 0x74, 0x6f, 0x72, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x65, 0x6c, 0x65, 0x76, 0x61, 0x74, 0x6f, 0x72, 0x12,
+	0x32, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x1a, 0x2e, 0x65, 0x6c, 0x65, 0x76, 0x61, 0x74, 0x6f, 0x72, 0x6d, 0x67, 0x72, 0x2e, 0x76, 0x31,
+	0x2e, 0x43, 0x61, 0x6c, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x12, 0x3c, 0x0a, 0x07, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x18, 0x08,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x65, 0x6c, 0x65, 0x76, 0x61, 0x74, 0x6f, 0x72, 0x6d,
+	0x67, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x07, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72,
+	0x79, 0x22, 0xdb, 0x01, 0x0a, 0x08, 0x48, 0x61, 0x6c, 0x6c, 0x43, 0x61, 0x6c, 0x6c, 0x12, 0x14,
+	0x0a, 0x05, 0x66, 0x6c, 0x6f, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x66,
+	0x6c, 0x6f, 0x6f, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x6c, 0x65, 0x76, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x65, 0x6c, 0x65, 0x76, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x23,
+	0x0a, 0x0d, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x65,
+	0x64, 0x41, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x5f,
+	0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e,
+	0x65, 0x64, 0x41, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x12, 0x21, 0x0a, 0x0c,
+	0x65, 0x73, 0x63, 0x61, 0x6c, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0b, 0x65, 0x73, 0x63, 0x61, 0x6c, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22,
+	0x54, 0x0a, 0x0c, 0x52, 0x65, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x12,
+	0x28, 0x0a, 0x04, 0x63, 0x61, 0x6c, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e,
+	0x65, 0x6c, 0x65, 0x76, 0x61, 0x74, 0x6f, 0x72, 0x6d, 0x67, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43,
+	0x61, 0x6c, 0x6c, 0x52, 0x04, 0x63, 0x61, 0x6c, 0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x6c, 0x65,
+	0x76, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x65, 0x6c, 0x65,
+	0x76, 0x61, 0x74, 0x6f, 0x72, 0x22, 0x16, 0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x75, 0x69,
+	0x6c, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x4f, 0x0a,
+	0x15, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x36, 0x0a, 0x09, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x69,
+	0x6e, 0x67, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x65, 0x6c, 0x65, 0x76,
+	0x61, 0x74, 0x6f, 0x72, 0x6d, 0x67, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x75, 0x69, 0x6c, 0x64,
+	0x69, 0x6e, 0x67, 0x52, 0x09, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x22, 0x31,
+	0x0a, 0x13, 0x47, 0x65, 0x74, 0x45, 0x6c, 0x65, 0x76, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x69, 0x6e,
+	0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x69, 0x6e,
+	0x67, 0x22, 0x4e, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x45, 0x6c, 0x65, 0x76, 0x61, 0x74, 0x6f, 0x72,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x36, 0x0a, 0x09, 0x65, 0x6c, 0x65,
+	0x76, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x65,
+	0x6c, 0x65, 0x76, 0x61, 0x74, 0x6f, 0x72, 0x6d, 0x67, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6c,
+	0x65, 0x76, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x09, 0x65, 0x6c, 0x65, 0x76, 0x61, 0x74, 0x6f, 0x72,
+	0x73, 0x22, 0x4c, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x45, 0x6c, 0x65, 0x76, 0x61, 0x74, 0x6f, 0x72,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x62, 0x75, 0x69, 0x6c, 0x64,
+	0x69, 0x6e, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x62, 0x75, 0x69, 0x6c, 0x64,
+	0x69, 0x6e, 0x67, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x6c, 0x65, 0x76, 0x61, 0x74, 0x6f, 0x72, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x65, 0x6c, 0x65, 0x76, 0x61, 0x74, 0x6f, 0x72, 0x22,
+	0x33, 0x0a, 0x15, 0x57, 0x61, 0x74, 0x63, 0x68, 0x45, 0x6c, 0x65, 0x76, 0x61, 0x74, 0x6f, 0x72,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x62, 0x75, 0x69, 0x6c,
+	0x64, 0x69, 0x6e, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x62, 0x75, 0x69, 0x6c,
+	0x64, 0x69, 0x6e, 0x67, 0x22, 0x65, 0x0a, 0x13, 0x43, 0x61, 0x6c, 0x6c, 0x45, 0x6c, 0x65, 0x76,
+	0x61, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x62,
+	0x75, 0x69, 0x6c, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x62,
+	0x75, 0x69, 0x6c, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x6c, 0x6f, 0x6f, 0x72,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x66, 0x6c, 0x6f, 0x6f, 0x72, 0x12, 0x1c, 0x0a,
+	0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x66, 0x0a, 0x16, 0x50,
+	0x75, 0x73, 0x68, 0x44, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x69, 0x6e,
+	0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x69, 0x6e,
+	0x67, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x6c, 0x65, 0x76, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x08, 0x65, 0x6c, 0x65, 0x76, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x14, 0x0a,
+	0x05, 0x66, 0x6c, 0x6f, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x66, 0x6c,
+	0x6f, 0x6f, 0x72, 0x22, 0x7f, 0x0a, 0x15, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x48, 0x61, 0x6c,
+	0x6c, 0x43, 0x61, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08,
+	0x62, 0x75, 0x69, 0x6c, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08,
+	0x62, 0x75, 0x69, 0x6c, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x6c, 0x6f, 0x6f,
+	0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x66, 0x6c, 0x6f, 0x6f, 0x72, 0x12, 0x1c,
+	0x0a, 0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06,
+	0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65,
+	0x61, 0x73, 0x6f, 0x6e, 0x22, 0x7c, 0x0a, 0x14, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x43, 0x61,
+	0x72, 0x43, 0x61, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08,
+	0x62, 0x75, 0x69, 0x6c, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08,
+	0x62, 0x75, 0x69, 0x6c, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x6c, 0x65, 0x76,
+	0x61, 0x74, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x65, 0x6c, 0x65, 0x76,
+	0x61, 0x74, 0x6f, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x6c, 0x6f, 0x6f, 0x72, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x05, 0x66, 0x6c, 0x6f, 0x6f, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65,
+	0x61, 0x73, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73,
+	0x6f, 0x6e, 0x22, 0x10, 0x0a, 0x0e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3c, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x43, 0x61, 0x6c, 0x6c, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x69,
+	0x6e, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x69,
+	0x6e, 0x67, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
+	0x69, 0x64, 0x22, 0x4b, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x48, 0x61, 0x6c, 0x6c, 0x43, 0x61, 0x6c,
+	0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x62, 0x75, 0x69,
+	0x6c, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x62, 0x75, 0x69,
+	0x6c, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x18, 0x0a, 0x07, 0x6f, 0x76, 0x65, 0x72, 0x64, 0x75, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x6f, 0x76, 0x65, 0x72, 0x64, 0x75, 0x65, 0x22,
+	0x4f, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x48, 0x61, 0x6c, 0x6c, 0x43, 0x61, 0x6c, 0x6c, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x37, 0x0a, 0x0a, 0x68, 0x61, 0x6c, 0x6c, 0x5f,
+	0x63, 0x61, 0x6c, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x65, 0x6c,
+	0x65, 0x76, 0x61, 0x74, 0x6f, 0x72, 0x6d, 0x67, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x61, 0x6c,
+	0x6c, 0x43, 0x61, 0x6c, 0x6c, 0x52, 0x09, 0x68, 0x61, 0x6c, 0x6c, 0x43, 0x61, 0x6c, 0x6c, 0x73,
+	0x22, 0x61, 0x0a, 0x0f, 0x45, 0x6c, 0x65, 0x76, 0x61, 0x74, 0x6f, 0x72, 0x43, 0x6f, 0x6d, 0x6d,
+	0x61, 0x6e, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x69, 0x6e, 0x67, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x69, 0x6e, 0x67, 0x12,
+	0x1a, 0x0a, 0x08, 0x65, 0x6c, 0x65, 0x76, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x08, 0x65, 0x6c, 0x65, 0x76, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x72,
+	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61,
+	0x73, 0x6f, 0x6e, 0x22, 0x84, 0x01, 0x0a, 0x13, 0x53, 0x65, 0x74, 0x49, 0x6e, 0x53, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x62,
+	0x75, 0x69, 0x6c, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x62,
+	0x75, 0x69, 0x6c, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x6c, 0x65, 0x76, 0x61,
+	0x74, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x65, 0x6c, 0x65, 0x76, 0x61,
+	0x74, 0x6f, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x69, 0x6e, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x69, 0x6e, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x7e, 0x0a, 0x1c, 0x53, 0x65,
+	0x74, 0x49, 0x6e, 0x64, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x62, 0x75,
+	0x69, 0x6c, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x62, 0x75,
+	0x69, 0x6c, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x6c, 0x65, 0x76, 0x61, 0x74,
+	0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x65, 0x6c, 0x65, 0x76, 0x61, 0x74,
+	0x6f, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x02,
+	0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x99, 0x01, 0x0a, 0x13, 0x4f,
+	0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x43, 0x61, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x1a,
+	0x0a, 0x08, 0x65, 0x6c, 0x65, 0x76, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x08, 0x65, 0x6c, 0x65, 0x76, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x6c,
+	0x6f, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x66, 0x6c, 0x6f, 0x6f, 0x72,
+	0x12, 0x1c, 0x0a, 0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16,
+	0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x9d, 0x01, 0x0a, 0x17, 0x52, 0x65, 0x61, 0x73, 0x73,
+	0x69, 0x67, 0x6e, 0x48, 0x61, 0x6c, 0x6c, 0x43, 0x61, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x14,
+	0x0a, 0x05, 0x66, 0x6c, 0x6f, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x66,
+	0x6c, 0x6f, 0x6f, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x6c, 0x65, 0x76, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x65, 0x6c, 0x65, 0x76, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x16,
+	0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0xa0, 0x01, 0x0a, 0x09, 0x43, 0x61, 0x72, 0x43, 0x68,
+	0x61, 0x6e, 0x67, 0x65, 0x12, 0x34, 0x0a, 0x08, 0x65, 0x6c, 0x65, 0x76, 0x61, 0x74, 0x6f, 0x72,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x65, 0x6c, 0x65, 0x76, 0x61, 0x74, 0x6f,
+	0x72, 0x6d, 0x67, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6c, 0x65, 0x76, 0x61, 0x74, 0x6f, 0x72,
+	0x52, 0x08, 0x65, 0x6c, 0x65, 0x76, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x3c, 0x0a, 0x0a, 0x72, 0x65,
+	0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c,
+	0x2e, 0x65, 0x6c, 0x65, 0x76, 0x61, 0x74, 0x6f, 0x72, 0x6d, 0x67, 0x72, 0x2e, 0x76, 0x31, 0x2e,
+	0x52, 0x65, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0a, 0x72, 0x65,
+	0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x70, 0x70, 0x72,
+	0x6f, 0x76, 0x61, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x61,
+	0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x49, 0x64, 0x22, 0x45, 0x0a, 0x0f, 0x42, 0x75, 0x69,
+	0x6c, 0x64, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x12, 0x1a, 0x0a, 0x08,
+	0x62, 0x75, 0x69, 0x6c, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08,
+	0x62, 0x75, 0x69, 0x6c, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73,
+	0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e,
+	0x22, 0x67, 0x0a, 0x0d, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x43, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c,
+	0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x78,
+	0x70, 0x69, 0x72, 0x65, 0x73, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09,
+	0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x22, 0xed, 0x01, 0x0a, 0x12, 0x50, 0x72,
+	0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x43, 0x61, 0x6c, 0x6c, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64,
+	0x12, 0x12, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04,
+	0x74, 0x69, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x6c, 0x6f, 0x6f, 0x72, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x05, 0x66, 0x6c, 0x6f, 0x6f, 0x72, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65,
+	0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x0b, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08,
+	0x65, 0x6c, 0x65, 0x76, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08,
+	0x65, 0x6c, 0x65, 0x76, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
+	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x64, 0x42, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x72,
+	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61,
+	0x73, 0x6f, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65,
+	0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69,
+	0x7a, 0x65, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x37, 0x0a, 0x19, 0x47, 0x65, 0x74,
+	0x50, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x43, 0x61, 0x6c, 0x6c, 0x4c, 0x6f, 0x67, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x69,
+	0x6e, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x69,
+	0x6e, 0x67, 0x22, 0x5a, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x50, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74,
+	0x79, 0x43, 0x61, 0x6c, 0x6c, 0x4c, 0x6f, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x3c, 0x0a, 0x07, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x22, 0x2e, 0x65, 0x6c, 0x65, 0x76, 0x61, 0x74, 0x6f, 0x72, 0x6d, 0x67, 0x72, 0x2e,
+	0x76, 0x31, 0x2e, 0x50, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x43, 0x61, 0x6c, 0x6c, 0x52,
+	0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x07, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x22, 0xa4,
+	0x01, 0x0a, 0x13, 0x50, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x43, 0x61, 0x6c, 0x6c, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x69,
+	0x6e, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x69,
+	0x6e, 0x67, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x6c, 0x6f, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x05, 0x66, 0x6c, 0x6f, 0x6f, 0x72, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x74,
+	0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x64,
+	0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x64, 0x42, 0x79, 0x12, 0x16, 0x0a,
+	0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72,
+	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0xb7, 0x01, 0x0a, 0x11, 0x4d, 0x61, 0x69, 0x6e, 0x74, 0x65,
+	0x6e, 0x61, 0x6e, 0x63, 0x65, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x65,
+	0x6c, 0x65, 0x76, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x65,
+	0x6c, 0x65, 0x76, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x12, 0x10, 0x0a,
+	0x03, 0x65, 0x6e, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x65, 0x6e, 0x64, 0x12,
+	0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x74, 0x65, 0x63, 0x68, 0x6e,
+	0x69, 0x63, 0x69, 0x61, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x74, 0x65, 0x63,
+	0x68, 0x6e, 0x69, 0x63, 0x69, 0x61, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x76,
+	0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x22,
+	0x3a, 0x0a, 0x1c, 0x47, 0x65, 0x74, 0x4d, 0x61, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63,
+	0x65, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x1a, 0x0a, 0x08, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x08, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x69, 0x6e, 0x67, 0x22, 0x5c, 0x0a, 0x1d, 0x47,
+	0x65, 0x74, 0x4d, 0x61, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x57, 0x69, 0x6e,
+	0x64, 0x6f, 0x77, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3b, 0x0a, 0x07,
+	0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e,
+	0x65, 0x6c, 0x65, 0x76, 0x61, 0x74, 0x6f, 0x72, 0x6d, 0x67, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4d,
+	0x61, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77,
+	0x52, 0x07, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x73, 0x22, 0xb4, 0x01, 0x0a, 0x1a, 0x53, 0x63,
+	0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x4d, 0x61, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x62, 0x75, 0x69, 0x6c,
+	0x64, 0x69, 0x6e, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x62, 0x75, 0x69, 0x6c,
+	0x64, 0x69, 0x6e, 0x67, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x6c, 0x65, 0x76, 0x61, 0x74, 0x6f, 0x72,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x65, 0x6c, 0x65, 0x76, 0x61, 0x74, 0x6f, 0x72,
+	0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x65, 0x6e, 0x64, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x03, 0x65, 0x6e, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73,
+	0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e,
+	0x12, 0x1e, 0x0a, 0x0a, 0x74, 0x65, 0x63, 0x68, 0x6e, 0x69, 0x63, 0x69, 0x61, 0x6e, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x74, 0x65, 0x63, 0x68, 0x6e, 0x69, 0x63, 0x69, 0x61, 0x6e,
+	0x22, 0x75, 0x0a, 0x17, 0x4d, 0x61, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x57,
+	0x69, 0x6e, 0x64, 0x6f, 0x77, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x39, 0x0a, 0x06, 0x77,
+	0x69, 0x6e, 0x64, 0x6f, 0x77, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x65, 0x6c,
+	0x65, 0x76, 0x61, 0x74, 0x6f, 0x72, 0x6d, 0x67, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61, 0x69,
+	0x6e, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x52, 0x06,
+	0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76,
+	0x61, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x70, 0x70,
+	0x72, 0x6f, 0x76, 0x61, 0x6c, 0x49, 0x64, 0x22, 0x40, 0x0a, 0x0b, 0x41, 0x67, 0x69, 0x6e, 0x67,
+	0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x19, 0x0a, 0x08, 0x6d, 0x61, 0x78, 0x5f, 0x77, 0x61,
+	0x69, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x6d, 0x61, 0x78, 0x57, 0x61, 0x69,
+	0x74, 0x12, 0x16, 0x0a, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0x33, 0x0a, 0x15, 0x47, 0x65, 0x74,
+	0x41, 0x67, 0x69, 0x6e, 0x67, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x69, 0x6e, 0x67, 0x22, 0x80,
+	0x01, 0x0a, 0x15, 0x53, 0x65, 0x74, 0x41, 0x67, 0x69, 0x6e, 0x67, 0x50, 0x6f, 0x6c, 0x69, 0x63,
+	0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x62, 0x75, 0x69, 0x6c,
+	0x64, 0x69, 0x6e, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x62, 0x75, 0x69, 0x6c,
+	0x64, 0x69, 0x6e, 0x67, 0x12, 0x33, 0x0a, 0x06, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x65, 0x6c, 0x65, 0x76, 0x61, 0x74, 0x6f, 0x72, 0x6d,
+	0x67, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x67, 0x69, 0x6e, 0x67, 0x50, 0x6f, 0x6c, 0x69, 0x63,
+	0x79, 0x52, 0x06, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61,
+	0x73, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f,
+	0x6e, 0x22, 0x63, 0x0a, 0x17, 0x53, 0x65, 0x74, 0x53, 0x6d, 0x6f, 0x6b, 0x65, 0x44, 0x65, 0x74,
+	0x65, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08,
+	0x62, 0x75, 0x69, 0x6c, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08,
+	0x62, 0x75, 0x69, 0x6c, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x6c, 0x6f, 0x6f,
+	0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x66, 0x6c, 0x6f, 0x6f, 0x72, 0x12, 0x16,
+	0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06,
+	0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x22, 0x3d, 0x0a, 0x0d, 0x53, 0x6d, 0x6f, 0x6b, 0x65, 0x44,
+	0x65, 0x74, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x6c, 0x6f, 0x6f, 0x72,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x66, 0x6c, 0x6f, 0x6f, 0x72, 0x12, 0x16, 0x0a,
+	0x06, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x61,
+	0x63, 0x74, 0x69, 0x76, 0x65, 0x22, 0x47, 0x0a, 0x0a, 0x46, 0x69, 0x72, 0x65, 0x52, 0x65, 0x63,
+	0x61, 0x6c, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x72,
+	0x65, 0x63, 0x61, 0x6c, 0x6c, 0x5f, 0x66, 0x6c, 0x6f, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x0b, 0x72, 0x65, 0x63, 0x61, 0x6c, 0x6c, 0x46, 0x6c, 0x6f, 0x6f, 0x72, 0x22, 0x76,
+	0x0a, 0x1d, 0x41, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x45, 0x6d, 0x65, 0x72, 0x67, 0x65,
+	0x6e, 0x63, 0x79, 0x50, 0x6f, 0x77, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x1a, 0x0a, 0x08, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x08, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x21, 0x0a, 0x0c, 0x72,
+	0x75, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x63, 0x61, 0x72, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x0b, 0x72, 0x75, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x43, 0x61, 0x72, 0x73, 0x12, 0x16,
+	0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x70, 0x0a, 0x1c, 0x53, 0x65, 0x74, 0x45, 0x6d, 0x65,
+	0x72, 0x67, 0x65, 0x6e, 0x63, 0x79, 0x50, 0x6f, 0x77, 0x65, 0x72, 0x43, 0x61, 0x72, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x69,
+	0x6e, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x69,
+	0x6e, 0x67, 0x12, 0x1c, 0x0a, 0x09, 0x65, 0x6c, 0x65, 0x76, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x05, 0x52, 0x09, 0x65, 0x6c, 0x65, 0x76, 0x61, 0x74, 0x6f, 0x72, 0x73,
+	0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x36, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x45,
+	0x6d, 0x65, 0x72, 0x67, 0x65, 0x6e, 0x63, 0x79, 0x50, 0x6f, 0x77, 0x65, 0x72, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x69, 0x6e, 0x67,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x69, 0x6e, 0x67,
+	0x22, 0x90, 0x01, 0x0a, 0x14, 0x45, 0x6d, 0x65, 0x72, 0x67, 0x65, 0x6e, 0x63, 0x79, 0x50, 0x6f,
+	0x77, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x63, 0x74,
+	0x69, 0x76, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x76,
+	0x65, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x75, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x63, 0x61, 0x72,
+	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x72, 0x75, 0x6e, 0x6e, 0x69, 0x6e, 0x67,
+	0x43, 0x61, 0x72, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x65, 0x64,
+	0x18, 0x03, 0x20, 0x03, 0x28, 0x05, 0x52, 0x08, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x65, 0x64,
+	0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x5f, 0x71, 0x75, 0x65, 0x75, 0x65,
+	0x18, 0x04, 0x20, 0x03, 0x28, 0x05, 0x52, 0x0b, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x51, 0x75,
+	0x65, 0x75, 0x65, 0x22, 0x7e, 0x0a, 0x1c, 0x53, 0x65, 0x74, 0x46, 0x69, 0x72, 0x65, 0x66, 0x69,
+	0x67, 0x68, 0x74, 0x65, 0x72, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x69, 0x6e, 0x67, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x69, 0x6e, 0x67, 0x12,
+	0x1a, 0x0a, 0x08, 0x65, 0x6c, 0x65, 0x76, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x08, 0x65, 0x6c, 0x65, 0x76, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x6f,
+	0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x02, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x72,
+	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61,
+	0x73, 0x6f, 0x6e, 0x22, 0x66, 0x0a, 0x16, 0x46, 0x69, 0x72, 0x65, 0x66, 0x69, 0x67, 0x68, 0x74,
+	0x65, 0x72, 0x43, 0x61, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a,
+	0x08, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x08, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x6c, 0x65,
+	0x76, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x65, 0x6c, 0x65,
+	0x76, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x6c, 0x6f, 0x6f, 0x72, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x66, 0x6c, 0x6f, 0x6f, 0x72, 0x22, 0x6d, 0x0a, 0x19, 0x53,
+	0x65, 0x74, 0x46, 0x69, 0x72, 0x65, 0x66, 0x69, 0x67, 0x68, 0x74, 0x65, 0x72, 0x44, 0x6f, 0x6f,
+	0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x62, 0x75, 0x69, 0x6c,
+	0x64, 0x69, 0x6e, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x62, 0x75, 0x69, 0x6c,
+	0x64, 0x69, 0x6e, 0x67, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x6c, 0x65, 0x76, 0x61, 0x74, 0x6f, 0x72,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x65, 0x6c, 0x65, 0x76, 0x61, 0x74, 0x6f, 0x72,
+	0x12, 0x18, 0x0a, 0x07, 0x70, 0x72, 0x65, 0x73, 0x73, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x07, 0x70, 0x72, 0x65, 0x73, 0x73, 0x65, 0x64, 0x22, 0xd4, 0x01, 0x0a, 0x19, 0x43,
+	0x6f, 0x6d, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x45, 0x6c, 0x65, 0x76, 0x61, 0x74, 0x6f,
+	0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x62, 0x75, 0x69, 0x6c,
+	0x64, 0x69, 0x6e, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x62, 0x75, 0x69, 0x6c,
+	0x64, 0x69, 0x6e, 0x67, 0x12, 0x1f, 0x0a, 0x08, 0x65, 0x6c, 0x65, 0x76, 0x61, 0x74, 0x6f, 0x72,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x48, 0x00, 0x52, 0x08, 0x65, 0x6c, 0x65, 0x76, 0x61, 0x74,
+	0x6f, 0x72, 0x88, 0x01, 0x01, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x65, 0x72, 0x76, 0x65, 0x64, 0x5f,
+	0x66, 0x6c, 0x6f, 0x6f, 0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x05, 0x52, 0x0c, 0x73, 0x65,
+	0x72, 0x76, 0x65, 0x64, 0x46, 0x6c, 0x6f, 0x6f, 0x72, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x61,
+	0x70, 0x61, 0x63, 0x69, 0x74, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x63, 0x61,
+	0x70, 0x61, 0x63, 0x69, 0x74, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x70, 0x65, 0x65, 0x64, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x73, 0x70, 0x65, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06,
+	0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65,
+	0x61, 0x73, 0x6f, 0x6e, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x65, 0x6c, 0x65, 0x76, 0x61, 0x74, 0x6f,
+	0x72, 0x22, 0x61, 0x0a, 0x13, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x46, 0x6c, 0x6f, 0x6f, 0x72,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x62, 0x75, 0x69, 0x6c,
+	0x64, 0x69, 0x6e, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x62, 0x75, 0x69, 0x6c,
+	0x64, 0x69, 0x6e, 0x67, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x6c, 0x6f, 0x6f, 0x72, 0x73, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x66, 0x6c, 0x6f, 0x6f, 0x72, 0x73, 0x12, 0x16, 0x0a, 0x06,
+	0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65,
+	0x61, 0x73, 0x6f, 0x6e, 0x2a, 0xcb, 0x01, 0x0a, 0x04, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x14, 0x0a,
+	0x10, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45,
+	0x44, 0x10, 0x00, 0x12, 0x0f, 0x0a, 0x0b, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x4e, 0x4f, 0x52, 0x4d,
+	0x41, 0x4c, 0x10, 0x01, 0x12, 0x14, 0x0a, 0x10, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x46, 0x49, 0x52,
+	0x45, 0x5f, 0x52, 0x45, 0x43, 0x41, 0x4c, 0x4c, 0x10, 0x02, 0x12, 0x14, 0x0a, 0x10, 0x4d, 0x4f,
+	0x44, 0x45, 0x5f, 0x46, 0x49, 0x52, 0x45, 0x46, 0x49, 0x47, 0x48, 0x54, 0x45, 0x52, 0x10, 0x03,
+	0x12, 0x19, 0x0a, 0x15, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x45, 0x4d, 0x45, 0x52, 0x47, 0x45, 0x4e,
+	0x43, 0x59, 0x5f, 0x52, 0x45, 0x54, 0x55, 0x52, 0x4e, 0x10, 0x04, 0x12, 0x19, 0x0a, 0x15, 0x4d,
+	0x4f, 0x44, 0x45, 0x5f, 0x45, 0x4d, 0x45, 0x52, 0x47, 0x45, 0x4e, 0x43, 0x59, 0x5f, 0x50, 0x41,
+	0x52, 0x4b, 0x45, 0x44, 0x10, 0x05, 0x12, 0x14, 0x0a, 0x10, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x49,
+	0x4e, 0x44, 0x45, 0x50, 0x45, 0x4e, 0x44, 0x45, 0x4e, 0x54, 0x10, 0x06, 0x12, 0x11, 0x0a, 0x0d,
+	0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x50, 0x52, 0x49, 0x4f, 0x52, 0x49, 0x54, 0x59, 0x10, 0x07, 0x12,
+	0x11, 0x0a, 0x0d, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x44, 0x52, 0x41, 0x49, 0x4e, 0x49, 0x4e, 0x47,
+	0x10, 0x08, 0x2a, 0x4c, 0x0a, 0x08, 0x43, 0x61, 0x6c, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x12, 0x19,
+	0x0a, 0x15, 0x43, 0x41, 0x4c, 0x4c, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50,
+	0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x43, 0x41, 0x4c,
+	0x4c, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x48, 0x41, 0x4c, 0x4c, 0x10, 0x01, 0x12, 0x11, 0x0a,
+	0x0d, 0x43, 0x41, 0x4c, 0x4c, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x43, 0x41, 0x52, 0x10, 0x02,
+	0x2a, 0xe4, 0x01, 0x0a, 0x0a, 0x43, 0x61, 0x6c, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
+	0x1b, 0x0a, 0x17, 0x43, 0x41, 0x4c, 0x4c, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x55,
+	0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1a, 0x0a, 0x16,
+	0x43, 0x41, 0x4c, 0x4c, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x52, 0x45, 0x47, 0x49,
+	0x53, 0x54, 0x45, 0x52, 0x45, 0x44, 0x10, 0x01, 0x12, 0x18, 0x0a, 0x14, 0x43, 0x41, 0x4c, 0x4c,
+	0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x41, 0x53, 0x53, 0x49, 0x47, 0x4e, 0x45, 0x44,
+	0x10, 0x02, 0x12, 0x1a, 0x0a, 0x16, 0x43, 0x41, 0x4c, 0x4c, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55,
+	0x53, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x53, 0x49, 0x47, 0x4e, 0x45, 0x44, 0x10, 0x03, 0x12, 0x18,
+	0x0a, 0x14, 0x43, 0x41, 0x4c, 0x4c, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x41, 0x4e,
+	0x53, 0x57, 0x45, 0x52, 0x45, 0x44, 0x10, 0x04, 0x12, 0x19, 0x0a, 0x15, 0x43, 0x41, 0x4c, 0x4c,
+	0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x43, 0x4f, 0x4d, 0x50, 0x4c, 0x45, 0x54, 0x45,
+	0x44, 0x10, 0x05, 0x12, 0x19, 0x0a, 0x15, 0x43, 0x41, 0x4c, 0x4c, 0x5f, 0x53, 0x54, 0x41, 0x54,
+	0x55, 0x53, 0x5f, 0x43, 0x41, 0x4e, 0x43, 0x45, 0x4c, 0x4c, 0x45, 0x44, 0x10, 0x06, 0x12, 0x17,
+	0x0a, 0x13, 0x43, 0x41, 0x4c, 0x4c, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x45, 0x58,
+	0x50, 0x49, 0x52, 0x45, 0x44, 0x10, 0x07, 0x32, 0xa0, 0x19, 0x0a, 0x0f, 0x45, 0x6c, 0x65, 0x76,
+	0x61, 0x74, 0x6f, 0x72, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x12, 0x5c, 0x0a, 0x0d, 0x4c,
+	0x69, 0x73, 0x74, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x24, 0x2e, 0x65,
+	0x6c, 0x65, 0x76, 0x61, 0x74, 0x6f, 0x72, 0x6d, 0x67, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x25, 0x2e, 0x65, 0x6c, 0x65, 0x76, 0x61, 0x74, 0x6f, 0x72, 0x6d, 0x67, 0x72,
+	0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x69, 0x6e, 0x67,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x59, 0x0a, 0x0c, 0x47, 0x65, 0x74,
+	0x45, 0x6c, 0x65, 0x76, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x12, 0x23, 0x2e, 0x65, 0x6c, 0x65, 0x76,
+	0x61, 0x74, 0x6f, 0x72, 0x6d, 0x67, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x45, 0x6c,
+	0x65, 0x76, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24,
+	0x2e, 0x65, 0x6c, 0x65, 0x76, 0x61, 0x74, 0x6f, 0x72, 0x6d, 0x67, 0x72, 0x2e, 0x76, 0x31, 0x2e,
+	0x47, 0x65, 0x74, 0x45, 0x6c, 0x65, 0x76, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4b, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x45, 0x6c, 0x65, 0x76, 0x61,
+	0x74, 0x6f, 0x72, 0x12, 0x22, 0x2e, 0x65, 0x6c, 0x65, 0x76, 0x61, 0x74, 0x6f, 0x72, 0x6d, 0x67,
+	0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x45, 0x6c, 0x65, 0x76, 0x61, 0x74, 0x6f, 0x72,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x65, 0x6c, 0x65, 0x76, 0x61, 0x74,
+	0x6f, 0x72, 0x6d, 0x67, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6c, 0x65, 0x76, 0x61, 0x74, 0x6f,
+	0x72, 0x12, 0x5f, 0x0a, 0x0e, 0x57, 0x61, 0x74, 0x63, 0x68, 0x45, 0x6c, 0x65, 0x76, 0x61, 0x74,
+	0x6f, 0x72, 0x73, 0x12, 0x25, 0x2e, 0x65, 0x6c, 0x65, 0x76, 0x61, 0x74, 0x6f, 0x72, 0x6d, 0x67,
+	0x72, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x61, 0x74, 0x63, 0x68, 0x45, 0x6c, 0x65, 0x76, 0x61, 0x74,
+	0x6f, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x65, 0x6c, 0x65,
+	0x76, 0x61, 0x74, 0x6f, 0x72, 0x6d, 0x67, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x45,
+	0x6c, 0x65, 0x76, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x30, 0x01, 0x12, 0x4b, 0x0a, 0x0c, 0x43, 0x61, 0x6c, 0x6c, 0x45, 0x6c, 0x65, 0x76, 0x61, 0x74,
+	0x6f, 0x72, 0x12, 0x23, 0x2e, 0x65, 0x6c, 0x65, 0x76, 0x61, 0x74, 0x6f, 0x72, 0x6d, 0x67, 0x72,
+	0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61, 0x6c, 0x6c, 0x45, 0x6c, 0x65, 0x76, 0x61, 0x74, 0x6f, 0x72,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x65, 0x6c, 0x65, 0x76, 0x61, 0x74,
+	0x6f, 0x72, 0x6d, 0x67, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x12,
+	0x51, 0x0a, 0x0f, 0x50, 0x75, 0x73, 0x68, 0x44, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x26, 0x2e, 0x65, 0x6c, 0x65, 0x76, 0x61, 0x74, 0x6f, 0x72, 0x6d, 0x67, 0x72,
+	0x2e, 0x76, 0x31, 0x2e, 0x50, 0x75, 0x73, 0x68, 0x44, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x65, 0x6c, 0x65,
+	0x76, 0x61, 0x74, 0x6f, 0x72, 0x6d, 0x67, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x69, 0x63, 0x6b,
+	0x65, 0x74, 0x12, 0x57, 0x0a, 0x0e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x48, 0x61, 0x6c, 0x6c,
+	0x43, 0x61, 0x6c, 0x6c, 0x12, 0x25, 0x2e, 0x65, 0x6c, 0x65, 0x76, 0x61, 0x74, 0x6f, 0x72, 0x6d,
+	0x67, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x48, 0x61, 0x6c, 0x6c,
+	0x43, 0x61, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x65, 0x6c,
+	0x65, 0x76, 0x61, 0x74, 0x6f, 0x72, 0x6d, 0x67, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61, 0x6e,
+	0x63, 0x65, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x55, 0x0a, 0x0d, 0x43,
+	0x61, 0x6e, 0x63, 0x65, 0x6c, 0x43, 0x61, 0x72, 0x43, 0x61, 0x6c, 0x6c, 0x12, 0x24, 0x2e, 0x65,
+	0x6c, 0x65, 0x76, 0x61, 0x74, 0x6f, 0x72, 0x6d, 0x67, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61,
+	0x6e, 0x63, 0x65, 0x6c, 0x43, 0x61, 0x72, 0x43, 0x61, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x65, 0x6c, 0x65, 0x76, 0x61, 0x74, 0x6f, 0x72, 0x6d, 0x67, 0x72,
+	0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x41, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x43, 0x61, 0x6c, 0x6c, 0x12, 0x1e, 0x2e,
+	0x65, 0x6c, 0x65, 0x76, 0x61, 0x74, 0x6f, 0x72, 0x6d, 0x67, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47,
+	0x65, 0x74, 0x43, 0x61, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e,
+	0x65, 0x6c, 0x65, 0x76, 0x61, 0x74, 0x6f, 0x72, 0x6d, 0x67, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x54,
+	0x69, 0x63, 0x6b, 0x65, 0x74, 0x12, 0x45, 0x0a, 0x09, 0x57, 0x61, 0x74, 0x63, 0x68, 0x43, 0x61,
+	0x6c, 0x6c, 0x12, 0x1e, 0x2e, 0x65, 0x6c, 0x65, 0x76, 0x61, 0x74, 0x6f, 0x72, 0x6d, 0x67, 0x72,
+	0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x61, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x16, 0x2e, 0x65, 0x6c, 0x65, 0x76, 0x61, 0x74, 0x6f, 0x72, 0x6d, 0x67, 0x72,
+	0x2e, 0x76, 0x31, 0x2e, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x30, 0x01, 0x12, 0x59, 0x0a, 0x0c,
+	0x47, 0x65, 0x74, 0x48, 0x61, 0x6c, 0x6c, 0x43, 0x61, 0x6c, 0x6c, 0x73, 0x12, 0x23, 0x2e, 0x65,
+	0x6c, 0x65, 0x76, 0x61, 0x74, 0x6f, 0x72, 0x6d, 0x67, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65,
+	0x74, 0x48, 0x61, 0x6c, 0x6c, 0x43, 0x61, 0x6c, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x24, 0x2e, 0x65, 0x6c, 0x65, 0x76, 0x61, 0x74, 0x6f, 0x72, 0x6d, 0x67, 0x72, 0x2e,
+	0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x48, 0x61, 0x6c, 0x6c, 0x43, 0x61, 0x6c, 0x6c, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4e, 0x0a, 0x0c, 0x53, 0x65, 0x74, 0x49, 0x6e,
+	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x23, 0x2e, 0x65, 0x6c, 0x65, 0x76, 0x61, 0x74,
+	0x6f, 0x72, 0x6d, 0x67, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x74, 0x49, 0x6e, 0x53, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x65,
+	0x6c, 0x65, 0x76, 0x61, 0x74, 0x6f, 0x72, 0x6d, 0x67, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61,
+	0x72, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x60, 0x0a, 0x15, 0x53, 0x65, 0x74, 0x49, 0x6e,
+	0x64, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x12, 0x2c, 0x2e, 0x65, 0x6c, 0x65, 0x76, 0x61, 0x74, 0x6f, 0x72, 0x6d, 0x67, 0x72, 0x2e, 0x76,
+	0x31, 0x2e, 0x53, 0x65, 0x74, 0x49, 0x6e, 0x64, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x74,
+	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19,
+	0x2e, 0x65, 0x6c, 0x65, 0x76, 0x61, 0x74, 0x6f, 0x72, 0x6d, 0x67, 0x72, 0x2e, 0x76, 0x31, 0x2e,
+	0x43, 0x61, 0x72, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x4b, 0x0a, 0x0d, 0x44, 0x72, 0x61,
+	0x69, 0x6e, 0x45, 0x6c, 0x65, 0x76, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x1f, 0x2e, 0x65, 0x6c, 0x65,
+	0x76, 0x61, 0x74, 0x6f, 0x72, 0x6d, 0x67, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6c, 0x65, 0x76,
+	0x61, 0x74, 0x6f, 0x72, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x1a, 0x19, 0x2e, 0x65, 0x6c,
+	0x65, 0x76, 0x61, 0x74, 0x6f, 0x72, 0x6d, 0x67, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61, 0x72,
+	0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x4e, 0x0a, 0x0c, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69,
+	0x64, 0x65, 0x43, 0x61, 0x6c, 0x6c, 0x12, 0x23, 0x2e, 0x65, 0x6c, 0x65, 0x76, 0x61, 0x74, 0x6f,
+	0x72, 0x6d, 0x67, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65,
+	0x43, 0x61, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x65, 0x6c,
+	0x65, 0x76, 0x61, 0x74, 0x6f, 0x72, 0x6d, 0x67, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61, 0x72,
+	0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x55, 0x0a, 0x10, 0x52, 0x65, 0x61, 0x73, 0x73, 0x69,
+	0x67, 0x6e, 0x48, 0x61, 0x6c, 0x6c, 0x43, 0x61, 0x6c, 0x6c, 0x12, 0x27, 0x2e, 0x65, 0x6c, 0x65,
+	0x76, 0x61, 0x74, 0x6f, 0x72, 0x6d, 0x67, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x61, 0x73,
+	0x73, 0x69, 0x67, 0x6e, 0x48, 0x61, 0x6c, 0x6c, 0x43, 0x61, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x65, 0x6c, 0x65, 0x76, 0x61, 0x74, 0x6f, 0x72, 0x6d, 0x67,
+	0x72, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x61, 0x6c, 0x6c, 0x43, 0x61, 0x6c, 0x6c, 0x12, 0x4b, 0x0a,
+	0x0d, 0x52, 0x65, 0x73, 0x65, 0x74, 0x45, 0x6c, 0x65, 0x76, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x1f,
+	0x2e, 0x65, 0x6c, 0x65, 0x76, 0x61, 0x74, 0x6f, 0x72, 0x6d, 0x67, 0x72, 0x2e, 0x76, 0x31, 0x2e,
+	0x45, 0x6c, 0x65, 0x76, 0x61, 0x74, 0x6f, 0x72, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x1a,
+	0x19, 0x2e, 0x65, 0x6c, 0x65, 0x76, 0x61, 0x74, 0x6f, 0x72, 0x6d, 0x67, 0x72, 0x2e, 0x76, 0x31,
+	0x2e, 0x43, 0x61, 0x72, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x6b, 0x0a, 0x12, 0x47, 0x65,
+	0x74, 0x50, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x43, 0x61, 0x6c, 0x6c, 0x4c, 0x6f, 0x67,
+	0x12, 0x29, 0x2e, 0x65, 0x6c, 0x65, 0x76, 0x61, 0x74, 0x6f, 0x72, 0x6d, 0x67, 0x72, 0x2e, 0x76,
+	0x31, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x43, 0x61, 0x6c,
+	0x6c, 0x4c, 0x6f, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x65, 0x6c,
+	0x65, 0x76, 0x61, 0x74, 0x6f, 0x72, 0x6d, 0x67, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74,
+	0x50, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x43, 0x61, 0x6c, 0x6c, 0x4c, 0x6f, 0x67, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x74, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x4d, 0x61,
+	0x69, 0x6e, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x73,
+	0x12, 0x2c, 0x2e, 0x65, 0x6c, 0x65, 0x76, 0x61, 0x74, 0x6f, 0x72, 0x6d, 0x67, 0x72, 0x2e, 0x76,
+	0x31, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x61, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65,
+	0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d,
+	0x2e, 0x65, 0x6c, 0x65, 0x76, 0x61, 0x74, 0x6f, 0x72, 0x6d, 0x67, 0x72, 0x2e, 0x76, 0x31, 0x2e,
+	0x47, 0x65, 0x74, 0x4d, 0x61, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x57, 0x69,
+	0x6e, 0x64, 0x6f, 0x77, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6a, 0x0a,
+	0x13, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x4d, 0x61, 0x69, 0x6e, 0x74, 0x65, 0x6e,
+	0x61, 0x6e, 0x63, 0x65, 0x12, 0x2a, 0x2e, 0x65, 0x6c, 0x65, 0x76, 0x61, 0x74, 0x6f, 0x72, 0x6d,
+	0x67, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x4d, 0x61,
+	0x69, 0x6e, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x27, 0x2e, 0x65, 0x6c, 0x65, 0x76, 0x61, 0x74, 0x6f, 0x72, 0x6d, 0x67, 0x72, 0x2e, 0x76,
+	0x31, 0x2e, 0x4d, 0x61, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x57, 0x69, 0x6e,
+	0x64, 0x6f, 0x77, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x54, 0x0a, 0x0e, 0x47, 0x65, 0x74,
+	0x41, 0x67, 0x69, 0x6e, 0x67, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x25, 0x2e, 0x65, 0x6c,
+	0x65, 0x76, 0x61, 0x74, 0x6f, 0x72, 0x6d, 0x67, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74,
+	0x41, 0x67, 0x69, 0x6e, 0x67, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x65, 0x6c, 0x65, 0x76, 0x61, 0x74, 0x6f, 0x72, 0x6d, 0x67, 0x72,
+	0x2e, 0x76, 0x31, 0x2e, 0x41, 0x67, 0x69, 0x6e, 0x67, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12,
+	0x56, 0x0a, 0x0e, 0x53, 0x65, 0x74, 0x41, 0x67, 0x69, 0x6e, 0x67, 0x50, 0x6f, 0x6c, 0x69, 0x63,
+	0x79, 0x12, 0x25, 0x2e, 0x65, 0x6c, 0x65, 0x76, 0x61, 0x74, 0x6f, 0x72, 0x6d, 0x67, 0x72, 0x2e,
+	0x76, 0x31, 0x2e, 0x53, 0x65, 0x74, 0x41, 0x67, 0x69, 0x6e, 0x67, 0x50, 0x6f, 0x6c, 0x69, 0x63,
+	0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x65, 0x6c, 0x65, 0x76, 0x61,
+	0x74, 0x6f, 0x72, 0x6d, 0x67, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e,
+	0x67, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x57, 0x0a, 0x0c, 0x50, 0x72, 0x69, 0x6f, 0x72,
+	0x69, 0x74, 0x79, 0x43, 0x61, 0x6c, 0x6c, 0x12, 0x23, 0x2e, 0x65, 0x6c, 0x65, 0x76, 0x61, 0x74,
+	0x6f, 0x72, 0x6d, 0x67, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74,
+	0x79, 0x43, 0x61, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x65,
+	0x6c, 0x65, 0x76, 0x61, 0x74, 0x6f, 0x72, 0x6d, 0x67, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72,
+	0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x43, 0x61, 0x6c, 0x6c, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64,
+	0x12, 0x5a, 0x0a, 0x10, 0x53, 0x65, 0x74, 0x53, 0x6d, 0x6f, 0x6b, 0x65, 0x44, 0x65, 0x74, 0x65,
+	0x63, 0x74, 0x6f, 0x72, 0x12, 0x27, 0x2e, 0x65, 0x6c, 0x65, 0x76, 0x61, 0x74, 0x6f, 0x72, 0x6d,
+	0x67, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x74, 0x53, 0x6d, 0x6f, 0x6b, 0x65, 0x44, 0x65,
+	0x74, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e,
+	0x65, 0x6c, 0x65, 0x76, 0x61, 0x74, 0x6f, 0x72, 0x6d, 0x67, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53,
+	0x6d, 0x6f, 0x6b, 0x65, 0x44, 0x65, 0x74, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x51, 0x0a, 0x12,
+	0x41, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x46, 0x69, 0x72, 0x65, 0x52, 0x65, 0x63, 0x61,
+	0x6c, 0x6c, 0x12, 0x1f, 0x2e, 0x65, 0x6c, 0x65, 0x76, 0x61, 0x74, 0x6f, 0x72, 0x6d, 0x67, 0x72,
+	0x2e, 0x76, 0x31, 0x2e, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x6d, 0x6d,
+	0x61, 0x6e, 0x64, 0x1a, 0x1a, 0x2e, 0x65, 0x6c, 0x65, 0x76, 0x61, 0x74, 0x6f, 0x72, 0x6d, 0x67,
+	0x72, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x69, 0x72, 0x65, 0x52, 0x65, 0x63, 0x61, 0x6c, 0x6c, 0x12,
+	0x4e, 0x0a, 0x0f, 0x52, 0x65, 0x73, 0x65, 0x74, 0x46, 0x69, 0x72, 0x65, 0x52, 0x65, 0x63, 0x61,
+	0x6c, 0x6c, 0x12, 0x1f, 0x2e, 0x65, 0x6c, 0x65, 0x76, 0x61, 0x74, 0x6f, 0x72, 0x6d, 0x67, 0x72,
+	0x2e, 0x76, 0x31, 0x2e, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x6d, 0x6d,
+	0x61, 0x6e, 0x64, 0x1a, 0x1a, 0x2e, 0x65, 0x6c, 0x65, 0x76, 0x61, 0x74, 0x6f, 0x72, 0x6d, 0x67,
+	0x72, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x69, 0x72, 0x65, 0x52, 0x65, 0x63, 0x61, 0x6c, 0x6c, 0x12,
+	0x6d, 0x0a, 0x16, 0x41, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x45, 0x6d, 0x65, 0x72, 0x67,
+	0x65, 0x6e, 0x63, 0x79, 0x50, 0x6f, 0x77, 0x65, 0x72, 0x12, 0x2d, 0x2e, 0x65, 0x6c, 0x65, 0x76,
+	0x61, 0x74, 0x6f, 0x72, 0x6d, 0x67, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x76,
+	0x61, 0x74, 0x65, 0x45, 0x6d, 0x65, 0x72, 0x67, 0x65, 0x6e, 0x63, 0x79, 0x50, 0x6f, 0x77, 0x65,
+	0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x65, 0x6c, 0x65, 0x76, 0x61,
+	0x74, 0x6f, 0x72, 0x6d, 0x67, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6d, 0x65, 0x72, 0x67, 0x65,
+	0x6e, 0x63, 0x79, 0x50, 0x6f, 0x77, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x6b,
+	0x0a, 0x15, 0x53, 0x65, 0x74, 0x45, 0x6d, 0x65, 0x72, 0x67, 0x65, 0x6e, 0x63, 0x79, 0x50, 0x6f,
+	0x77, 0x65, 0x72, 0x43, 0x61, 0x72, 0x73, 0x12, 0x2c, 0x2e, 0x65, 0x6c, 0x65, 0x76, 0x61, 0x74,
+	0x6f, 0x72, 0x6d, 0x67, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x74, 0x45, 0x6d, 0x65, 0x72,
+	0x67, 0x65, 0x6e, 0x63, 0x79, 0x50, 0x6f, 0x77, 0x65, 0x72, 0x43, 0x61, 0x72, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x65, 0x6c, 0x65, 0x76, 0x61, 0x74, 0x6f, 0x72,
+	0x6d, 0x67, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6d, 0x65, 0x72, 0x67, 0x65, 0x6e, 0x63, 0x79,
+	0x50, 0x6f, 0x77, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x5a, 0x0a, 0x11, 0x45,
+	0x6e, 0x64, 0x45, 0x6d, 0x65, 0x72, 0x67, 0x65, 0x6e, 0x63, 0x79, 0x50, 0x6f, 0x77, 0x65, 0x72,
+	0x12, 0x1f, 0x2e, 0x65, 0x6c, 0x65, 0x76, 0x61, 0x74, 0x6f, 0x72, 0x6d, 0x67, 0x72, 0x2e, 0x76,
+	0x31, 0x2e, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e,
+	0x64, 0x1a, 0x24, 0x2e, 0x65, 0x6c, 0x65, 0x76, 0x61, 0x74, 0x6f, 0x72, 0x6d, 0x67, 0x72, 0x2e,
+	0x76, 0x31, 0x2e, 0x45, 0x6d, 0x65, 0x72, 0x67, 0x65, 0x6e, 0x63, 0x79, 0x50, 0x6f, 0x77, 0x65,
+	0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x63, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x45, 0x6d,
+	0x65, 0x72, 0x67, 0x65, 0x6e, 0x63, 0x79, 0x50, 0x6f, 0x77, 0x65, 0x72, 0x12, 0x28, 0x2e, 0x65,
+	0x6c, 0x65, 0x76, 0x61, 0x74, 0x6f, 0x72, 0x6d, 0x67, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65,
+	0x74, 0x45, 0x6d, 0x65, 0x72, 0x67, 0x65, 0x6e, 0x63, 0x79, 0x50, 0x6f, 0x77, 0x65, 0x72, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x65, 0x6c, 0x65, 0x76, 0x61, 0x74, 0x6f,
+	0x72, 0x6d, 0x67, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6d, 0x65, 0x72, 0x67, 0x65, 0x6e, 0x63,
+	0x79, 0x50, 0x6f, 0x77, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x5f, 0x0a, 0x15,
+	0x53, 0x65, 0x74, 0x46, 0x69, 0x72, 0x65, 0x66, 0x69, 0x67, 0x68, 0x74, 0x65, 0x72, 0x53, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x2c, 0x2e, 0x65, 0x6c, 0x65, 0x76, 0x61, 0x74, 0x6f, 0x72,
+	0x6d, 0x67, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x74, 0x46, 0x69, 0x72, 0x65, 0x66, 0x69,
+	0x67, 0x68, 0x74, 0x65, 0x72, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x65, 0x6c, 0x65, 0x76, 0x61, 0x74, 0x6f, 0x72, 0x6d, 0x67,
+	0x72, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6c, 0x65, 0x76, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x53, 0x0a,
+	0x0f, 0x46, 0x69, 0x72, 0x65, 0x66, 0x69, 0x67, 0x68, 0x74, 0x65, 0x72, 0x43, 0x61, 0x6c, 0x6c,
+	0x12, 0x26, 0x2e, 0x65, 0x6c, 0x65, 0x76, 0x61, 0x74, 0x6f, 0x72, 0x6d, 0x67, 0x72, 0x2e, 0x76,
+	0x31, 0x2e, 0x46, 0x69, 0x72, 0x65, 0x66, 0x69, 0x67, 0x68, 0x74, 0x65, 0x72, 0x43, 0x61, 0x6c,
+	0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x65, 0x6c, 0x65, 0x76, 0x61,
+	0x74, 0x6f, 0x72, 0x6d, 0x67, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6c, 0x65, 0x76, 0x61, 0x74,
+	0x6f, 0x72, 0x12, 0x59, 0x0a, 0x12, 0x53, 0x65, 0x74, 0x46, 0x69, 0x72, 0x65, 0x66, 0x69, 0x67,
+	0x68, 0x74, 0x65, 0x72, 0x44, 0x6f, 0x6f, 0x72, 0x12, 0x29, 0x2e, 0x65, 0x6c, 0x65, 0x76, 0x61,
+	0x74, 0x6f, 0x72, 0x6d, 0x67, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x74, 0x46, 0x69, 0x72,
+	0x65, 0x66, 0x69, 0x67, 0x68, 0x74, 0x65, 0x72, 0x44, 0x6f, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x65, 0x6c, 0x65, 0x76, 0x61, 0x74, 0x6f, 0x72, 0x6d, 0x67,
+	0x72, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6c, 0x65, 0x76, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x59, 0x0a,
+	0x12, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x45, 0x6c, 0x65, 0x76, 0x61,
+	0x74, 0x6f, 0x72, 0x12, 0x29, 0x2e, 0x65, 0x6c, 0x65, 0x76, 0x61, 0x74, 0x6f, 0x72, 0x6d, 0x67,
+	0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x45,
+	0x6c, 0x65, 0x76, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18,
+	0x2e, 0x65, 0x6c, 0x65, 0x76, 0x61, 0x74, 0x6f, 0x72, 0x6d, 0x67, 0x72, 0x2e, 0x76, 0x31, 0x2e,
+	0x45, 0x6c, 0x65, 0x76, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x51, 0x0a, 0x14, 0x44, 0x65, 0x63, 0x6f,
+	0x6d, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x45, 0x6c, 0x65, 0x76, 0x61, 0x74, 0x6f, 0x72,
+	0x12, 0x1f, 0x2e, 0x65, 0x6c, 0x65, 0x76, 0x61, 0x74, 0x6f, 0x72, 0x6d, 0x67, 0x72, 0x2e, 0x76,
+	0x31, 0x2e, 0x45, 0x6c, 0x65, 0x76, 0x61, 0x74, 0x6f, 0x72, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e,
+	0x64, 0x1a, 0x18, 0x2e, 0x65, 0x6c, 0x65, 0x76, 0x61, 0x74, 0x6f, 0x72, 0x6d, 0x67, 0x72, 0x2e,
+	0x76, 0x31, 0x2e, 0x45, 0x6c, 0x65, 0x76, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x4d, 0x0a, 0x0c, 0x45,
+	0x78, 0x74, 0x65, 0x6e, 0x64, 0x46, 0x6c, 0x6f, 0x6f, 0x72, 0x73, 0x12, 0x23, 0x2e, 0x65, 0x6c,
+	0x65, 0x76, 0x61, 0x74, 0x6f, 0x72, 0x6d, 0x67, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x74,
+	0x65, 0x6e, 0x64, 0x46, 0x6c, 0x6f, 0x6f, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x18, 0x2e, 0x65, 0x6c, 0x65, 0x76, 0x61, 0x74, 0x6f, 0x72, 0x6d, 0x67, 0x72, 0x2e, 0x76,
+	0x31, 0x2e, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x69, 0x6e, 0x67, 0x42, 0x23, 0x5a, 0x21, 0x67, 0x69,
+	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x74, 0x63, 0x6f, 0x74, 0x61, 0x76, 0x2f,
+	0x65, 0x6c, 0x65, 0x76, 0x61, 0x74, 0x6f, 0x72, 0x6d, 0x67, 0x72, 0x2f, 0x72, 0x70, 0x63, 0x62,
+	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_elevatormgr_proto_rawDescOnce sync.Once
+	file_elevatormgr_proto_rawDescData = file_elevatormgr_proto_rawDesc
+)
+
+func file_elevatormgr_proto_rawDescGZIP() []byte {
+	file_elevatormgr_proto_rawDescOnce.Do(func() {
+		file_elevatormgr_proto_rawDescData = protoimpl.X.CompressGZIP(file_elevatormgr_proto_rawDescData)
+	})
+	return file_elevatormgr_proto_rawDescData
+}
+
+var file_elevatormgr_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_elevatormgr_proto_msgTypes = make([]protoimpl.MessageInfo, 53)
+var file_elevatormgr_proto_goTypes = []interface{}{
+	(Mode)(0),                             // 0: elevatormgr.v1.Mode
+	(CallType)(0),                         // 1: elevatormgr.v1.CallType
+	(CallStatus)(0),                       // 2: elevatormgr.v1.CallStatus
+	(*Building)(nil),                      // 3: elevatormgr.v1.Building
+	(*Call)(nil),                          // 4: elevatormgr.v1.Call
+	(*Elevator)(nil),                      // 5: elevatormgr.v1.Elevator
+	(*TicketStatusChange)(nil),            // 6: elevatormgr.v1.TicketStatusChange
+	(*Ticket)(nil),                        // 7: elevatormgr.v1.Ticket
+	(*HallCall)(nil),                      // 8: elevatormgr.v1.HallCall
+	(*Reassignment)(nil),                  // 9: elevatormgr.v1.Reassignment
+	(*ListBuildingsRequest)(nil),          // 10: elevatormgr.v1.ListBuildingsRequest
+	(*ListBuildingsResponse)(nil),         // 11: elevatormgr.v1.ListBuildingsResponse
+	(*GetElevatorsRequest)(nil),           // 12: elevatormgr.v1.GetElevatorsRequest
+	(*GetElevatorsResponse)(nil),          // 13: elevatormgr.v1.GetElevatorsResponse
+	(*GetElevatorRequest)(nil),            // 14: elevatormgr.v1.GetElevatorRequest
+	(*WatchElevatorsRequest)(nil),         // 15: elevatormgr.v1.WatchElevatorsRequest
+	(*CallElevatorRequest)(nil),           // 16: elevatormgr.v1.CallElevatorRequest
+	(*PushDestinationRequest)(nil),        // 17: elevatormgr.v1.PushDestinationRequest
+	(*CancelHallCallRequest)(nil),         // 18: elevatormgr.v1.CancelHallCallRequest
+	(*CancelCarCallRequest)(nil),          // 19: elevatormgr.v1.CancelCarCallRequest
+	(*CancelResponse)(nil),                // 20: elevatormgr.v1.CancelResponse
+	(*GetCallRequest)(nil),                // 21: elevatormgr.v1.GetCallRequest
+	(*GetHallCallsRequest)(nil),           // 22: elevatormgr.v1.GetHallCallsRequest
+	(*GetHallCallsResponse)(nil),          // 23: elevatormgr.v1.GetHallCallsResponse
+	(*ElevatorCommand)(nil),               // 24: elevatormgr.v1.ElevatorCommand
+	(*SetInServiceRequest)(nil),           // 25: elevatormgr.v1.SetInServiceRequest
+	(*SetIndependentServiceRequest)(nil),  // 26: elevatormgr.v1.SetIndependentServiceRequest
+	(*OverrideCallRequest)(nil),           // 27: elevatormgr.v1.OverrideCallRequest
+	(*ReassignHallCallRequest)(nil),       // 28: elevatormgr.v1.ReassignHallCallRequest
+	(*CarChange)(nil),                     // 29: elevatormgr.v1.CarChange
+	(*BuildingCommand)(nil),               // 30: elevatormgr.v1.BuildingCommand
+	(*PendingChange)(nil),                 // 31: elevatormgr.v1.PendingChange
+	(*PriorityCallRecord)(nil),            // 32: elevatormgr.v1.PriorityCallRecord
+	(*GetPriorityCallLogRequest)(nil),     // 33: elevatormgr.v1.GetPriorityCallLogRequest
+	(*GetPriorityCallLogResponse)(nil),    // 34: elevatormgr.v1.GetPriorityCallLogResponse
+	(*PriorityCallRequest)(nil),           // 35: elevatormgr.v1.PriorityCallRequest
+	(*MaintenanceWindow)(nil),             // 36: elevatormgr.v1.MaintenanceWindow
+	(*GetMaintenanceWindowsRequest)(nil),  // 37: elevatormgr.v1.GetMaintenanceWindowsRequest
+	(*GetMaintenanceWindowsResponse)(nil), // 38: elevatormgr.v1.GetMaintenanceWindowsResponse
+	(*ScheduleMaintenanceRequest)(nil),    // 39: elevatormgr.v1.ScheduleMaintenanceRequest
+	(*MaintenanceWindowChange)(nil),       // 40: elevatormgr.v1.MaintenanceWindowChange
+	(*AgingPolicy)(nil),                   // 41: elevatormgr.v1.AgingPolicy
+	(*GetAgingPolicyRequest)(nil),         // 42: elevatormgr.v1.GetAgingPolicyRequest
+	(*SetAgingPolicyRequest)(nil),         // 43: elevatormgr.v1.SetAgingPolicyRequest
+	(*SetSmokeDetectorRequest)(nil),       // 44: elevatormgr.v1.SetSmokeDetectorRequest
+	(*SmokeDetector)(nil),                 // 45: elevatormgr.v1.SmokeDetector
+	(*FireRecall)(nil),                    // 46: elevatormgr.v1.FireRecall
+	(*ActivateEmergencyPowerRequest)(nil), // 47: elevatormgr.v1.ActivateEmergencyPowerRequest
+	(*SetEmergencyPowerCarsRequest)(nil),  // 48: elevatormgr.v1.SetEmergencyPowerCarsRequest
+	(*GetEmergencyPowerRequest)(nil),      // 49: elevatormgr.v1.GetEmergencyPowerRequest
+	(*EmergencyPowerStatus)(nil),          // 50: elevatormgr.v1.EmergencyPowerStatus
+	(*SetFirefighterServiceRequest)(nil),  // 51: elevatormgr.v1.SetFirefighterServiceRequest
+	(*FirefighterCallRequest)(nil),        // 52: elevatormgr.v1.FirefighterCallRequest
+	(*SetFirefighterDoorRequest)(nil),     // 53: elevatormgr.v1.SetFirefighterDoorRequest
+	(*CommissionElevatorRequest)(nil),     // 54: elevatormgr.v1.CommissionElevatorRequest
+	(*ExtendFloorsRequest)(nil),           // 55: elevatormgr.v1.ExtendFloorsRequest
+}
+var file_elevatormgr_proto_depIdxs = []int32{
+	1,  // 0: elevatormgr.v1.Call.type:type_name -> elevatormgr.v1.CallType
+	0,  // 1: elevatormgr.v1.Elevator.mode:type_name -> elevatormgr.v1.Mode
+	4,  // 2: elevatormgr.v1.Elevator.calls:type_name -> elevatormgr.v1.Call
+	4,  // 3: elevatormgr.v1.Elevator.deferred_calls:type_name -> elevatormgr.v1.Call
+	2,  // 4: elevatormgr.v1.TicketStatusChange.status:type_name -> elevatormgr.v1.CallStatus
+	1,  // 5: elevatormgr.v1.Ticket.type:type_name -> elevatormgr.v1.CallType
+	2,  // 6: elevatormgr.v1.Ticket.status:type_name -> elevatormgr.v1.CallStatus
+	6,  // 7: elevatormgr.v1.Ticket.history:type_name -> elevatormgr.v1.TicketStatusChange
+	4,  // 8: elevatormgr.v1.Reassignment.call:type_name -> elevatormgr.v1.Call
+	3,  // 9: elevatormgr.v1.ListBuildingsResponse.buildings:type_name -> elevatormgr.v1.Building
+	5,  // 10: elevatormgr.v1.GetElevatorsResponse.elevators:type_name -> elevatormgr.v1.Elevator
+	8,  // 11: elevatormgr.v1.GetHallCallsResponse.hall_calls:type_name -> elevatormgr.v1.HallCall
+	5,  // 12: elevatormgr.v1.CarChange.elevator:type_name -> elevatormgr.v1.Elevator
+	9,  // 13: elevatormgr.v1.CarChange.reassigned:type_name -> elevatormgr.v1.Reassignment
+	32, // 14: elevatormgr.v1.GetPriorityCallLogResponse.records:type_name -> elevatormgr.v1.PriorityCallRecord
+	36, // 15: elevatormgr.v1.GetMaintenanceWindowsResponse.windows:type_name -> elevatormgr.v1.MaintenanceWindow
+	36, // 16: elevatormgr.v1.MaintenanceWindowChange.window:type_name -> elevatormgr.v1.MaintenanceWindow
+	41, // 17: elevatormgr.v1.SetAgingPolicyRequest.policy:type_name -> elevatormgr.v1.AgingPolicy
+	10, // 18: elevatormgr.v1.ElevatorControl.ListBuildings:input_type -> elevatormgr.v1.ListBuildingsRequest
+	12, // 19: elevatormgr.v1.ElevatorControl.GetElevators:input_type -> elevatormgr.v1.GetElevatorsRequest
+	14, // 20: elevatormgr.v1.ElevatorControl.GetElevator:input_type -> elevatormgr.v1.GetElevatorRequest
+	15, // 21: elevatormgr.v1.ElevatorControl.WatchElevators:input_type -> elevatormgr.v1.WatchElevatorsRequest
+	16, // 22: elevatormgr.v1.ElevatorControl.CallElevator:input_type -> elevatormgr.v1.CallElevatorRequest
+	17, // 23: elevatormgr.v1.ElevatorControl.PushDestination:input_type -> elevatormgr.v1.PushDestinationRequest
+	18, // 24: elevatormgr.v1.ElevatorControl.CancelHallCall:input_type -> elevatormgr.v1.CancelHallCallRequest
+	19, // 25: elevatormgr.v1.ElevatorControl.CancelCarCall:input_type -> elevatormgr.v1.CancelCarCallRequest
+	21, // 26: elevatormgr.v1.ElevatorControl.GetCall:input_type -> elevatormgr.v1.GetCallRequest
+	21, // 27: elevatormgr.v1.ElevatorControl.WatchCall:input_type -> elevatormgr.v1.GetCallRequest
+	22, // 28: elevatormgr.v1.ElevatorControl.GetHallCalls:input_type -> elevatormgr.v1.GetHallCallsRequest
+	25, // 29: elevatormgr.v1.ElevatorControl.SetInService:input_type -> elevatormgr.v1.SetInServiceRequest
+	26, // 30: elevatormgr.v1.ElevatorControl.SetIndependentService:input_type -> elevatormgr.v1.SetIndependentServiceRequest
+	24, // 31: elevatormgr.v1.ElevatorControl.DrainElevator:input_type -> elevatormgr.v1.ElevatorCommand
+	27, // 32: elevatormgr.v1.ElevatorControl.OverrideCall:input_type -> elevatormgr.v1.OverrideCallRequest
+	28, // 33: elevatormgr.v1.ElevatorControl.ReassignHallCall:input_type -> elevatormgr.v1.ReassignHallCallRequest
+	24, // 34: elevatormgr.v1.ElevatorControl.ResetElevator:input_type -> elevatormgr.v1.ElevatorCommand
+	33, // 35: elevatormgr.v1.ElevatorControl.GetPriorityCallLog:input_type -> elevatormgr.v1.GetPriorityCallLogRequest
+	37, // 36: elevatormgr.v1.ElevatorControl.GetMaintenanceWindows:input_type -> elevatormgr.v1.GetMaintenanceWindowsRequest
+	39, // 37: elevatormgr.v1.ElevatorControl.ScheduleMaintenance:input_type -> elevatormgr.v1.ScheduleMaintenanceRequest
+	42, // 38: elevatormgr.v1.ElevatorControl.GetAgingPolicy:input_type -> elevatormgr.v1.GetAgingPolicyRequest
+	43, // 39: elevatormgr.v1.ElevatorControl.SetAgingPolicy:input_type -> elevatormgr.v1.SetAgingPolicyRequest
+	35, // 40: elevatormgr.v1.ElevatorControl.PriorityCall:input_type -> elevatormgr.v1.PriorityCallRequest
+	44, // 41: elevatormgr.v1.ElevatorControl.SetSmokeDetector:input_type -> elevatormgr.v1.SetSmokeDetectorRequest
+	30, // 42: elevatormgr.v1.ElevatorControl.ActivateFireRecall:input_type -> elevatormgr.v1.BuildingCommand
+	30, // 43: elevatormgr.v1.ElevatorControl.ResetFireRecall:input_type -> elevatormgr.v1.BuildingCommand
+	47, // 44: elevatormgr.v1.ElevatorControl.ActivateEmergencyPower:input_type -> elevatormgr.v1.ActivateEmergencyPowerRequest
+	48, // 45: elevatormgr.v1.ElevatorControl.SetEmergencyPowerCars:input_type -> elevatormgr.v1.SetEmergencyPowerCarsRequest
+	30, // 46: elevatormgr.v1.ElevatorControl.EndEmergencyPower:input_type -> elevatormgr.v1.BuildingCommand
+	49, // 47: elevatormgr.v1.ElevatorControl.GetEmergencyPower:input_type -> elevatormgr.v1.GetEmergencyPowerRequest
+	51, // 48: elevatormgr.v1.ElevatorControl.SetFirefighterService:input_type -> elevatormgr.v1.SetFirefighterServiceRequest
+	52, // 49: elevatormgr.v1.ElevatorControl.FirefighterCall:input_type -> elevatormgr.v1.FirefighterCallRequest
+	53, // 50: elevatormgr.v1.ElevatorControl.SetFirefighterDoor:input_type -> elevatormgr.v1.SetFirefighterDoorRequest
+	54, // 51: elevatormgr.v1.ElevatorControl.CommissionElevator:input_type -> elevatormgr.v1.CommissionElevatorRequest
+	24, // 52: elevatormgr.v1.ElevatorControl.DecommissionElevator:input_type -> elevatormgr.v1.ElevatorCommand
+	55, // 53: elevatormgr.v1.ElevatorControl.ExtendFloors:input_type -> elevatormgr.v1.ExtendFloorsRequest
+	11, // 54: elevatormgr.v1.ElevatorControl.ListBuildings:output_type -> elevatormgr.v1.ListBuildingsResponse
+	13, // 55: elevatormgr.v1.ElevatorControl.GetElevators:output_type -> elevatormgr.v1.GetElevatorsResponse
+	5,  // 56: elevatormgr.v1.ElevatorControl.GetElevator:output_type -> elevatormgr.v1.Elevator
+	13, // 57: elevatormgr.v1.ElevatorControl.WatchElevators:output_type -> elevatormgr.v1.GetElevatorsResponse
+	7,  // 58: elevatormgr.v1.ElevatorControl.CallElevator:output_type -> elevatormgr.v1.Ticket
+	7,  // 59: elevatormgr.v1.ElevatorControl.PushDestination:output_type -> elevatormgr.v1.Ticket
+	20, // 60: elevatormgr.v1.ElevatorControl.CancelHallCall:output_type -> elevatormgr.v1.CancelResponse
+	20, // 61: elevatormgr.v1.ElevatorControl.CancelCarCall:output_type -> elevatormgr.v1.CancelResponse
+	7,  // 62: elevatormgr.v1.ElevatorControl.GetCall:output_type -> elevatormgr.v1.Ticket
+	7,  // 63: elevatormgr.v1.ElevatorControl.WatchCall:output_type -> elevatormgr.v1.Ticket
+	23, // 64: elevatormgr.v1.ElevatorControl.GetHallCalls:output_type -> elevatormgr.v1.GetHallCallsResponse
+	29, // 65: elevatormgr.v1.ElevatorControl.SetInService:output_type -> elevatormgr.v1.CarChange
+	29, // 66: elevatormgr.v1.ElevatorControl.SetIndependentService:output_type -> elevatormgr.v1.CarChange
+	29, // 67: elevatormgr.v1.ElevatorControl.DrainElevator:output_type -> elevatormgr.v1.CarChange
+	29, // 68: elevatormgr.v1.ElevatorControl.OverrideCall:output_type -> elevatormgr.v1.CarChange
+	8,  // 69: elevatormgr.v1.ElevatorControl.ReassignHallCall:output_type -> elevatormgr.v1.HallCall
+	29, // 70: elevatormgr.v1.ElevatorControl.ResetElevator:output_type -> elevatormgr.v1.CarChange
+	34, // 71: elevatormgr.v1.ElevatorControl.GetPriorityCallLog:output_type -> elevatormgr.v1.GetPriorityCallLogResponse
+	38, // 72: elevatormgr.v1.ElevatorControl.GetMaintenanceWindows:output_type -> elevatormgr.v1.GetMaintenanceWindowsResponse
+	40, // 73: elevatormgr.v1.ElevatorControl.ScheduleMaintenance:output_type -> elevatormgr.v1.MaintenanceWindowChange
+	41, // 74: elevatormgr.v1.ElevatorControl.GetAgingPolicy:output_type -> elevatormgr.v1.AgingPolicy
+	31, // 75: elevatormgr.v1.ElevatorControl.SetAgingPolicy:output_type -> elevatormgr.v1.PendingChange
+	32, // 76: elevatormgr.v1.ElevatorControl.PriorityCall:output_type -> elevatormgr.v1.PriorityCallRecord
+	45, // 77: elevatormgr.v1.ElevatorControl.SetSmokeDetector:output_type -> elevatormgr.v1.SmokeDetector
+	46, // 78: elevatormgr.v1.ElevatorControl.ActivateFireRecall:output_type -> elevatormgr.v1.FireRecall
+	46, // 79: elevatormgr.v1.ElevatorControl.ResetFireRecall:output_type -> elevatormgr.v1.FireRecall
+	50, // 80: elevatormgr.v1.ElevatorControl.ActivateEmergencyPower:output_type -> elevatormgr.v1.EmergencyPowerStatus
+	50, // 81: elevatormgr.v1.ElevatorControl.SetEmergencyPowerCars:output_type -> elevatormgr.v1.EmergencyPowerStatus
+	50, // 82: elevatormgr.v1.ElevatorControl.EndEmergencyPower:output_type -> elevatormgr.v1.EmergencyPowerStatus
+	50, // 83: elevatormgr.v1.ElevatorControl.GetEmergencyPower:output_type -> elevatormgr.v1.EmergencyPowerStatus
+	5,  // 84: elevatormgr.v1.ElevatorControl.SetFirefighterService:output_type -> elevatormgr.v1.Elevator
+	5,  // 85: elevatormgr.v1.ElevatorControl.FirefighterCall:output_type -> elevatormgr.v1.Elevator
+	5,  // 86: elevatormgr.v1.ElevatorControl.SetFirefighterDoor:output_type -> elevatormgr.v1.Elevator
+	5,  // 87: elevatormgr.v1.ElevatorControl.CommissionElevator:output_type -> elevatormgr.v1.Elevator
+	5,  // 88: elevatormgr.v1.ElevatorControl.DecommissionElevator:output_type -> elevatormgr.v1.Elevator
+	3,  // 89: elevatormgr.v1.ElevatorControl.ExtendFloors:output_type -> elevatormgr.v1.Building
+	54, // [54:90] is the sub-list for method output_type
+	18, // [18:54] is the sub-list for method input_type
+	18, // [18:18] is the sub-list for extension type_name
+	18, // [18:18] is the sub-list for extension extendee
+	0,  // [0:18] is the sub-list for field type_name
+}
+
+func init() { file_elevatormgr_proto_init() }
+func file_elevatormgr_proto_init() {
+	if File_elevatormgr_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_elevatormgr_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Building); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_elevatormgr_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Call); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_elevatormgr_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Elevator); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_elevatormgr_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TicketStatusChange); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_elevatormgr_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Ticket); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_elevatormgr_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HallCall); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_elevatormgr_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Reassignment); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_elevatormgr_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListBuildingsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_elevatormgr_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListBuildingsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_elevatormgr_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetElevatorsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_elevatormgr_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetElevatorsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_elevatormgr_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetElevatorRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_elevatormgr_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WatchElevatorsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_elevatormgr_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CallElevatorRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_elevatormgr_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PushDestinationRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_elevatormgr_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CancelHallCallRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_elevatormgr_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CancelCarCallRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_elevatormgr_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CancelResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_elevatormgr_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetCallRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_elevatormgr_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetHallCallsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_elevatormgr_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetHallCallsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_elevatormgr_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ElevatorCommand); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_elevatormgr_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetInServiceRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_elevatormgr_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetIndependentServiceRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_elevatormgr_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OverrideCallRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_elevatormgr_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReassignHallCallRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_elevatormgr_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CarChange); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_elevatormgr_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BuildingCommand); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_elevatormgr_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PendingChange); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_elevatormgr_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PriorityCallRecord); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_elevatormgr_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPriorityCallLogRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_elevatormgr_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPriorityCallLogResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_elevatormgr_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PriorityCallRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_elevatormgr_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MaintenanceWindow); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_elevatormgr_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetMaintenanceWindowsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_elevatormgr_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetMaintenanceWindowsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_elevatormgr_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ScheduleMaintenanceRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_elevatormgr_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MaintenanceWindowChange); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_elevatormgr_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AgingPolicy); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_elevatormgr_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAgingPolicyRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_elevatormgr_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetAgingPolicyRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_elevatormgr_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetSmokeDetectorRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_elevatormgr_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SmokeDetector); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_elevatormgr_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FireRecall); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_elevatormgr_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ActivateEmergencyPowerRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_elevatormgr_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetEmergencyPowerCarsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_elevatormgr_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetEmergencyPowerRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_elevatormgr_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EmergencyPowerStatus); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_elevatormgr_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetFirefighterServiceRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_elevatormgr_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FirefighterCallRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_elevatormgr_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetFirefighterDoorRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_elevatormgr_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CommissionElevatorRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_elevatormgr_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExtendFloorsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_elevatormgr_proto_msgTypes[51].OneofWrappers = []interface{}{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_elevatormgr_proto_rawDesc,
+			NumEnums:      3,
+			NumMessages:   53,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_elevatormgr_proto_goTypes,
+		DependencyIndexes: file_elevatormgr_proto_depIdxs,
+		EnumInfos:         file_elevatormgr_proto_enumTypes,
+		MessageInfos:      file_elevatormgr_proto_msgTypes,
+	}.Build()
+	File_elevatormgr_proto = out.File
+	file_elevatormgr_proto_rawDesc = nil
+	file_elevatormgr_proto_goTypes = nil
+	file_elevatormgr_proto_depIdxs = nil
+}
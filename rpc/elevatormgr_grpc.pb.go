@@ -0,0 +1,1493 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             v4.25.3
+// source: elevatormgr.proto
+
+package rpc
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	ElevatorControl_ListBuildings_FullMethodName          = "/elevatormgr.v1.ElevatorControl/ListBuildings"
+	ElevatorControl_GetElevators_FullMethodName           = "/elevatormgr.v1.ElevatorControl/GetElevators"
+	ElevatorControl_GetElevator_FullMethodName            = "/elevatormgr.v1.ElevatorControl/GetElevator"
+	ElevatorControl_WatchElevators_FullMethodName         = "/elevatormgr.v1.ElevatorControl/WatchElevators"
+	ElevatorControl_CallElevator_FullMethodName           = "/elevatormgr.v1.ElevatorControl/CallElevator"
+	ElevatorControl_PushDestination_FullMethodName        = "/elevatormgr.v1.ElevatorControl/PushDestination"
+	ElevatorControl_CancelHallCall_FullMethodName         = "/elevatormgr.v1.ElevatorControl/CancelHallCall"
+	ElevatorControl_CancelCarCall_FullMethodName          = "/elevatormgr.v1.ElevatorControl/CancelCarCall"
+	ElevatorControl_GetCall_FullMethodName                = "/elevatormgr.v1.ElevatorControl/GetCall"
+	ElevatorControl_WatchCall_FullMethodName              = "/elevatormgr.v1.ElevatorControl/WatchCall"
+	ElevatorControl_GetHallCalls_FullMethodName           = "/elevatormgr.v1.ElevatorControl/GetHallCalls"
+	ElevatorControl_SetInService_FullMethodName           = "/elevatormgr.v1.ElevatorControl/SetInService"
+	ElevatorControl_SetIndependentService_FullMethodName  = "/elevatormgr.v1.ElevatorControl/SetIndependentService"
+	ElevatorControl_DrainElevator_FullMethodName          = "/elevatormgr.v1.ElevatorControl/DrainElevator"
+	ElevatorControl_OverrideCall_FullMethodName           = "/elevatormgr.v1.ElevatorControl/OverrideCall"
+	ElevatorControl_ReassignHallCall_FullMethodName       = "/elevatormgr.v1.ElevatorControl/ReassignHallCall"
+	ElevatorControl_ResetElevator_FullMethodName          = "/elevatormgr.v1.ElevatorControl/ResetElevator"
+	ElevatorControl_GetPriorityCallLog_FullMethodName     = "/elevatormgr.v1.ElevatorControl/GetPriorityCallLog"
+	ElevatorControl_GetMaintenanceWindows_FullMethodName  = "/elevatormgr.v1.ElevatorControl/GetMaintenanceWindows"
+	ElevatorControl_ScheduleMaintenance_FullMethodName    = "/elevatormgr.v1.ElevatorControl/ScheduleMaintenance"
+	ElevatorControl_GetAgingPolicy_FullMethodName         = "/elevatormgr.v1.ElevatorControl/GetAgingPolicy"
+	ElevatorControl_SetAgingPolicy_FullMethodName         = "/elevatormgr.v1.ElevatorControl/SetAgingPolicy"
+	ElevatorControl_PriorityCall_FullMethodName           = "/elevatormgr.v1.ElevatorControl/PriorityCall"
+	ElevatorControl_SetSmokeDetector_FullMethodName       = "/elevatormgr.v1.ElevatorControl/SetSmokeDetector"
+	ElevatorControl_ActivateFireRecall_FullMethodName     = "/elevatormgr.v1.ElevatorControl/ActivateFireRecall"
+	ElevatorControl_ResetFireRecall_FullMethodName        = "/elevatormgr.v1.ElevatorControl/ResetFireRecall"
+	ElevatorControl_ActivateEmergencyPower_FullMethodName = "/elevatormgr.v1.ElevatorControl/ActivateEmergencyPower"
+	ElevatorControl_SetEmergencyPowerCars_FullMethodName  = "/elevatormgr.v1.ElevatorControl/SetEmergencyPowerCars"
+	ElevatorControl_EndEmergencyPower_FullMethodName      = "/elevatormgr.v1.ElevatorControl/EndEmergencyPower"
+	ElevatorControl_GetEmergencyPower_FullMethodName      = "/elevatormgr.v1.ElevatorControl/GetEmergencyPower"
+	ElevatorControl_SetFirefighterService_FullMethodName  = "/elevatormgr.v1.ElevatorControl/SetFirefighterService"
+	ElevatorControl_FirefighterCall_FullMethodName        = "/elevatormgr.v1.ElevatorControl/FirefighterCall"
+	ElevatorControl_SetFirefighterDoor_FullMethodName     = "/elevatormgr.v1.ElevatorControl/SetFirefighterDoor"
+	ElevatorControl_CommissionElevator_FullMethodName     = "/elevatormgr.v1.ElevatorControl/CommissionElevator"
+	ElevatorControl_DecommissionElevator_FullMethodName   = "/elevatormgr.v1.ElevatorControl/DecommissionElevator"
+	ElevatorControl_ExtendFloors_FullMethodName           = "/elevatormgr.v1.ElevatorControl/ExtendFloors"
+)
+
+// ElevatorControlClient is the client API for ElevatorControl service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ElevatorControlClient interface {
+	// buildings and cars
+	ListBuildings(ctx context.Context, in *ListBuildingsRequest, opts ...grpc.CallOption) (*ListBuildingsResponse, error)
+	GetElevators(ctx context.Context, in *GetElevatorsRequest, opts ...grpc.CallOption) (*GetElevatorsResponse, error)
+	GetElevator(ctx context.Context, in *GetElevatorRequest, opts ...grpc.CallOption) (*Elevator, error)
+	// sends every car's state now and again whenever any of them changes
+	WatchElevators(ctx context.Context, in *WatchElevatorsRequest, opts ...grpc.CallOption) (ElevatorControl_WatchElevatorsClient, error)
+	// passengers
+	CallElevator(ctx context.Context, in *CallElevatorRequest, opts ...grpc.CallOption) (*Ticket, error)
+	PushDestination(ctx context.Context, in *PushDestinationRequest, opts ...grpc.CallOption) (*Ticket, error)
+	CancelHallCall(ctx context.Context, in *CancelHallCallRequest, opts ...grpc.CallOption) (*CancelResponse, error)
+	CancelCarCall(ctx context.Context, in *CancelCarCallRequest, opts ...grpc.CallOption) (*CancelResponse, error)
+	GetCall(ctx context.Context, in *GetCallRequest, opts ...grpc.CallOption) (*Ticket, error)
+	// sends the ticket now and on every change until the call is done
+	WatchCall(ctx context.Context, in *GetCallRequest, opts ...grpc.CallOption) (ElevatorControl_WatchCallClient, error)
+	GetHallCalls(ctx context.Context, in *GetHallCallsRequest, opts ...grpc.CallOption) (*GetHallCallsResponse, error)
+	// maintenance, technicians and up
+	SetInService(ctx context.Context, in *SetInServiceRequest, opts ...grpc.CallOption) (*CarChange, error)
+	SetIndependentService(ctx context.Context, in *SetIndependentServiceRequest, opts ...grpc.CallOption) (*CarChange, error)
+	DrainElevator(ctx context.Context, in *ElevatorCommand, opts ...grpc.CallOption) (*CarChange, error)
+	OverrideCall(ctx context.Context, in *OverrideCallRequest, opts ...grpc.CallOption) (*CarChange, error)
+	// the hall call comes back empty when it was answered in the meantime
+	ReassignHallCall(ctx context.Context, in *ReassignHallCallRequest, opts ...grpc.CallOption) (*HallCall, error)
+	// supervisors only, always needs approving
+	ResetElevator(ctx context.Context, in *ElevatorCommand, opts ...grpc.CallOption) (*CarChange, error)
+	GetPriorityCallLog(ctx context.Context, in *GetPriorityCallLogRequest, opts ...grpc.CallOption) (*GetPriorityCallLogResponse, error)
+	GetMaintenanceWindows(ctx context.Context, in *GetMaintenanceWindowsRequest, opts ...grpc.CallOption) (*GetMaintenanceWindowsResponse, error)
+	// a window that runs into peak hours needs approving
+	ScheduleMaintenance(ctx context.Context, in *ScheduleMaintenanceRequest, opts ...grpc.CallOption) (*MaintenanceWindowChange, error)
+	GetAgingPolicy(ctx context.Context, in *GetAgingPolicyRequest, opts ...grpc.CallOption) (*AgingPolicy, error)
+	// supervisors only, always needs approving
+	SetAgingPolicy(ctx context.Context, in *SetAgingPolicyRequest, opts ...grpc.CallOption) (*PendingChange, error)
+	// priority calls, on the priority key in "x-priority-key" rather than an API key
+	PriorityCall(ctx context.Context, in *PriorityCallRequest, opts ...grpc.CallOption) (*PriorityCallRecord, error)
+	// fire recall and emergency power take the whole building over, technicians and up
+	SetSmokeDetector(ctx context.Context, in *SetSmokeDetectorRequest, opts ...grpc.CallOption) (*SmokeDetector, error)
+	ActivateFireRecall(ctx context.Context, in *BuildingCommand, opts ...grpc.CallOption) (*FireRecall, error)
+	ResetFireRecall(ctx context.Context, in *BuildingCommand, opts ...grpc.CallOption) (*FireRecall, error)
+	ActivateEmergencyPower(ctx context.Context, in *ActivateEmergencyPowerRequest, opts ...grpc.CallOption) (*EmergencyPowerStatus, error)
+	SetEmergencyPowerCars(ctx context.Context, in *SetEmergencyPowerCarsRequest, opts ...grpc.CallOption) (*EmergencyPowerStatus, error)
+	EndEmergencyPower(ctx context.Context, in *BuildingCommand, opts ...grpc.CallOption) (*EmergencyPowerStatus, error)
+	// anyone can look
+	GetEmergencyPower(ctx context.Context, in *GetEmergencyPowerRequest, opts ...grpc.CallOption) (*EmergencyPowerStatus, error)
+	// fire service Phase II, on the firefighters' key in "x-fire-service-key"
+	// rather than an API key
+	SetFirefighterService(ctx context.Context, in *SetFirefighterServiceRequest, opts ...grpc.CallOption) (*Elevator, error)
+	FirefighterCall(ctx context.Context, in *FirefighterCallRequest, opts ...grpc.CallOption) (*Elevator, error)
+	// constant pressure door open button, on while pressed
+	SetFirefighterDoor(ctx context.Context, in *SetFirefighterDoorRequest, opts ...grpc.CallOption) (*Elevator, error)
+	// commissioning, supervisors only
+	CommissionElevator(ctx context.Context, in *CommissionElevatorRequest, opts ...grpc.CallOption) (*Elevator, error)
+	// the car has to be drained first, comes back as it was
+	DecommissionElevator(ctx context.Context, in *ElevatorCommand, opts ...grpc.CallOption) (*Elevator, error)
+	ExtendFloors(ctx context.Context, in *ExtendFloorsRequest, opts ...grpc.CallOption) (*Building, error)
+}
+
+type elevatorControlClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewElevatorControlClient(cc grpc.ClientConnInterface) ElevatorControlClient {
+	return &elevatorControlClient{cc}
+}
+
+func (c *elevatorControlClient) ListBuildings(ctx context.Context, in *ListBuildingsRequest, opts ...grpc.CallOption) (*ListBuildingsResponse, error) {
+	out := new(ListBuildingsResponse)
+	err := c.cc.Invoke(ctx, ElevatorControl_ListBuildings_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *elevatorControlClient) GetElevators(ctx context.Context, in *GetElevatorsRequest, opts ...grpc.CallOption) (*GetElevatorsResponse, error) {
+	out := new(GetElevatorsResponse)
+	err := c.cc.Invoke(ctx, ElevatorControl_GetElevators_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *elevatorControlClient) GetElevator(ctx context.Context, in *GetElevatorRequest, opts ...grpc.CallOption) (*Elevator, error) {
+	out := new(Elevator)
+	err := c.cc.Invoke(ctx, ElevatorControl_GetElevator_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *elevatorControlClient) WatchElevators(ctx context.Context, in *WatchElevatorsRequest, opts ...grpc.CallOption) (ElevatorControl_WatchElevatorsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &ElevatorControl_ServiceDesc.Streams[0], ElevatorControl_WatchElevators_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &elevatorControlWatchElevatorsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ElevatorControl_WatchElevatorsClient interface {
+	Recv() (*GetElevatorsResponse, error)
+	grpc.ClientStream
+}
+
+type elevatorControlWatchElevatorsClient struct {
+	grpc.ClientStream
+}
+
+func (x *elevatorControlWatchElevatorsClient) Recv() (*GetElevatorsResponse, error) {
+	m := new(GetElevatorsResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *elevatorControlClient) CallElevator(ctx context.Context, in *CallElevatorRequest, opts ...grpc.CallOption) (*Ticket, error) {
+	out := new(Ticket)
+	err := c.cc.Invoke(ctx, ElevatorControl_CallElevator_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *elevatorControlClient) PushDestination(ctx context.Context, in *PushDestinationRequest, opts ...grpc.CallOption) (*Ticket, error) {
+	out := new(Ticket)
+	err := c.cc.Invoke(ctx, ElevatorControl_PushDestination_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *elevatorControlClient) CancelHallCall(ctx context.Context, in *CancelHallCallRequest, opts ...grpc.CallOption) (*CancelResponse, error) {
+	out := new(CancelResponse)
+	err := c.cc.Invoke(ctx, ElevatorControl_CancelHallCall_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *elevatorControlClient) CancelCarCall(ctx context.Context, in *CancelCarCallRequest, opts ...grpc.CallOption) (*CancelResponse, error) {
+	out := new(CancelResponse)
+	err := c.cc.Invoke(ctx, ElevatorControl_CancelCarCall_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *elevatorControlClient) GetCall(ctx context.Context, in *GetCallRequest, opts ...grpc.CallOption) (*Ticket, error) {
+	out := new(Ticket)
+	err := c.cc.Invoke(ctx, ElevatorControl_GetCall_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *elevatorControlClient) WatchCall(ctx context.Context, in *GetCallRequest, opts ...grpc.CallOption) (ElevatorControl_WatchCallClient, error) {
+	stream, err := c.cc.NewStream(ctx, &ElevatorControl_ServiceDesc.Streams[1], ElevatorControl_WatchCall_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &elevatorControlWatchCallClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ElevatorControl_WatchCallClient interface {
+	Recv() (*Ticket, error)
+	grpc.ClientStream
+}
+
+type elevatorControlWatchCallClient struct {
+	grpc.ClientStream
+}
+
+func (x *elevatorControlWatchCallClient) Recv() (*Ticket, error) {
+	m := new(Ticket)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *elevatorControlClient) GetHallCalls(ctx context.Context, in *GetHallCallsRequest, opts ...grpc.CallOption) (*GetHallCallsResponse, error) {
+	out := new(GetHallCallsResponse)
+	err := c.cc.Invoke(ctx, ElevatorControl_GetHallCalls_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *elevatorControlClient) SetInService(ctx context.Context, in *SetInServiceRequest, opts ...grpc.CallOption) (*CarChange, error) {
+	out := new(CarChange)
+	err := c.cc.Invoke(ctx, ElevatorControl_SetInService_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *elevatorControlClient) SetIndependentService(ctx context.Context, in *SetIndependentServiceRequest, opts ...grpc.CallOption) (*CarChange, error) {
+	out := new(CarChange)
+	err := c.cc.Invoke(ctx, ElevatorControl_SetIndependentService_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *elevatorControlClient) DrainElevator(ctx context.Context, in *ElevatorCommand, opts ...grpc.CallOption) (*CarChange, error) {
+	out := new(CarChange)
+	err := c.cc.Invoke(ctx, ElevatorControl_DrainElevator_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *elevatorControlClient) OverrideCall(ctx context.Context, in *OverrideCallRequest, opts ...grpc.CallOption) (*CarChange, error) {
+	out := new(CarChange)
+	err := c.cc.Invoke(ctx, ElevatorControl_OverrideCall_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *elevatorControlClient) ReassignHallCall(ctx context.Context, in *ReassignHallCallRequest, opts ...grpc.CallOption) (*HallCall, error) {
+	out := new(HallCall)
+	err := c.cc.Invoke(ctx, ElevatorControl_ReassignHallCall_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *elevatorControlClient) ResetElevator(ctx context.Context, in *ElevatorCommand, opts ...grpc.CallOption) (*CarChange, error) {
+	out := new(CarChange)
+	err := c.cc.Invoke(ctx, ElevatorControl_ResetElevator_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *elevatorControlClient) GetPriorityCallLog(ctx context.Context, in *GetPriorityCallLogRequest, opts ...grpc.CallOption) (*GetPriorityCallLogResponse, error) {
+	out := new(GetPriorityCallLogResponse)
+	err := c.cc.Invoke(ctx, ElevatorControl_GetPriorityCallLog_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *elevatorControlClient) GetMaintenanceWindows(ctx context.Context, in *GetMaintenanceWindowsRequest, opts ...grpc.CallOption) (*GetMaintenanceWindowsResponse, error) {
+	out := new(GetMaintenanceWindowsResponse)
+	err := c.cc.Invoke(ctx, ElevatorControl_GetMaintenanceWindows_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *elevatorControlClient) ScheduleMaintenance(ctx context.Context, in *ScheduleMaintenanceRequest, opts ...grpc.CallOption) (*MaintenanceWindowChange, error) {
+	out := new(MaintenanceWindowChange)
+	err := c.cc.Invoke(ctx, ElevatorControl_ScheduleMaintenance_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *elevatorControlClient) GetAgingPolicy(ctx context.Context, in *GetAgingPolicyRequest, opts ...grpc.CallOption) (*AgingPolicy, error) {
+	out := new(AgingPolicy)
+	err := c.cc.Invoke(ctx, ElevatorControl_GetAgingPolicy_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *elevatorControlClient) SetAgingPolicy(ctx context.Context, in *SetAgingPolicyRequest, opts ...grpc.CallOption) (*PendingChange, error) {
+	out := new(PendingChange)
+	err := c.cc.Invoke(ctx, ElevatorControl_SetAgingPolicy_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *elevatorControlClient) PriorityCall(ctx context.Context, in *PriorityCallRequest, opts ...grpc.CallOption) (*PriorityCallRecord, error) {
+	out := new(PriorityCallRecord)
+	err := c.cc.Invoke(ctx, ElevatorControl_PriorityCall_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *elevatorControlClient) SetSmokeDetector(ctx context.Context, in *SetSmokeDetectorRequest, opts ...grpc.CallOption) (*SmokeDetector, error) {
+	out := new(SmokeDetector)
+	err := c.cc.Invoke(ctx, ElevatorControl_SetSmokeDetector_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *elevatorControlClient) ActivateFireRecall(ctx context.Context, in *BuildingCommand, opts ...grpc.CallOption) (*FireRecall, error) {
+	out := new(FireRecall)
+	err := c.cc.Invoke(ctx, ElevatorControl_ActivateFireRecall_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *elevatorControlClient) ResetFireRecall(ctx context.Context, in *BuildingCommand, opts ...grpc.CallOption) (*FireRecall, error) {
+	out := new(FireRecall)
+	err := c.cc.Invoke(ctx, ElevatorControl_ResetFireRecall_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *elevatorControlClient) ActivateEmergencyPower(ctx context.Context, in *ActivateEmergencyPowerRequest, opts ...grpc.CallOption) (*EmergencyPowerStatus, error) {
+	out := new(EmergencyPowerStatus)
+	err := c.cc.Invoke(ctx, ElevatorControl_ActivateEmergencyPower_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *elevatorControlClient) SetEmergencyPowerCars(ctx context.Context, in *SetEmergencyPowerCarsRequest, opts ...grpc.CallOption) (*EmergencyPowerStatus, error) {
+	out := new(EmergencyPowerStatus)
+	err := c.cc.Invoke(ctx, ElevatorControl_SetEmergencyPowerCars_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *elevatorControlClient) EndEmergencyPower(ctx context.Context, in *BuildingCommand, opts ...grpc.CallOption) (*EmergencyPowerStatus, error) {
+	out := new(EmergencyPowerStatus)
+	err := c.cc.Invoke(ctx, ElevatorControl_EndEmergencyPower_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *elevatorControlClient) GetEmergencyPower(ctx context.Context, in *GetEmergencyPowerRequest, opts ...grpc.CallOption) (*EmergencyPowerStatus, error) {
+	out := new(EmergencyPowerStatus)
+	err := c.cc.Invoke(ctx, ElevatorControl_GetEmergencyPower_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *elevatorControlClient) SetFirefighterService(ctx context.Context, in *SetFirefighterServiceRequest, opts ...grpc.CallOption) (*Elevator, error) {
+	out := new(Elevator)
+	err := c.cc.Invoke(ctx, ElevatorControl_SetFirefighterService_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *elevatorControlClient) FirefighterCall(ctx context.Context, in *FirefighterCallRequest, opts ...grpc.CallOption) (*Elevator, error) {
+	out := new(Elevator)
+	err := c.cc.Invoke(ctx, ElevatorControl_FirefighterCall_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *elevatorControlClient) SetFirefighterDoor(ctx context.Context, in *SetFirefighterDoorRequest, opts ...grpc.CallOption) (*Elevator, error) {
+	out := new(Elevator)
+	err := c.cc.Invoke(ctx, ElevatorControl_SetFirefighterDoor_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *elevatorControlClient) CommissionElevator(ctx context.Context, in *CommissionElevatorRequest, opts ...grpc.CallOption) (*Elevator, error) {
+	out := new(Elevator)
+	err := c.cc.Invoke(ctx, ElevatorControl_CommissionElevator_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *elevatorControlClient) DecommissionElevator(ctx context.Context, in *ElevatorCommand, opts ...grpc.CallOption) (*Elevator, error) {
+	out := new(Elevator)
+	err := c.cc.Invoke(ctx, ElevatorControl_DecommissionElevator_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *elevatorControlClient) ExtendFloors(ctx context.Context, in *ExtendFloorsRequest, opts ...grpc.CallOption) (*Building, error) {
+	out := new(Building)
+	err := c.cc.Invoke(ctx, ElevatorControl_ExtendFloors_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ElevatorControlServer is the server API for ElevatorControl service.
+// All implementations must embed UnimplementedElevatorControlServer
+// for forward compatibility
+type ElevatorControlServer interface {
+	// buildings and cars
+	ListBuildings(context.Context, *ListBuildingsRequest) (*ListBuildingsResponse, error)
+	GetElevators(context.Context, *GetElevatorsRequest) (*GetElevatorsResponse, error)
+	GetElevator(context.Context, *GetElevatorRequest) (*Elevator, error)
+	// sends every car's state now and again whenever any of them changes
+	WatchElevators(*WatchElevatorsRequest, ElevatorControl_WatchElevatorsServer) error
+	// passengers
+	CallElevator(context.Context, *CallElevatorRequest) (*Ticket, error)
+	PushDestination(context.Context, *PushDestinationRequest) (*Ticket, error)
+	CancelHallCall(context.Context, *CancelHallCallRequest) (*CancelResponse, error)
+	CancelCarCall(context.Context, *CancelCarCallRequest) (*CancelResponse, error)
+	GetCall(context.Context, *GetCallRequest) (*Ticket, error)
+	// sends the ticket now and on every change until the call is done
+	WatchCall(*GetCallRequest, ElevatorControl_WatchCallServer) error
+	GetHallCalls(context.Context, *GetHallCallsRequest) (*GetHallCallsResponse, error)
+	// maintenance, technicians and up
+	SetInService(context.Context, *SetInServiceRequest) (*CarChange, error)
+	SetIndependentService(context.Context, *SetIndependentServiceRequest) (*CarChange, error)
+	DrainElevator(context.Context, *ElevatorCommand) (*CarChange, error)
+	OverrideCall(context.Context, *OverrideCallRequest) (*CarChange, error)
+	// the hall call comes back empty when it was answered in the meantime
+	ReassignHallCall(context.Context, *ReassignHallCallRequest) (*HallCall, error)
+	// supervisors only, always needs approving
+	ResetElevator(context.Context, *ElevatorCommand) (*CarChange, error)
+	GetPriorityCallLog(context.Context, *GetPriorityCallLogRequest) (*GetPriorityCallLogResponse, error)
+	GetMaintenanceWindows(context.Context, *GetMaintenanceWindowsRequest) (*GetMaintenanceWindowsResponse, error)
+	// a window that runs into peak hours needs approving
+	ScheduleMaintenance(context.Context, *ScheduleMaintenanceRequest) (*MaintenanceWindowChange, error)
+	GetAgingPolicy(context.Context, *GetAgingPolicyRequest) (*AgingPolicy, error)
+	// supervisors only, always needs approving
+	SetAgingPolicy(context.Context, *SetAgingPolicyRequest) (*PendingChange, error)
+	// priority calls, on the priority key in "x-priority-key" rather than an API key
+	PriorityCall(context.Context, *PriorityCallRequest) (*PriorityCallRecord, error)
+	// fire recall and emergency power take the whole building over, technicians and up
+	SetSmokeDetector(context.Context, *SetSmokeDetectorRequest) (*SmokeDetector, error)
+	ActivateFireRecall(context.Context, *BuildingCommand) (*FireRecall, error)
+	ResetFireRecall(context.Context, *BuildingCommand) (*FireRecall, error)
+	ActivateEmergencyPower(context.Context, *ActivateEmergencyPowerRequest) (*EmergencyPowerStatus, error)
+	SetEmergencyPowerCars(context.Context, *SetEmergencyPowerCarsRequest) (*EmergencyPowerStatus, error)
+	EndEmergencyPower(context.Context, *BuildingCommand) (*EmergencyPowerStatus, error)
+	// anyone can look
+	GetEmergencyPower(context.Context, *GetEmergencyPowerRequest) (*EmergencyPowerStatus, error)
+	// fire service Phase II, on the firefighters' key in "x-fire-service-key"
+	// rather than an API key
+	SetFirefighterService(context.Context, *SetFirefighterServiceRequest) (*Elevator, error)
+	FirefighterCall(context.Context, *FirefighterCallRequest) (*Elevator, error)
+	// constant pressure door open button, on while pressed
+	SetFirefighterDoor(context.Context, *SetFirefighterDoorRequest) (*Elevator, error)
+	// commissioning, supervisors only
+	CommissionElevator(context.Context, *CommissionElevatorRequest) (*Elevator, error)
+	// the car has to be drained first, comes back as it was
+	DecommissionElevator(context.Context, *ElevatorCommand) (*Elevator, error)
+	ExtendFloors(context.Context, *ExtendFloorsRequest) (*Building, error)
+	mustEmbedUnimplementedElevatorControlServer()
+}
+
+// UnimplementedElevatorControlServer must be embedded to have forward compatible implementations.
+type UnimplementedElevatorControlServer struct {
+}
+
+func (UnimplementedElevatorControlServer) ListBuildings(context.Context, *ListBuildingsRequest) (*ListBuildingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListBuildings not implemented")
+}
+func (UnimplementedElevatorControlServer) GetElevators(context.Context, *GetElevatorsRequest) (*GetElevatorsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetElevators not implemented")
+}
+func (UnimplementedElevatorControlServer) GetElevator(context.Context, *GetElevatorRequest) (*Elevator, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetElevator not implemented")
+}
+func (UnimplementedElevatorControlServer) WatchElevators(*WatchElevatorsRequest, ElevatorControl_WatchElevatorsServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchElevators not implemented")
+}
+func (UnimplementedElevatorControlServer) CallElevator(context.Context, *CallElevatorRequest) (*Ticket, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CallElevator not implemented")
+}
+func (UnimplementedElevatorControlServer) PushDestination(context.Context, *PushDestinationRequest) (*Ticket, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PushDestination not implemented")
+}
+func (UnimplementedElevatorControlServer) CancelHallCall(context.Context, *CancelHallCallRequest) (*CancelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelHallCall not implemented")
+}
+func (UnimplementedElevatorControlServer) CancelCarCall(context.Context, *CancelCarCallRequest) (*CancelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelCarCall not implemented")
+}
+func (UnimplementedElevatorControlServer) GetCall(context.Context, *GetCallRequest) (*Ticket, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCall not implemented")
+}
+func (UnimplementedElevatorControlServer) WatchCall(*GetCallRequest, ElevatorControl_WatchCallServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchCall not implemented")
+}
+func (UnimplementedElevatorControlServer) GetHallCalls(context.Context, *GetHallCallsRequest) (*GetHallCallsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetHallCalls not implemented")
+}
+func (UnimplementedElevatorControlServer) SetInService(context.Context, *SetInServiceRequest) (*CarChange, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetInService not implemented")
+}
+func (UnimplementedElevatorControlServer) SetIndependentService(context.Context, *SetIndependentServiceRequest) (*CarChange, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetIndependentService not implemented")
+}
+func (UnimplementedElevatorControlServer) DrainElevator(context.Context, *ElevatorCommand) (*CarChange, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DrainElevator not implemented")
+}
+func (UnimplementedElevatorControlServer) OverrideCall(context.Context, *OverrideCallRequest) (*CarChange, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OverrideCall not implemented")
+}
+func (UnimplementedElevatorControlServer) ReassignHallCall(context.Context, *ReassignHallCallRequest) (*HallCall, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReassignHallCall not implemented")
+}
+func (UnimplementedElevatorControlServer) ResetElevator(context.Context, *ElevatorCommand) (*CarChange, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResetElevator not implemented")
+}
+func (UnimplementedElevatorControlServer) GetPriorityCallLog(context.Context, *GetPriorityCallLogRequest) (*GetPriorityCallLogResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPriorityCallLog not implemented")
+}
+func (UnimplementedElevatorControlServer) GetMaintenanceWindows(context.Context, *GetMaintenanceWindowsRequest) (*GetMaintenanceWindowsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMaintenanceWindows not implemented")
+}
+func (UnimplementedElevatorControlServer) ScheduleMaintenance(context.Context, *ScheduleMaintenanceRequest) (*MaintenanceWindowChange, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScheduleMaintenance not implemented")
+}
+func (UnimplementedElevatorControlServer) GetAgingPolicy(context.Context, *GetAgingPolicyRequest) (*AgingPolicy, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAgingPolicy not implemented")
+}
+func (UnimplementedElevatorControlServer) SetAgingPolicy(context.Context, *SetAgingPolicyRequest) (*PendingChange, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetAgingPolicy not implemented")
+}
+func (UnimplementedElevatorControlServer) PriorityCall(context.Context, *PriorityCallRequest) (*PriorityCallRecord, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PriorityCall not implemented")
+}
+func (UnimplementedElevatorControlServer) SetSmokeDetector(context.Context, *SetSmokeDetectorRequest) (*SmokeDetector, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetSmokeDetector not implemented")
+}
+func (UnimplementedElevatorControlServer) ActivateFireRecall(context.Context, *BuildingCommand) (*FireRecall, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ActivateFireRecall not implemented")
+}
+func (UnimplementedElevatorControlServer) ResetFireRecall(context.Context, *BuildingCommand) (*FireRecall, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResetFireRecall not implemented")
+}
+func (UnimplementedElevatorControlServer) ActivateEmergencyPower(context.Context, *ActivateEmergencyPowerRequest) (*EmergencyPowerStatus, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ActivateEmergencyPower not implemented")
+}
+func (UnimplementedElevatorControlServer) SetEmergencyPowerCars(context.Context, *SetEmergencyPowerCarsRequest) (*EmergencyPowerStatus, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetEmergencyPowerCars not implemented")
+}
+func (UnimplementedElevatorControlServer) EndEmergencyPower(context.Context, *BuildingCommand) (*EmergencyPowerStatus, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EndEmergencyPower not implemented")
+}
+func (UnimplementedElevatorControlServer) GetEmergencyPower(context.Context, *GetEmergencyPowerRequest) (*EmergencyPowerStatus, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEmergencyPower not implemented")
+}
+func (UnimplementedElevatorControlServer) SetFirefighterService(context.Context, *SetFirefighterServiceRequest) (*Elevator, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetFirefighterService not implemented")
+}
+func (UnimplementedElevatorControlServer) FirefighterCall(context.Context, *FirefighterCallRequest) (*Elevator, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FirefighterCall not implemented")
+}
+func (UnimplementedElevatorControlServer) SetFirefighterDoor(context.Context, *SetFirefighterDoorRequest) (*Elevator, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetFirefighterDoor not implemented")
+}
+func (UnimplementedElevatorControlServer) CommissionElevator(context.Context, *CommissionElevatorRequest) (*Elevator, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CommissionElevator not implemented")
+}
+func (UnimplementedElevatorControlServer) DecommissionElevator(context.Context, *ElevatorCommand) (*Elevator, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DecommissionElevator not implemented")
+}
+func (UnimplementedElevatorControlServer) ExtendFloors(context.Context, *ExtendFloorsRequest) (*Building, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExtendFloors not implemented")
+}
+func (UnimplementedElevatorControlServer) mustEmbedUnimplementedElevatorControlServer() {}
+
+// UnsafeElevatorControlServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ElevatorControlServer will
+// result in compilation errors.
+type UnsafeElevatorControlServer interface {
+	mustEmbedUnimplementedElevatorControlServer()
+}
+
+func RegisterElevatorControlServer(s grpc.ServiceRegistrar, srv ElevatorControlServer) {
+	s.RegisterService(&ElevatorControl_ServiceDesc, srv)
+}
+
+func _ElevatorControl_ListBuildings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListBuildingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ElevatorControlServer).ListBuildings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ElevatorControl_ListBuildings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ElevatorControlServer).ListBuildings(ctx, req.(*ListBuildingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ElevatorControl_GetElevators_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetElevatorsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ElevatorControlServer).GetElevators(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ElevatorControl_GetElevators_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ElevatorControlServer).GetElevators(ctx, req.(*GetElevatorsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ElevatorControl_GetElevator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetElevatorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ElevatorControlServer).GetElevator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ElevatorControl_GetElevator_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ElevatorControlServer).GetElevator(ctx, req.(*GetElevatorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ElevatorControl_WatchElevators_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchElevatorsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ElevatorControlServer).WatchElevators(m, &elevatorControlWatchElevatorsServer{stream})
+}
+
+type ElevatorControl_WatchElevatorsServer interface {
+	Send(*GetElevatorsResponse) error
+	grpc.ServerStream
+}
+
+type elevatorControlWatchElevatorsServer struct {
+	grpc.ServerStream
+}
+
+func (x *elevatorControlWatchElevatorsServer) Send(m *GetElevatorsResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _ElevatorControl_CallElevator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CallElevatorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ElevatorControlServer).CallElevator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ElevatorControl_CallElevator_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ElevatorControlServer).CallElevator(ctx, req.(*CallElevatorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ElevatorControl_PushDestination_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PushDestinationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ElevatorControlServer).PushDestination(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ElevatorControl_PushDestination_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ElevatorControlServer).PushDestination(ctx, req.(*PushDestinationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ElevatorControl_CancelHallCall_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelHallCallRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ElevatorControlServer).CancelHallCall(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ElevatorControl_CancelHallCall_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ElevatorControlServer).CancelHallCall(ctx, req.(*CancelHallCallRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ElevatorControl_CancelCarCall_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelCarCallRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ElevatorControlServer).CancelCarCall(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ElevatorControl_CancelCarCall_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ElevatorControlServer).CancelCarCall(ctx, req.(*CancelCarCallRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ElevatorControl_GetCall_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCallRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ElevatorControlServer).GetCall(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ElevatorControl_GetCall_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ElevatorControlServer).GetCall(ctx, req.(*GetCallRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ElevatorControl_WatchCall_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetCallRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ElevatorControlServer).WatchCall(m, &elevatorControlWatchCallServer{stream})
+}
+
+type ElevatorControl_WatchCallServer interface {
+	Send(*Ticket) error
+	grpc.ServerStream
+}
+
+type elevatorControlWatchCallServer struct {
+	grpc.ServerStream
+}
+
+func (x *elevatorControlWatchCallServer) Send(m *Ticket) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _ElevatorControl_GetHallCalls_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetHallCallsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ElevatorControlServer).GetHallCalls(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ElevatorControl_GetHallCalls_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ElevatorControlServer).GetHallCalls(ctx, req.(*GetHallCallsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ElevatorControl_SetInService_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetInServiceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ElevatorControlServer).SetInService(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ElevatorControl_SetInService_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ElevatorControlServer).SetInService(ctx, req.(*SetInServiceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ElevatorControl_SetIndependentService_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetIndependentServiceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ElevatorControlServer).SetIndependentService(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ElevatorControl_SetIndependentService_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ElevatorControlServer).SetIndependentService(ctx, req.(*SetIndependentServiceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ElevatorControl_DrainElevator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ElevatorCommand)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ElevatorControlServer).DrainElevator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ElevatorControl_DrainElevator_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ElevatorControlServer).DrainElevator(ctx, req.(*ElevatorCommand))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ElevatorControl_OverrideCall_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OverrideCallRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ElevatorControlServer).OverrideCall(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ElevatorControl_OverrideCall_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ElevatorControlServer).OverrideCall(ctx, req.(*OverrideCallRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ElevatorControl_ReassignHallCall_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReassignHallCallRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ElevatorControlServer).ReassignHallCall(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ElevatorControl_ReassignHallCall_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ElevatorControlServer).ReassignHallCall(ctx, req.(*ReassignHallCallRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ElevatorControl_ResetElevator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ElevatorCommand)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ElevatorControlServer).ResetElevator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ElevatorControl_ResetElevator_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ElevatorControlServer).ResetElevator(ctx, req.(*ElevatorCommand))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ElevatorControl_GetPriorityCallLog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPriorityCallLogRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ElevatorControlServer).GetPriorityCallLog(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ElevatorControl_GetPriorityCallLog_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ElevatorControlServer).GetPriorityCallLog(ctx, req.(*GetPriorityCallLogRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ElevatorControl_GetMaintenanceWindows_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMaintenanceWindowsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ElevatorControlServer).GetMaintenanceWindows(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ElevatorControl_GetMaintenanceWindows_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ElevatorControlServer).GetMaintenanceWindows(ctx, req.(*GetMaintenanceWindowsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ElevatorControl_ScheduleMaintenance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ScheduleMaintenanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ElevatorControlServer).ScheduleMaintenance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ElevatorControl_ScheduleMaintenance_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ElevatorControlServer).ScheduleMaintenance(ctx, req.(*ScheduleMaintenanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ElevatorControl_GetAgingPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAgingPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ElevatorControlServer).GetAgingPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ElevatorControl_GetAgingPolicy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ElevatorControlServer).GetAgingPolicy(ctx, req.(*GetAgingPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ElevatorControl_SetAgingPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetAgingPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ElevatorControlServer).SetAgingPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ElevatorControl_SetAgingPolicy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ElevatorControlServer).SetAgingPolicy(ctx, req.(*SetAgingPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ElevatorControl_PriorityCall_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PriorityCallRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ElevatorControlServer).PriorityCall(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ElevatorControl_PriorityCall_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ElevatorControlServer).PriorityCall(ctx, req.(*PriorityCallRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ElevatorControl_SetSmokeDetector_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetSmokeDetectorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ElevatorControlServer).SetSmokeDetector(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ElevatorControl_SetSmokeDetector_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ElevatorControlServer).SetSmokeDetector(ctx, req.(*SetSmokeDetectorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ElevatorControl_ActivateFireRecall_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BuildingCommand)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ElevatorControlServer).ActivateFireRecall(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ElevatorControl_ActivateFireRecall_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ElevatorControlServer).ActivateFireRecall(ctx, req.(*BuildingCommand))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ElevatorControl_ResetFireRecall_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BuildingCommand)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ElevatorControlServer).ResetFireRecall(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ElevatorControl_ResetFireRecall_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ElevatorControlServer).ResetFireRecall(ctx, req.(*BuildingCommand))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ElevatorControl_ActivateEmergencyPower_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ActivateEmergencyPowerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ElevatorControlServer).ActivateEmergencyPower(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ElevatorControl_ActivateEmergencyPower_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ElevatorControlServer).ActivateEmergencyPower(ctx, req.(*ActivateEmergencyPowerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ElevatorControl_SetEmergencyPowerCars_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetEmergencyPowerCarsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ElevatorControlServer).SetEmergencyPowerCars(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ElevatorControl_SetEmergencyPowerCars_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ElevatorControlServer).SetEmergencyPowerCars(ctx, req.(*SetEmergencyPowerCarsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ElevatorControl_EndEmergencyPower_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BuildingCommand)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ElevatorControlServer).EndEmergencyPower(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ElevatorControl_EndEmergencyPower_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ElevatorControlServer).EndEmergencyPower(ctx, req.(*BuildingCommand))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ElevatorControl_GetEmergencyPower_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetEmergencyPowerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ElevatorControlServer).GetEmergencyPower(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ElevatorControl_GetEmergencyPower_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ElevatorControlServer).GetEmergencyPower(ctx, req.(*GetEmergencyPowerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ElevatorControl_SetFirefighterService_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetFirefighterServiceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ElevatorControlServer).SetFirefighterService(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ElevatorControl_SetFirefighterService_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ElevatorControlServer).SetFirefighterService(ctx, req.(*SetFirefighterServiceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ElevatorControl_FirefighterCall_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FirefighterCallRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ElevatorControlServer).FirefighterCall(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ElevatorControl_FirefighterCall_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ElevatorControlServer).FirefighterCall(ctx, req.(*FirefighterCallRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ElevatorControl_SetFirefighterDoor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetFirefighterDoorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ElevatorControlServer).SetFirefighterDoor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ElevatorControl_SetFirefighterDoor_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ElevatorControlServer).SetFirefighterDoor(ctx, req.(*SetFirefighterDoorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ElevatorControl_CommissionElevator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CommissionElevatorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ElevatorControlServer).CommissionElevator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ElevatorControl_CommissionElevator_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ElevatorControlServer).CommissionElevator(ctx, req.(*CommissionElevatorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ElevatorControl_DecommissionElevator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ElevatorCommand)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ElevatorControlServer).DecommissionElevator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ElevatorControl_DecommissionElevator_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ElevatorControlServer).DecommissionElevator(ctx, req.(*ElevatorCommand))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ElevatorControl_ExtendFloors_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExtendFloorsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ElevatorControlServer).ExtendFloors(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ElevatorControl_ExtendFloors_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ElevatorControlServer).ExtendFloors(ctx, req.(*ExtendFloorsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ElevatorControl_ServiceDesc is the grpc.ServiceDesc for ElevatorControl service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ElevatorControl_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "elevatormgr.v1.ElevatorControl",
+	HandlerType: (*ElevatorControlServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListBuildings",
+			Handler:    _ElevatorControl_ListBuildings_Handler,
+		},
+		{
+			MethodName: "GetElevators",
+			Handler:    _ElevatorControl_GetElevators_Handler,
+		},
+		{
+			MethodName: "GetElevator",
+			Handler:    _ElevatorControl_GetElevator_Handler,
+		},
+		{
+			MethodName: "CallElevator",
+			Handler:    _ElevatorControl_CallElevator_Handler,
+		},
+		{
+			MethodName: "PushDestination",
+			Handler:    _ElevatorControl_PushDestination_Handler,
+		},
+		{
+			MethodName: "CancelHallCall",
+			Handler:    _ElevatorControl_CancelHallCall_Handler,
+		},
+		{
+			MethodName: "CancelCarCall",
+			Handler:    _ElevatorControl_CancelCarCall_Handler,
+		},
+		{
+			MethodName: "GetCall",
+			Handler:    _ElevatorControl_GetCall_Handler,
+		},
+		{
+			MethodName: "GetHallCalls",
+			Handler:    _ElevatorControl_GetHallCalls_Handler,
+		},
+		{
+			MethodName: "SetInService",
+			Handler:    _ElevatorControl_SetInService_Handler,
+		},
+		{
+			MethodName: "SetIndependentService",
+			Handler:    _ElevatorControl_SetIndependentService_Handler,
+		},
+		{
+			MethodName: "DrainElevator",
+			Handler:    _ElevatorControl_DrainElevator_Handler,
+		},
+		{
+			MethodName: "OverrideCall",
+			Handler:    _ElevatorControl_OverrideCall_Handler,
+		},
+		{
+			MethodName: "ReassignHallCall",
+			Handler:    _ElevatorControl_ReassignHallCall_Handler,
+		},
+		{
+			MethodName: "ResetElevator",
+			Handler:    _ElevatorControl_ResetElevator_Handler,
+		},
+		{
+			MethodName: "GetPriorityCallLog",
+			Handler:    _ElevatorControl_GetPriorityCallLog_Handler,
+		},
+		{
+			MethodName: "GetMaintenanceWindows",
+			Handler:    _ElevatorControl_GetMaintenanceWindows_Handler,
+		},
+		{
+			MethodName: "ScheduleMaintenance",
+			Handler:    _ElevatorControl_ScheduleMaintenance_Handler,
+		},
+		{
+			MethodName: "GetAgingPolicy",
+			Handler:    _ElevatorControl_GetAgingPolicy_Handler,
+		},
+		{
+			MethodName: "SetAgingPolicy",
+			Handler:    _ElevatorControl_SetAgingPolicy_Handler,
+		},
+		{
+			MethodName: "PriorityCall",
+			Handler:    _ElevatorControl_PriorityCall_Handler,
+		},
+		{
+			MethodName: "SetSmokeDetector",
+			Handler:    _ElevatorControl_SetSmokeDetector_Handler,
+		},
+		{
+			MethodName: "ActivateFireRecall",
+			Handler:    _ElevatorControl_ActivateFireRecall_Handler,
+		},
+		{
+			MethodName: "ResetFireRecall",
+			Handler:    _ElevatorControl_ResetFireRecall_Handler,
+		},
+		{
+			MethodName: "ActivateEmergencyPower",
+			Handler:    _ElevatorControl_ActivateEmergencyPower_Handler,
+		},
+		{
+			MethodName: "SetEmergencyPowerCars",
+			Handler:    _ElevatorControl_SetEmergencyPowerCars_Handler,
+		},
+		{
+			MethodName: "EndEmergencyPower",
+			Handler:    _ElevatorControl_EndEmergencyPower_Handler,
+		},
+		{
+			MethodName: "GetEmergencyPower",
+			Handler:    _ElevatorControl_GetEmergencyPower_Handler,
+		},
+		{
+			MethodName: "SetFirefighterService",
+			Handler:    _ElevatorControl_SetFirefighterService_Handler,
+		},
+		{
+			MethodName: "FirefighterCall",
+			Handler:    _ElevatorControl_FirefighterCall_Handler,
+		},
+		{
+			MethodName: "SetFirefighterDoor",
+			Handler:    _ElevatorControl_SetFirefighterDoor_Handler,
+		},
+		{
+			MethodName: "CommissionElevator",
+			Handler:    _ElevatorControl_CommissionElevator_Handler,
+		},
+		{
+			MethodName: "DecommissionElevator",
+			Handler:    _ElevatorControl_DecommissionElevator_Handler,
+		},
+		{
+			MethodName: "ExtendFloors",
+			Handler:    _ElevatorControl_ExtendFloors_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchElevators",
+			Handler:       _ElevatorControl_WatchElevators_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "WatchCall",
+			Handler:       _ElevatorControl_WatchCall_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "elevatormgr.proto",
+}
@@ -0,0 +1,5 @@
+// Package rpc is the gRPC API, generated from elevatormgr.proto.  The server
+// side is in cmd/server/grpc.go.
+package rpc
+
+//go:generate protoc --go_out=. --go_opt=paths=source_relative --go-grpc_out=. --go-grpc_opt=paths=source_relative elevatormgr.proto